@@ -3,18 +3,13 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"PR/internal/app"
 )
 
 func main() {
-
-	a, err := app.NewApp(context.Background())
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	if err := a.Run(); err != nil {
+	if err := app.RunCLI(context.Background(), os.Args[1:], os.Stdout); err != nil {
 		log.Fatal(err)
 	}
 }
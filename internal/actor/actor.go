@@ -0,0 +1,34 @@
+package actor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"PR/internal/model"
+)
+
+type key struct{}
+
+// Actor — тот, от чьего имени выполняется запрос
+type Actor struct {
+	Name  string
+	Role  model.Role
+	KeyID *uuid.UUID
+	// UserID задан для JWT токена пользователя и API ключа, привязанного к пользователю
+	UserID *uuid.UUID
+}
+
+// IsAdmin — проверки владения для админа не применяются
+func (a *Actor) IsAdmin() bool {
+	return a.Role == model.RoleAdmin
+}
+
+func WithContext(ctx context.Context, a *Actor) context.Context {
+	return context.WithValue(ctx, key{}, a)
+}
+
+func FromContext(ctx context.Context) (*Actor, bool) {
+	a, ok := ctx.Value(key{}).(*Actor)
+	return a, ok
+}
@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req model.APIKeyCreateRequest
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.Name == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	var userID *uuid.UUID
+	if req.UserID != "" {
+		id, err := uuid.Parse(req.UserID)
+		if err != nil {
+			id = handlers.StringToUUID(req.UserID)
+		}
+		userID = &id
+	}
+
+	key, err := h.service.Create(c.Request.Context(), req.Name, model.Role(req.Role), userID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"api_key": key,
+	})
+}
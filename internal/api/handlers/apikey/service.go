@@ -0,0 +1,36 @@
+package apikey
+
+import (
+	"net/http"
+
+	"PR/internal/api/handlers"
+	"PR/internal/service"
+	"PR/internal/service/apikey"
+)
+
+type APIKeyHandler struct {
+	service service.APIKeyService
+}
+
+func NewAPIKeyHandler(serv service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{service: serv}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch err {
+	case apikey.ErrNotFound, apikey.ErrUserNotFound:
+		e.Code = "NOT_FOUND"
+		e.Message = "resource not found"
+		e.Status = http.StatusNotFound
+	case apikey.ErrInvalidRole:
+		e.Code = "BAD_REQUEST"
+		e.Message = "role must be one of admin, member, reader"
+		e.Status = http.StatusBadRequest
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
@@ -0,0 +1,171 @@
+package apikey_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers"
+	"PR/internal/api/handlers/apikey"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	serviceAPIKey "PR/internal/service/apikey"
+)
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      interface{}
+		setupMock      func(*mocks.MockAPIKeyService)
+		expectedStatus int
+	}{
+		{
+			name:      "success",
+			inputBody: model.APIKeyCreateRequest{Name: "ci-bot", Role: "member"},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Create", mock.Anything, "ci-bot", model.RoleMember, (*uuid.UUID)(nil)).
+					Return(&model.APIKeyCreated{
+						APIKey: model.APIKey{ID: uuid.New(), Name: "ci-bot", Role: model.RoleMember},
+						Key:    "prk_secret",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:      "invalid_role",
+			inputBody: model.APIKeyCreateRequest{Name: "ci-bot", Role: "owner"},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Create", mock.Anything, "ci-bot", model.Role("owner"), (*uuid.UUID)(nil)).
+					Return((*model.APIKeyCreated)(nil), serviceAPIKey.ErrInvalidRole)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "bound_to_user",
+			inputBody: model.APIKeyCreateRequest{Name: "alice-cli", Role: "member", UserID: "u1"},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				userID := handlers.StringToUUID("u1")
+				m.On("Create", mock.Anything, "alice-cli", model.RoleMember, &userID).
+					Return(&model.APIKeyCreated{
+						APIKey: model.APIKey{ID: uuid.New(), Name: "alice-cli", Role: model.RoleMember, UserID: &userID},
+						Key:    "prk_secret",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:      "unknown_user",
+			inputBody: model.APIKeyCreateRequest{Name: "ghost-cli", Role: "member", UserID: "ghost"},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Create", mock.Anything, "ghost-cli", model.RoleMember, mock.Anything).
+					Return((*model.APIKeyCreated)(nil), serviceAPIKey.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "empty_name",
+			inputBody:      model.APIKeyCreateRequest{Role: "member"},
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid_json",
+			inputBody:      "invalid json",
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockAPIKeyService(t)
+			tt.setupMock(mockService)
+
+			handler := apikey.NewAPIKeyHandler(mockService)
+			router.POST("/apiKeys/create", handler.Create)
+
+			var body []byte
+			if str, ok := tt.inputBody.(string); ok {
+				body = []byte(str)
+			} else {
+				body, _ = json.Marshal(tt.inputBody)
+			}
+
+			req, _ := http.NewRequest("POST", "/apiKeys/create", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	keyID := uuid.New()
+
+	tests := []struct {
+		name           string
+		inputBody      interface{}
+		setupMock      func(*mocks.MockAPIKeyService)
+		expectedStatus int
+	}{
+		{
+			name:      "success",
+			inputBody: model.APIKeyRevokeRequest{ID: keyID.String()},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				now := time.Now()
+				m.On("Revoke", mock.Anything, keyID).
+					Return(&model.APIKey{ID: keyID, RevokedAt: &now}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "not_found",
+			inputBody: model.APIKeyRevokeRequest{ID: keyID.String()},
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Revoke", mock.Anything, keyID).
+					Return((*model.APIKey)(nil), serviceAPIKey.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid_id",
+			inputBody:      model.APIKeyRevokeRequest{ID: "not-a-uuid"},
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockAPIKeyService(t)
+			tt.setupMock(mockService)
+
+			handler := apikey.NewAPIKeyHandler(mockService)
+			router.POST("/apiKeys/revoke", handler.Revoke)
+
+			body, _ := json.Marshal(tt.inputBody)
+			req, _ := http.NewRequest("POST", "/apiKeys/revoke", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
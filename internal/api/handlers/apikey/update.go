@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	var req model.APIKeyRevokeRequest
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	key, err := h.service.Revoke(c.Request.Context(), id)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"api_key": key,
+	})
+}
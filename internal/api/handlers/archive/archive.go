@@ -0,0 +1,62 @@
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+	"PR/internal/requestid"
+	"PR/internal/service/archive"
+)
+
+// maxArchiveSize ограничивает тело запроса восстановления
+const maxArchiveSize = 64 << 20
+
+// conflictResponse дополняет обычную ошибку списком конфликтов
+type conflictResponse struct {
+	Err       handlers.Error           `json:"error"`
+	Conflicts []*model.ArchiveConflict `json:"conflicts"`
+}
+
+// Export отдает архив файлом, чтобы его можно было сохранить из браузера
+func (h *ArchiveHandler) Export(c *gin.Context) {
+	a, err := h.service.Export(c.Request.Context())
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	filename := fmt.Sprintf("pr-archive-%s.json", a.ExportedAt.Format("20060102T150405Z"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.JSON(http.StatusOK, a)
+}
+
+// Import восстанавливает архив в пустую базу и возвращает число записей по разделам
+func (h *ArchiveHandler) Import(c *gin.Context) {
+	var a model.Archive
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxArchiveSize)
+	if err := json.NewDecoder(body).Decode(&a); err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	counts, err := h.service.Import(c.Request.Context(), &a)
+	if err != nil {
+		var conflict *archive.ConflictError
+		if errors.As(err, &conflict) {
+			e := mappingServiceError(err)
+			e.RequestID = requestid.FromContext(c.Request.Context())
+			c.AbortWithStatusJSON(e.Status, conflictResponse{Err: e, Conflicts: conflict.Conflicts})
+			return
+		}
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, counts)
+}
@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"errors"
+	"net/http"
+
+	"PR/internal/api/handlers"
+	"PR/internal/service"
+	"PR/internal/service/archive"
+)
+
+type ArchiveHandler struct {
+	service service.ArchiveService
+}
+
+func NewArchiveHandler(serv service.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{service: serv}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch {
+	case errors.Is(err, archive.ErrUnsupportedVersion):
+		e.Code = "UNSUPPORTED_ARCHIVE_VERSION"
+		e.Message = err.Error()
+		e.Status = http.StatusBadRequest
+	case errors.Is(err, archive.ErrConflict):
+		e.Code = "ARCHIVE_CONFLICT"
+		e.Message = err.Error()
+		e.Status = http.StatusConflict
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
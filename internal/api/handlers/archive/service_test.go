@@ -0,0 +1,128 @@
+package archive_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers/archive"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	serviceArchive "PR/internal/service/archive"
+)
+
+func TestExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	mockService := mocks.NewMockArchiveService(t)
+	exportedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	mockService.EXPECT().Export(mock.Anything).
+		Return(&model.Archive{Version: model.ArchiveVersion, ExportedAt: exportedAt}, nil)
+
+	router.GET("/admin/export", archive.NewArchiveHandler(mockService).Export)
+	req, _ := http.NewRequest("GET", "/admin/export", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `attachment; filename="pr-archive-20250301T100000Z.json"`, w.Header().Get("Content-Disposition"))
+
+	var resp model.Archive
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, model.ArchiveVersion, resp.Version)
+}
+
+func TestImport(t *testing.T) {
+	body := fmt.Sprintf(`{"version": %d, "teams": [{"id": "6f1c1b9e-7f39-4d55-9d0f-1c2b3a4d5e6f", "team_name": "backend"}]}`,
+		model.ArchiveVersion)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockArchiveService)
+		expectedStatus int
+		expectedCode   string
+		conflicts      int
+	}{
+		{
+			name: "success",
+			body: body,
+			setupMock: func(m *mocks.MockArchiveService) {
+				m.EXPECT().Import(mock.Anything, mock.MatchedBy(func(a *model.Archive) bool {
+					return len(a.Teams) == 1 && a.Teams[0].TeamName == "backend"
+				})).Return(&model.ArchiveCounts{Teams: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid_json",
+			body:           `{"version":`,
+			setupMock:      func(m *mocks.MockArchiveService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "BAD_REQUEST",
+		},
+		{
+			name: "unsupported_version",
+			body: `{"version": 99}`,
+			setupMock: func(m *mocks.MockArchiveService) {
+				m.EXPECT().Import(mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: 99", serviceArchive.ErrUnsupportedVersion))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "UNSUPPORTED_ARCHIVE_VERSION",
+		},
+		{
+			name: "conflicts",
+			body: body,
+			setupMock: func(m *mocks.MockArchiveService) {
+				m.EXPECT().Import(mock.Anything, mock.Anything).Return(nil, &serviceArchive.ConflictError{
+					Conflicts: []*model.ArchiveConflict{
+						{Entity: "teams", Message: "database already has 1 row(s)"},
+						{Entity: "users", ID: "u1", Message: `unknown team "mobile"`},
+					},
+				})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "ARCHIVE_CONFLICT",
+			conflicts:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockArchiveService(t)
+			tt.setupMock(mockService)
+
+			router.POST("/admin/import", archive.NewArchiveHandler(mockService).Import)
+			req, _ := http.NewRequest("POST", "/admin/import", strings.NewReader(tt.body))
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedCode != "" {
+				var resp struct {
+					Error struct {
+						Code string `json:"code"`
+					} `json:"error"`
+					Conflicts []*model.ArchiveConflict `json:"conflicts"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedCode, resp.Error.Code)
+				assert.Len(t, resp.Conflicts, tt.conflicts)
+			}
+		})
+	}
+}
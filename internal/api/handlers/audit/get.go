@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+func (h *AuditHandler) List(c *gin.Context) {
+	var q model.AuditQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	f, err := toFilter(&q)
+	if err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	events, err := h.service.List(c.Request.Context(), f)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	resp := gin.H{
+		"events": events,
+		"limit":  f.Limit,
+		"offset": f.Offset,
+	}
+	if len(events) == f.Limit {
+		resp["next_offset"] = f.Offset + f.Limit
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func toFilter(q *model.AuditQuery) (*model.AuditFilter, error) {
+	f := &model.AuditFilter{
+		Action:     q.Action,
+		EntityType: q.EntityType,
+		EntityID:   q.EntityID,
+		Limit:      q.Limit,
+		Offset:     q.Offset,
+	}
+
+	// id пользователей и PR в запросах принимаются так же, как в остальных эндпоинтах
+	if f.EntityID != "" && (f.EntityType == model.AuditEntityUser || f.EntityType == model.AuditEntityPR) {
+		f.EntityID = parseID(f.EntityID).String()
+	}
+
+	if q.ActorUserID != "" {
+		id := parseID(q.ActorUserID)
+		f.ActorUserID = &id
+	}
+
+	if q.From != "" {
+		from, err := time.Parse(time.RFC3339, q.From)
+		if err != nil {
+			return nil, err
+		}
+		f.From = &from
+	}
+	if q.To != "" {
+		to, err := time.Parse(time.RFC3339, q.To)
+		if err != nil {
+			return nil, err
+		}
+		f.To = &to
+	}
+
+	return f, nil
+}
+
+func parseID(s string) uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return handlers.StringToUUID(s)
+	}
+	return id
+}
@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"net/http"
+
+	"PR/internal/api/handlers"
+	"PR/internal/service"
+	"PR/internal/service/audit"
+)
+
+type AuditHandler struct {
+	service service.AuditService
+}
+
+func NewAuditHandler(serv service.AuditService) *AuditHandler {
+	return &AuditHandler{service: serv}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch err {
+	case audit.ErrInvalidFilter:
+		e.Code = "BAD_REQUEST"
+		e.Message = "invalid filter or pagination"
+		e.Status = http.StatusBadRequest
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
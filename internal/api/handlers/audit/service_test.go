@@ -0,0 +1,101 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers"
+	"PR/internal/api/handlers/audit"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	serviceAudit "PR/internal/service/audit"
+)
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockAuditService)
+		expectedStatus int
+		nextOffset     bool
+	}{
+		{
+			name:  "success_with_filters",
+			query: "?action=user.set_active&entity_type=user&entity_id=u2&actor_user_id=u1&from=2025-01-01T00:00:00Z&to=2025-02-01T00:00:00Z&limit=1",
+			setupMock: func(m *mocks.MockAuditService) {
+				m.On("List", mock.Anything, mock.MatchedBy(func(f *model.AuditFilter) bool {
+					return f.Action == "user.set_active" &&
+						f.EntityID == handlers.StringToUUID("u2").String() &&
+						*f.ActorUserID == handlers.StringToUUID("u1") &&
+						f.From != nil && f.To != nil &&
+						f.Limit == 1
+				})).Return([]*model.AuditEvent{{ID: uuid.New(), Action: "user.set_active"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			nextOffset:     true,
+		},
+		{
+			name:  "team_entity_id_kept_as_is",
+			query: "?entity_type=team&entity_id=backend&limit=10",
+			setupMock: func(m *mocks.MockAuditService) {
+				m.On("List", mock.Anything, mock.MatchedBy(func(f *model.AuditFilter) bool {
+					return f.EntityID == "backend"
+				})).Return([]*model.AuditEvent{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid_from",
+			query:          "?from=yesterday",
+			setupMock:      func(m *mocks.MockAuditService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid_limit",
+			query:          "?limit=abc",
+			setupMock:      func(m *mocks.MockAuditService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid_filter_from_service",
+			query: "?limit=100000",
+			setupMock: func(m *mocks.MockAuditService) {
+				m.On("List", mock.Anything, mock.Anything).Return(nil, serviceAudit.ErrInvalidFilter)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockAuditService(t)
+			tt.setupMock(mockService)
+
+			handler := audit.NewAuditHandler(mockService)
+			router.GET("/audit", handler.List)
+
+			req, _ := http.NewRequest("GET", "/audit"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var resp map[string]any
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				_, ok := resp["next_offset"]
+				assert.Equal(t, tt.nextOffset, ok)
+			}
+		})
+	}
+}
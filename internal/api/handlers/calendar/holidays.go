@@ -0,0 +1,67 @@
+package calendar
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+// AddHoliday — без team_name праздник действует для всех команд
+func (h *CalendarHandler) AddHoliday(c *gin.Context) {
+	var req model.Holiday
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.Date == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	holiday, err := h.service.AddHoliday(c.Request.Context(), &req)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"holiday": holiday,
+	})
+}
+
+func (h *CalendarHandler) RemoveHoliday(c *gin.Context) {
+	var req model.HolidayRemoveRequest
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.Date == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	err = h.service.RemoveHoliday(c.Request.Context(), req.Date, req.TeamName)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"holiday": req,
+	})
+}
+
+func (h *CalendarHandler) ListHolidays(c *gin.Context) {
+	list, err := h.service.ListHolidays(c.Request.Context(), &model.HolidayFilter{
+		TeamName: c.Query("team_name"),
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+	})
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"holidays": list,
+	})
+}
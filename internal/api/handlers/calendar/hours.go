@@ -0,0 +1,87 @@
+package calendar
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+func (h *CalendarHandler) SetTeamHours(c *gin.Context) {
+	var req model.TeamWorkingHoursRequest
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.TeamName == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	res, err := h.service.SetTeamHours(c.Request.Context(), req.TeamName, &req.WorkingHours)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedule": res,
+	})
+}
+
+func (h *CalendarHandler) GetTeamHours(c *gin.Context) {
+	res, err := h.service.GetTeamHours(c.Request.Context(), c.Query("team_name"))
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedule": res,
+	})
+}
+
+// SetUserHours — пустые поля графика берутся у команды пользователя
+func (h *CalendarHandler) SetUserHours(c *gin.Context) {
+	var req model.UserWorkingHoursRequest
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.UserID == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		userID = handlers.StringToUUID(req.UserID)
+	}
+
+	res, err := h.service.SetUserHours(c.Request.Context(), userID, &req.WorkingHours)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedule": res,
+	})
+}
+
+func (h *CalendarHandler) GetUserHours(c *gin.Context) {
+	raw := c.Query("user_id")
+	userID, err := uuid.Parse(raw)
+	if err != nil {
+		userID = handlers.StringToUUID(raw)
+	}
+
+	res, err := h.service.GetUserSchedule(c.Request.Context(), userID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedule": res,
+	})
+}
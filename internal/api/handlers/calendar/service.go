@@ -0,0 +1,44 @@
+package calendar
+
+import (
+	"net/http"
+
+	"PR/internal/api/handlers"
+	"PR/internal/service"
+	"PR/internal/service/calendar"
+)
+
+type CalendarHandler struct {
+	service service.CalendarService
+}
+
+func NewCalendarHandler(serv service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{service: serv}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch err {
+	case calendar.ErrTeamNotFound, calendar.ErrUserNotFound, calendar.ErrHolidayNotFound:
+		e.Code = "NOT_FOUND"
+		e.Message = "resource not found"
+		e.Status = http.StatusNotFound
+	case calendar.ErrInvalidWorkingHours:
+		e.Code = "BAD_REQUEST"
+		e.Message = "timezone must be an IANA name, work_start and work_end HH:MM with work_start before work_end"
+		e.Status = http.StatusBadRequest
+	case calendar.ErrInvalidHoliday:
+		e.Code = "BAD_REQUEST"
+		e.Message = "dates must be YYYY-MM-DD, from not after to, name at most 200 characters"
+		e.Status = http.StatusBadRequest
+	case calendar.ErrHolidayExists:
+		e.Code = "HOLIDAY_EXISTS"
+		e.Message = "holiday already exists"
+		e.Status = http.StatusConflict
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
@@ -0,0 +1,187 @@
+package calendar_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers/calendar"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	serviceCalendar "PR/internal/service/calendar"
+)
+
+func TestSetTeamHours(t *testing.T) {
+	hours := model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "09:00", WorkEnd: "18:00"}
+
+	tests := []struct {
+		name           string
+		inputBody      interface{}
+		setupMock      func(*mocks.MockCalendarService)
+		expectedStatus int
+	}{
+		{
+			name:      "success",
+			inputBody: model.TeamWorkingHoursRequest{TeamName: "backend", WorkingHours: hours},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("SetTeamHours", mock.Anything, "backend", &hours).
+					Return(&model.TeamWorkingHours{TeamName: "backend", WorkingHours: hours}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "invalid_hours",
+			inputBody: model.TeamWorkingHoursRequest{TeamName: "backend", WorkingHours: model.WorkingHours{Timezone: "Mars/Olympus"}},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("SetTeamHours", mock.Anything, "backend", mock.Anything).
+					Return((*model.TeamWorkingHours)(nil), serviceCalendar.ErrInvalidWorkingHours)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown_team",
+			inputBody: model.TeamWorkingHoursRequest{TeamName: "missing", WorkingHours: hours},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("SetTeamHours", mock.Anything, "missing", mock.Anything).
+					Return((*model.TeamWorkingHours)(nil), serviceCalendar.ErrTeamNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing_team_name",
+			inputBody:      map[string]string{"timezone": "UTC"},
+			setupMock:      func(m *mocks.MockCalendarService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := new(mocks.MockCalendarService)
+			tt.setupMock(mockService)
+			router.POST("/team/workingHours", calendar.NewCalendarHandler(mockService).SetTeamHours)
+
+			body, _ := json.Marshal(tt.inputBody)
+			req, _ := http.NewRequest("POST", "/team/workingHours", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetUserHours_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	mockService := new(mocks.MockCalendarService)
+	mockService.On("GetUserSchedule", mock.Anything, mock.Anything).
+		Return((*model.UserSchedule)(nil), serviceCalendar.ErrUserNotFound)
+	router.GET("/users/workingHours", calendar.NewCalendarHandler(mockService).GetUserHours)
+
+	req, _ := http.NewRequest("GET", "/users/workingHours?user_id=u1", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAddHoliday(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      interface{}
+		setupMock      func(*mocks.MockCalendarService)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:      "success",
+			inputBody: model.Holiday{Date: "2026-12-25", Name: "Christmas"},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("AddHoliday", mock.Anything, &model.Holiday{Date: "2026-12-25", Name: "Christmas"}).
+					Return(&model.Holiday{Date: "2026-12-25", Name: "Christmas"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:      "exists",
+			inputBody: model.Holiday{Date: "2026-12-25", TeamName: "backend"},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("AddHoliday", mock.Anything, mock.Anything).
+					Return((*model.Holiday)(nil), serviceCalendar.ErrHolidayExists)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "HOLIDAY_EXISTS",
+		},
+		{
+			name:      "invalid_date",
+			inputBody: model.Holiday{Date: "25.12.2026"},
+			setupMock: func(m *mocks.MockCalendarService) {
+				m.On("AddHoliday", mock.Anything, mock.Anything).
+					Return((*model.Holiday)(nil), serviceCalendar.ErrInvalidHoliday)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "BAD_REQUEST",
+		},
+		{
+			name:           "missing_date",
+			inputBody:      map[string]string{"name": "Christmas"},
+			setupMock:      func(m *mocks.MockCalendarService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := new(mocks.MockCalendarService)
+			tt.setupMock(mockService)
+			router.POST("/holidays/add", calendar.NewCalendarHandler(mockService).AddHoliday)
+
+			body, _ := json.Marshal(tt.inputBody)
+			req, _ := http.NewRequest("POST", "/holidays/add", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedCode != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedCode)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListHolidays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	mockService := new(mocks.MockCalendarService)
+	mockService.On("ListHolidays", mock.Anything, &model.HolidayFilter{TeamName: "backend", From: "2026-01-01", To: "2026-12-31"}).
+		Return([]*model.Holiday{{Date: "2026-12-25", Name: "Christmas"}}, nil)
+	router.GET("/holidays/list", calendar.NewCalendarHandler(mockService).ListHolidays)
+
+	req, _ := http.NewRequest("GET", "/holidays/list?team_name=backend&from=2026-01-01&to=2026-12-31", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"holidays":[{"date":"2026-12-25","name":"Christmas"}]}`, w.Body.String())
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"PR/internal/api/handlers"
+	"PR/internal/events"
+	"PR/internal/service"
+	"PR/internal/service/team"
+)
+
+type EventsHandler struct {
+	bus         *events.Bus
+	teamService service.TeamService
+	heartbeat   time.Duration
+	closing     <-chan struct{}
+}
+
+// NewEventsHandler — closing закрывается при остановке сервиса, после этого
+// открытые потоки завершаются, чтобы не задерживать graceful shutdown
+func NewEventsHandler(
+	bus *events.Bus,
+	teamService service.TeamService,
+	heartbeat time.Duration,
+	closing <-chan struct{},
+) *EventsHandler {
+	return &EventsHandler{
+		bus:         bus,
+		teamService: teamService,
+		heartbeat:   heartbeat,
+		closing:     closing,
+	}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch {
+	case errors.Is(err, team.ErrNotFound):
+		e.Code = "NOT_FOUND"
+		e.Message = "team not found"
+		e.Status = http.StatusNotFound
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
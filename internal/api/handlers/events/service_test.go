@@ -0,0 +1,172 @@
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers"
+	handlerEvents "PR/internal/api/handlers/events"
+	"PR/internal/events"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/service/team"
+)
+
+func TestStream(t *testing.T) {
+	alice := handlers.StringToUUID("u1")
+	bob := uuid.New()
+
+	tests := []struct {
+		name           string
+		bufferSize     int
+		query          string
+		lastEventID    string
+		setupMock      func(*mocks.MockTeamService)
+		expectedStatus int
+		contains       []string
+		notContains    []string
+	}{
+		{
+			name:           "replay_for_user",
+			bufferSize:     10,
+			query:          "?user_id=u1",
+			lastEventID:    "{e}-1",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusOK,
+			contains:       []string{"id: {e}-3\nevent: pr.merged\ndata: {", "id: {e}-4\n"},
+			notContains:    []string{"id: {e}-1\n", "id: {e}-2\n", "reset"},
+		},
+		{
+			name:       "team_members",
+			bufferSize: 10,
+			query:      "?team_name=backend&last_event_id={e}-1",
+			setupMock: func(m *mocks.MockTeamService) {
+				m.EXPECT().GetTeamByName(mock.Anything, "backend").
+					Return(&model.Team{TeamName: "backend", Members: []*model.TeamMember{{ID: bob}}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			contains:       []string{"id: {e}-2\n", "id: {e}-3\n"},
+			notContains:    []string{"id: {e}-4\n"},
+		},
+		{
+			name:           "new_events_only",
+			bufferSize:     10,
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusOK,
+			notContains:    []string{"id: "},
+		},
+		{
+			name:           "reset_when_evicted",
+			bufferSize:     1,
+			lastEventID:    "{e}-1",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusOK,
+			contains:       []string{"id: {e}-4\nevent: reset\ndata: {}\n\n"},
+			notContains:    []string{"pr.merged"},
+		},
+		{
+			name:  "unknown_team",
+			query: "?team_name=missing",
+			setupMock: func(m *mocks.MockTeamService) {
+				m.EXPECT().GetTeamByName(mock.Anything, "missing").Return(nil, team.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "reset_for_other_replica",
+			bufferSize:     10,
+			lastEventID:    "other-3",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusOK,
+			contains:       []string{"id: {e}-4\nevent: reset\ndata: {}\n\n"},
+			notContains:    []string{"pr.merged"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			bus := events.NewBus(tt.bufferSize)
+			ctx := context.Background()
+			bus.Publish(ctx, &model.Event{Type: model.EventPRCreated, UserIDs: []uuid.UUID{alice}})
+			bus.Publish(ctx, &model.Event{Type: model.EventPRCreated, UserIDs: []uuid.UUID{bob}})
+			bus.Publish(ctx, &model.Event{Type: model.EventPRMerged, UserIDs: []uuid.UUID{alice, bob}})
+			bus.Publish(ctx, &model.Event{Type: model.EventUserActivityChanged, TeamName: "frontend", UserIDs: []uuid.UUID{alice}})
+
+			mockTeams := mocks.NewMockTeamService(t)
+			tt.setupMock(mockTeams)
+
+			// поток закрывается сразу после отправки накопленного, как при остановке сервиса
+			closing := make(chan struct{})
+			close(closing)
+			handler := handlerEvents.NewEventsHandler(bus, mockTeams, time.Minute, closing)
+			router.GET("/events/stream", handler.Stream)
+
+			// ID событий начинаются с поколения шины
+			epoch := strings.NewReplacer("{e}", bus.Epoch())
+			req, _ := http.NewRequest("GET", "/events/stream"+epoch.Replace(tt.query), nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", epoch.Replace(tt.lastEventID))
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			body := w.Body.String()
+			for _, s := range tt.contains {
+				assert.Contains(t, body, epoch.Replace(s))
+			}
+			for _, s := range tt.notContains {
+				s = epoch.Replace(s)
+				assert.False(t, strings.Contains(body, s), "unexpected %q in %q", s, body)
+			}
+		})
+	}
+}
+
+func TestStream_Live(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	bus := events.NewBus(10)
+	router := gin.New()
+	router.GET("/events/stream", handlerEvents.NewEventsHandler(bus, mocks.NewMockTeamService(t), 10*time.Millisecond, nil).Stream)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", srv.URL+"/events/stream", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer resp.Body.Close()
+
+	// подписка уже создана, когда клиент получил заголовки ответа
+	bus.Publish(ctx, &model.Event{Type: model.EventPRCreated})
+
+	var got strings.Builder
+	buf := make([]byte, 1024)
+	for !strings.Contains(got.String(), ": heartbeat") || !strings.Contains(got.String(), "event: pr.created") {
+		n, err := resp.Body.Read(buf)
+		if !assert.NoError(t, err) {
+			return
+		}
+		got.Write(buf[:n])
+	}
+}
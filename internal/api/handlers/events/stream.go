@@ -0,0 +1,117 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+// Stream отдает события в формате Server-Sent Events. ?team_name= и ?user_id=
+// ограничивают поток, Last-Event-ID (заголовок или ?last_event_id=) продолжает
+// его после переподключения. Если пропущенные события уже вытеснены из буфера
+// или ID выдан другой репликой, первым приходит событие reset — клиенту нужно
+// заново загрузить состояние
+func (h *EventsHandler) Stream(c *gin.Context) {
+	filter, err := h.filter(c)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	sub, replay, complete := h.bus.Subscribe(filter, lastEventID(c))
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// nginx не должен буферизовать поток
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	w := c.Writer
+	if !complete {
+		fmt.Fprintf(w, "id: %s\nevent: reset\ndata: {}\n\n", sub.Position)
+	}
+	for _, e := range replay {
+		if err := writeEvent(w, e); err != nil {
+			return
+		}
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.closing:
+			return
+		case e, ok := <-sub.C:
+			// шина отключила отставшего подписчика — клиент переподключится с Last-Event-ID
+			if !ok {
+				zerolog.Ctx(ctx).Warn().Msg("event stream subscriber is too slow, disconnected")
+				return
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		w.Flush()
+	}
+}
+
+// filter берет состав команды на момент подписки: события PR не всегда знают команду
+func (h *EventsHandler) filter(c *gin.Context) (*model.EventFilter, error) {
+	f := &model.EventFilter{TeamName: c.Query("team_name")}
+
+	if id := c.Query("user_id"); id != "" {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			userID = handlers.StringToUUID(id)
+		}
+		f.UserID = &userID
+	}
+
+	if f.TeamName != "" {
+		team, err := h.teamService.GetTeamByName(c.Request.Context(), f.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range team.Members {
+			f.TeamMembers = append(f.TeamMembers, m.ID)
+		}
+	}
+	return f, nil
+}
+
+func lastEventID(c *gin.Context) string {
+	if id := c.GetHeader("Last-Event-ID"); id != "" {
+		return id
+	}
+	return c.Query("last_event_id")
+}
+
+func writeEvent(w io.Writer, e *model.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
@@ -0,0 +1,76 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	statusOK       = "ok"
+	statusNotReady = "not_ready"
+)
+
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status": statusOK,
+	})
+}
+
+func (h *HealthHandler) Ready(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), checkTimeout)
+	defer cancel()
+
+	checks := gin.H{
+		"shutdown":   h.checkShutdown(),
+		"database":   h.checkDatabase(ctx),
+		"migrations": h.checkMigrations(ctx),
+	}
+
+	status, code := statusOK, http.StatusOK
+	for _, res := range checks {
+		if res != statusOK {
+			status, code = statusNotReady, http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	c.JSON(code, gin.H{
+		"status": status,
+		"checks": checks,
+	})
+}
+
+func (h *HealthHandler) checkShutdown() string {
+	select {
+	case <-h.closing:
+		return "shutting down"
+	default:
+		return statusOK
+	}
+}
+
+func (h *HealthHandler) checkDatabase(ctx context.Context) string {
+	if err := h.pinger.Ping(ctx); err != nil {
+		return err.Error()
+	}
+	return statusOK
+}
+
+// checkMigrations допускает схему новее ожидаемой, чтобы старые реплики
+// не выпадали из балансировки во время выкатки новой версии
+func (h *HealthHandler) checkMigrations(ctx context.Context) string {
+	version, dirty, err := h.schema.Version(ctx)
+	if err != nil {
+		return err.Error()
+	}
+	if dirty {
+		return fmt.Sprintf("version %d is dirty", version)
+	}
+	if version < h.schemaVersion {
+		return fmt.Sprintf("version %d, expected %d", version, h.schemaVersion)
+	}
+	return statusOK
+}
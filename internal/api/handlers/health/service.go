@@ -0,0 +1,33 @@
+package health
+
+import (
+	"time"
+
+	"PR/internal/client/db"
+	"PR/internal/repository"
+)
+
+const checkTimeout = 2 * time.Second
+
+type HealthHandler struct {
+	pinger        db.Pinger
+	schema        repository.SchemaRepository
+	schemaVersion uint
+	closing       <-chan struct{}
+}
+
+// NewHandler принимает версию схемы, с которой собран бинарник, и канал,
+// который закрывается при начале остановки приложения
+func NewHandler(
+	pinger db.Pinger,
+	schema repository.SchemaRepository,
+	schemaVersion uint,
+	closing <-chan struct{},
+) *HealthHandler {
+	return &HealthHandler{
+		pinger:        pinger,
+		schema:        schema,
+		schemaVersion: schemaVersion,
+		closing:       closing,
+	}
+}
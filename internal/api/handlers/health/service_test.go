@@ -0,0 +1,130 @@
+package health_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers/health"
+	"PR/internal/mocks"
+)
+
+func TestReady(t *testing.T) {
+	tests := []struct {
+		name           string
+		closing        bool
+		setupMocks     func(*mocks.MockPinger, *mocks.MockSchemaRepository)
+		expectedStatus int
+		expectedChecks map[string]string
+	}{
+		{
+			name: "ready",
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(nil)
+				s.On("Version", mock.Anything).Return(uint(4), false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "ok"},
+		},
+		{
+			name: "newer_schema_is_ready",
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(nil)
+				s.On("Version", mock.Anything).Return(uint(5), false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "ok"},
+		},
+		{
+			name: "database_unavailable",
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(errors.New("connection refused"))
+				s.On("Version", mock.Anything).Return(uint(0), false, errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "connection refused", "migrations": "connection refused", "shutdown": "ok"},
+		},
+		{
+			name: "migrations_behind",
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(nil)
+				s.On("Version", mock.Anything).Return(uint(3), false, nil)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "version 3, expected 4", "shutdown": "ok"},
+		},
+		{
+			name: "migrations_dirty",
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(nil)
+				s.On("Version", mock.Anything).Return(uint(4), true, nil)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "version 4 is dirty", "shutdown": "ok"},
+		},
+		{
+			name:    "shutting_down",
+			closing: true,
+			setupMocks: func(p *mocks.MockPinger, s *mocks.MockSchemaRepository) {
+				p.On("Ping", mock.Anything).Return(nil)
+				s.On("Version", mock.Anything).Return(uint(4), false, nil)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "shutting down"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			pinger := mocks.NewMockPinger(t)
+			schema := mocks.NewMockSchemaRepository(t)
+			tt.setupMocks(pinger, schema)
+
+			closing := make(chan struct{})
+			if tt.closing {
+				close(closing)
+			}
+
+			handler := health.NewHandler(pinger, schema, 4, closing)
+			router.GET("/health/ready", handler.Ready)
+
+			req, _ := http.NewRequest("GET", "/health/ready", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var resp struct {
+				Status string            `json:"status"`
+				Checks map[string]string `json:"checks"`
+			}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedChecks, resp.Checks)
+		})
+	}
+}
+
+func TestLive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	handler := health.NewHandler(mocks.NewMockPinger(t), mocks.NewMockSchemaRepository(t), 4, make(chan struct{}))
+	router.GET("/health/live", handler.Live)
+
+	req, _ := http.NewRequest("GET", "/health/live", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
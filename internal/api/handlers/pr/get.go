@@ -29,3 +29,27 @@ func (h *PullRequestHandler) GetByReviewer(c *gin.Context) {
 	})
 
 }
+
+func (h *PullRequestHandler) GetHistory(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	prUUID, err := uuid.Parse(prID)
+	if err != nil {
+		prUUID = handlers.StringToUUID(prID)
+	}
+
+	history, err := h.service.GetHistory(c.Request.Context(), prUUID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pull_request_id": prID,
+		"history":         history,
+	})
+}
@@ -39,6 +39,26 @@ func mappingServiceError(err error) handlers.Error {
 		e.Code = "NOT_ASSIGNED"
 		e.Message = "reviewer is not assigned to this PR"
 		e.Status = http.StatusConflict
+	case pr.ErrNotActive:
+		e.Code = "NOT_ACTIVE"
+		e.Message = "user is not active"
+		e.Status = http.StatusConflict
+	case pr.ErrAuthorReviewer:
+		e.Code = "AUTHOR_REVIEWER"
+		e.Message = "author cannot review own PR"
+		e.Status = http.StatusConflict
+	case pr.ErrAlreadyAssigned:
+		e.Code = "ALREADY_ASSIGNED"
+		e.Message = "reviewer is already assigned to this PR"
+		e.Status = http.StatusConflict
+	case pr.ErrReviewersLimit:
+		e.Code = "REVIEWERS_LIMIT"
+		e.Message = "PR already has the maximum number of reviewers"
+		e.Status = http.StatusConflict
+	case pr.ErrForbidden:
+		e.Code = "FORBIDDEN"
+		e.Message = "caller does not own this PR"
+		e.Status = http.StatusForbidden
 
 	default:
 		e.Code = "UNKNOW"
@@ -2,6 +2,7 @@ package pr_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -11,10 +12,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"PR/internal/api/handlers"
 	"PR/internal/api/handlers/pr"
+	"PR/internal/api/middleware"
+	"PR/internal/events"
 	"PR/internal/mocks"
 	"PR/internal/model"
+	"PR/internal/repository/memory"
+	serviceAPIKey "PR/internal/service/apikey"
 	servicePr "PR/internal/service/pr"
 )
 
@@ -191,6 +198,17 @@ func TestMerge(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "forbidden",
+			inputBody: model.PullRequestInMerge{
+				ID: uuid.New().String(),
+			},
+			setupMock: func(m *mocks.MockPullRequestService, id uuid.UUID) {
+				m.On("Merge", mock.Anything, id).
+					Return((*model.PullRequest)(nil), servicePr.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -219,6 +237,8 @@ func TestMerge(t *testing.T) {
 }
 
 func TestReassign(t *testing.T) {
+	target := uuid.New()
+
 	tests := []struct {
 		name           string
 		inputBody      model.PullRequestInReassign
@@ -232,7 +252,7 @@ func TestReassign(t *testing.T) {
 				PrID:          uuid.New().String(),
 			},
 			setupMock: func(m *mocks.MockPullRequestService, oldID, prID uuid.UUID) {
-				m.On("ReassignReviewers", mock.Anything, oldID, prID).
+				m.On("ReassignReviewers", mock.Anything, oldID, prID, (*uuid.UUID)(nil)).
 					Return(&model.PullRequest{
 						ID:       prID,
 						Name:     "Test PR",
@@ -248,7 +268,7 @@ func TestReassign(t *testing.T) {
 				PrID:          uuid.New().String(),
 			},
 			setupMock: func(m *mocks.MockPullRequestService, oldID, prID uuid.UUID) {
-				m.On("ReassignReviewers", mock.Anything, oldID, prID).
+				m.On("ReassignReviewers", mock.Anything, oldID, prID, (*uuid.UUID)(nil)).
 					Return((*model.PullRequest)(nil), uuid.Nil, servicePr.ErrNoCandidate)
 			},
 			expectedStatus: http.StatusConflict,
@@ -260,11 +280,38 @@ func TestReassign(t *testing.T) {
 				PrID:          uuid.New().String(),
 			},
 			setupMock: func(m *mocks.MockPullRequestService, oldID, prID uuid.UUID) {
-				m.On("ReassignReviewers", mock.Anything, oldID, prID).
+				m.On("ReassignReviewers", mock.Anything, oldID, prID, (*uuid.UUID)(nil)).
 					Return((*model.PullRequest)(nil), uuid.Nil, servicePr.ErrNoAssigned)
 			},
 			expectedStatus: http.StatusConflict,
 		},
+		{
+			name: "explicit_target",
+			inputBody: model.PullRequestInReassign{
+				OldReviewerID: uuid.New().String(),
+				PrID:          uuid.New().String(),
+				NewReviewerID: target.String(),
+			},
+			setupMock: func(m *mocks.MockPullRequestService, oldID, prID uuid.UUID) {
+				m.On("ReassignReviewers", mock.Anything, oldID, prID, mock.MatchedBy(func(id *uuid.UUID) bool {
+					return id != nil && *id == target
+				})).Return(&model.PullRequest{ID: prID, AssignedReviewers: []uuid.UUID{target}}, target, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "explicit_target_not_active",
+			inputBody: model.PullRequestInReassign{
+				OldReviewerID: uuid.New().String(),
+				PrID:          uuid.New().String(),
+				NewReviewerID: target.String(),
+			},
+			setupMock: func(m *mocks.MockPullRequestService, oldID, prID uuid.UUID) {
+				m.On("ReassignReviewers", mock.Anything, oldID, prID, mock.Anything).
+					Return((*model.PullRequest)(nil), uuid.Nil, servicePr.ErrNotActive)
+			},
+			expectedStatus: http.StatusConflict,
+		},
 	}
 
 	for _, tt := range tests {
@@ -292,3 +339,231 @@ func TestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewerAdjustments(t *testing.T) {
+	prID := uuid.New()
+	reviewerID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		inputBody      model.PullRequestInReviewer
+		setupMock      func(*mocks.MockPullRequestService)
+		expectedStatus int
+	}{
+		{
+			name:      "add_success",
+			path:      "/pr/addReviewer",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("AddReviewer", mock.Anything, prID, reviewerID).
+					Return(&model.PullRequest{ID: prID, AssignedReviewers: []uuid.UUID{reviewerID}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "add_limit",
+			path:      "/pr/addReviewer",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("AddReviewer", mock.Anything, prID, reviewerID).
+					Return((*model.PullRequest)(nil), servicePr.ErrReviewersLimit)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "add_missing_reviewer",
+			path:           "/pr/addReviewer",
+			inputBody:      model.PullRequestInReviewer{PrID: prID.String()},
+			setupMock:      func(m *mocks.MockPullRequestService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "remove_success",
+			path:      "/pr/removeReviewer",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("RemoveReviewer", mock.Anything, prID, reviewerID).
+					Return(&model.PullRequest{ID: prID, AssignedReviewers: []uuid.UUID{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "remove_not_assigned",
+			path:      "/pr/removeReviewer",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("RemoveReviewer", mock.Anything, prID, reviewerID).
+					Return((*model.PullRequest)(nil), servicePr.ErrNoAssigned)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:      "review_success",
+			path:      "/pr/review",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("Review", mock.Anything, prID, reviewerID).
+					Return(&model.PullRequest{ID: prID}, &model.PRReview{PRID: prID, ReviewerID: reviewerID}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "review_forbidden",
+			path:      "/pr/review",
+			inputBody: model.PullRequestInReviewer{PrID: prID.String(), ReviewerID: reviewerID.String()},
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("Review", mock.Anything, prID, reviewerID).
+					Return((*model.PullRequest)(nil), (*model.PRReview)(nil), servicePr.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "review_missing_reviewer",
+			path:           "/pr/review",
+			inputBody:      model.PullRequestInReviewer{PrID: prID.String()},
+			setupMock:      func(m *mocks.MockPullRequestService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := new(mocks.MockPullRequestService)
+			tt.setupMock(mockService)
+
+			handler := pr.NewPullRequestHandler(mockService)
+			router.POST("/pr/addReviewer", handler.AddReviewer)
+			router.POST("/pr/removeReviewer", handler.RemoveReviewer)
+			router.POST("/pr/review", handler.Review)
+
+			body, _ := json.Marshal(tt.inputBody)
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	prID := uuid.New()
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockPullRequestService)
+		expectedStatus int
+	}{
+		{
+			name:  "success",
+			query: "?pull_request_id=" + prID.String(),
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("GetHistory", mock.Anything, prID).
+					Return([]*model.ReviewerAssignment{
+						{PRID: prID, ReviewerID: uuid.New(), Reason: model.AssignmentInitial},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "string_id",
+			query: "?pull_request_id=pr-1001",
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("GetHistory", mock.Anything, handlers.StringToUUID("pr-1001")).
+					Return([]*model.ReviewerAssignment{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "not_found",
+			query: "?pull_request_id=" + prID.String(),
+			setupMock: func(m *mocks.MockPullRequestService) {
+				m.On("GetHistory", mock.Anything, prID).
+					Return(nil, servicePr.ErrNotFound)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing_id",
+			query:          "",
+			setupMock:      func(m *mocks.MockPullRequestService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockPullRequestService(t)
+			tt.setupMock(mockService)
+
+			handler := pr.NewPullRequestHandler(mockService)
+			router.GET("/pullRequest/history", handler.GetHistory)
+
+			req, _ := http.NewRequest("GET", "/pullRequest/history"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestMerge_MemberAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	prRepo := memory.NewPullRequestRepository(store)
+	userRepo := memory.NewUserRepository(store)
+	txMgr := memory.NewTxManager(store)
+
+	authorID, reviewerID, prID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, memory.NewTeamRepository(store).CreateTeam(ctx, "backend"))
+	require.NoError(t, userRepo.Upsert(ctx, []*model.User{
+		{ID: authorID, Username: "alice", TeamName: "backend", IsActive: true},
+		{ID: reviewerID, Username: "bob", TeamName: "backend", IsActive: true},
+	}))
+	require.NoError(t, prRepo.CreatePR(ctx, &model.PullRequest{ID: prID, Name: "feature", AuthorID: authorID, Status: "OPEN"}))
+
+	keys := serviceAPIKey.NewService(memory.NewAPIKeyRepository(store), txMgr, "")
+	serviceKey, err := keys.Create(ctx, "ci", model.RoleMember, nil)
+	require.NoError(t, err)
+	reviewerKey, err := keys.Create(ctx, "bob-cli", model.RoleMember, &reviewerID)
+	require.NoError(t, err)
+
+	prs := servicePr.NewService(prRepo, userRepo, memory.NewCalendarRepository(store),
+		memory.NewAuditRepository(store), txMgr, events.NewBus(0))
+	router := gin.New()
+	router.POST("/pullRequest/merge", middleware.APIKeyAuth(keys), middleware.RequireRole(model.RoleMember),
+		pr.NewPullRequestHandler(prs).Merge)
+
+	merge := func(key string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		body, _ := json.Marshal(model.PullRequestInMerge{ID: prID.String()})
+		req, _ := http.NewRequest("POST", "/pullRequest/merge", bytes.NewBuffer(body))
+		req.Header.Set(middleware.APIKeyHeader, key)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// ключ, привязанный к ревьюеру, проверяется как его JWT: мержит только автор
+	w := merge(reviewerKey.Key)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.JSONEq(t, `{"error":{"code":"FORBIDDEN","message":"caller does not own this PR"}}`, w.Body.String())
+
+	// сервисный ключ member без пользователя мержит PR
+	w = merge(serviceKey.Key)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"MERGED"`)
+}
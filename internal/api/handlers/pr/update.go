@@ -54,7 +54,16 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 		prID = handlers.StringToUUID(req.PrID)
 	}
 
-	pr, replacedBy, err := h.service.ReassignReviewers(c.Request.Context(), oldID, prID)
+	var newID *uuid.UUID
+	if req.NewReviewerID != "" {
+		id, err := uuid.Parse(req.NewReviewerID)
+		if err != nil {
+			id = handlers.StringToUUID(req.NewReviewerID)
+		}
+		newID = &id
+	}
+
+	pr, replacedBy, err := h.service.ReassignReviewers(c.Request.Context(), oldID, prID, newID)
 	if err != nil {
 		handlers.NewErrorResponse(c, mappingServiceError(err))
 		return
@@ -66,3 +75,95 @@ func (h *PullRequestHandler) Reassign(c *gin.Context) {
 	})
 
 }
+
+func (h *PullRequestHandler) AddReviewer(c *gin.Context) {
+	var req model.PullRequestInReviewer
+
+	err := c.BindJSON(&req)
+	if err != nil || req.PrID == "" || req.ReviewerID == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	prID, err := uuid.Parse(req.PrID)
+	if err != nil {
+		prID = handlers.StringToUUID(req.PrID)
+	}
+
+	reviewerID, err := uuid.Parse(req.ReviewerID)
+	if err != nil {
+		reviewerID = handlers.StringToUUID(req.ReviewerID)
+	}
+
+	pr, err := h.service.AddReviewer(c.Request.Context(), prID, reviewerID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pr": pr,
+	})
+}
+
+func (h *PullRequestHandler) RemoveReviewer(c *gin.Context) {
+	var req model.PullRequestInReviewer
+
+	err := c.BindJSON(&req)
+	if err != nil || req.PrID == "" || req.ReviewerID == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	prID, err := uuid.Parse(req.PrID)
+	if err != nil {
+		prID = handlers.StringToUUID(req.PrID)
+	}
+
+	reviewerID, err := uuid.Parse(req.ReviewerID)
+	if err != nil {
+		reviewerID = handlers.StringToUUID(req.ReviewerID)
+	}
+
+	pr, err := h.service.RemoveReviewer(c.Request.Context(), prID, reviewerID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pr": pr,
+	})
+}
+
+// Review отмечает ревью назначенного ревьюера, от него считается SLA первого ревью
+func (h *PullRequestHandler) Review(c *gin.Context) {
+	var req model.PullRequestInReviewer
+
+	err := c.BindJSON(&req)
+	if err != nil || req.PrID == "" || req.ReviewerID == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	prID, err := uuid.Parse(req.PrID)
+	if err != nil {
+		prID = handlers.StringToUUID(req.PrID)
+	}
+
+	reviewerID, err := uuid.Parse(req.ReviewerID)
+	if err != nil {
+		reviewerID = handlers.StringToUUID(req.ReviewerID)
+	}
+
+	pr, review, err := h.service.Review(c.Request.Context(), prID, reviewerID)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pr":     pr,
+		"review": review,
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"PR/internal/requestid"
 )
 
 type ErrorResponse struct {
@@ -13,10 +15,13 @@ type ErrorResponse struct {
 type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
-	Status  int    `json:"-"`
+	// по нему ошибку можно найти в логах
+	RequestID string `json:"request_id,omitempty"`
+	Status    int    `json:"-"`
 }
 
 func NewErrorResponse(c *gin.Context, err Error) {
+	err.RequestID = requestid.FromContext(c.Request.Context())
 	c.AbortWithStatusJSON(err.Status, ErrorResponse{Err: err})
 }
 
@@ -27,3 +32,51 @@ func BadRequestError() Error {
 		Status:  http.StatusBadRequest,
 	}
 }
+
+func UnauthorizedError() Error {
+	return Error{
+		Code:    "UNAUTHORIZED",
+		Message: "missing or invalid credentials",
+		Status:  http.StatusUnauthorized,
+	}
+}
+
+func ForbiddenError() Error {
+	return Error{
+		Code:    "FORBIDDEN",
+		Message: "not enough permissions",
+		Status:  http.StatusForbidden,
+	}
+}
+
+func InternalError(err error) Error {
+	return Error{
+		Code:    "UNKNOW",
+		Message: err.Error(),
+		Status:  http.StatusInternalServerError,
+	}
+}
+
+func IdempotencyMismatchError() Error {
+	return Error{
+		Code:    "IDEMPOTENCY_KEY_MISMATCH",
+		Message: "idempotency key was used with a different request",
+		Status:  http.StatusUnprocessableEntity,
+	}
+}
+
+func IdempotencyInProgressError() Error {
+	return Error{
+		Code:    "IDEMPOTENCY_IN_PROGRESS",
+		Message: "request with this idempotency key is still in progress",
+		Status:  http.StatusConflict,
+	}
+}
+
+func TooManyRequestsError() Error {
+	return Error{
+		Code:    "TOO_MANY_REQUESTS",
+		Message: "rate limit exceeded",
+		Status:  http.StatusTooManyRequests,
+	}
+}
@@ -0,0 +1,36 @@
+package sla
+
+import (
+	"net/http"
+
+	"PR/internal/api/handlers"
+	"PR/internal/service"
+	"PR/internal/service/sla"
+)
+
+type SLAHandler struct {
+	service service.SLAService
+}
+
+func NewSLAHandler(serv service.SLAService) *SLAHandler {
+	return &SLAHandler{service: serv}
+}
+
+func mappingServiceError(err error) handlers.Error {
+	var e handlers.Error
+	switch err {
+	case sla.ErrNotFound, sla.ErrTeamNotFound:
+		e.Code = "NOT_FOUND"
+		e.Message = "resource not found"
+		e.Status = http.StatusNotFound
+	case sla.ErrInvalidSettings:
+		e.Code = "BAD_REQUEST"
+		e.Message = "first_review_hours must be positive, reminder_interval_hours and auto-reassign policy non-negative, webhook_url a public http(s) URL"
+		e.Status = http.StatusBadRequest
+	default:
+		e.Code = "UNKNOW"
+		e.Message = err.Error()
+		e.Status = http.StatusInternalServerError
+	}
+	return e
+}
@@ -0,0 +1,141 @@
+package sla_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/handlers/sla"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	serviceSLA "PR/internal/service/sla"
+)
+
+func TestSetTeamSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      interface{}
+		setupMock      func(*mocks.MockSLAService)
+		expectedStatus int
+	}{
+		{
+			name:      "success",
+			inputBody: model.TeamSLA{TeamName: "backend", FirstReviewHours: 24},
+			setupMock: func(m *mocks.MockSLAService) {
+				m.On("SetTeamSettings", mock.Anything, &model.TeamSLA{TeamName: "backend", FirstReviewHours: 24}).
+					Return(&model.TeamSLA{TeamName: "backend", FirstReviewHours: 24}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "invalid_settings",
+			inputBody: model.TeamSLA{TeamName: "backend"},
+			setupMock: func(m *mocks.MockSLAService) {
+				m.On("SetTeamSettings", mock.Anything, mock.Anything).
+					Return((*model.TeamSLA)(nil), serviceSLA.ErrInvalidSettings)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown_team",
+			inputBody: model.TeamSLA{TeamName: "missing", FirstReviewHours: 24},
+			setupMock: func(m *mocks.MockSLAService) {
+				m.On("SetTeamSettings", mock.Anything, mock.Anything).
+					Return((*model.TeamSLA)(nil), serviceSLA.ErrTeamNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing_team_name",
+			inputBody:      map[string]int{"first_review_hours": 24},
+			setupMock:      func(m *mocks.MockSLAService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := new(mocks.MockSLAService)
+			tt.setupMock(mockService)
+			router.POST("/team/sla", sla.NewSLAHandler(mockService).SetTeamSettings)
+
+			body, _ := json.Marshal(tt.inputBody)
+			req, _ := http.NewRequest("POST", "/team/sla", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTeamSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockSLAService)
+		expectedStatus int
+	}{
+		{
+			name: "success",
+			setupMock: func(m *mocks.MockSLAService) {
+				m.On("GetTeamSettings", mock.Anything, "backend").
+					Return(&model.TeamSLA{TeamName: "backend", FirstReviewHours: 24}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "not_configured",
+			setupMock: func(m *mocks.MockSLAService) {
+				m.On("GetTeamSettings", mock.Anything, "backend").
+					Return((*model.TeamSLA)(nil), serviceSLA.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := new(mocks.MockSLAService)
+			tt.setupMock(mockService)
+			router.GET("/team/sla", sla.NewSLAHandler(mockService).GetTeamSettings)
+
+			req, _ := http.NewRequest("GET", "/team/sla?team_name=backend", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	mockService := new(mocks.MockSLAService)
+	mockService.On("GetStats", mock.Anything).
+		Return([]*model.SLAStats{{TeamName: "backend", Total: 2, OnTime: 1, Late: 1, BreachRate: 0.5}}, nil)
+	router.GET("/statistics/sla", sla.NewSLAHandler(mockService).GetStats)
+
+	req, _ := http.NewRequest("GET", "/statistics/sla", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"team_name":"backend","first_review_hours":0,"total":2,"on_time":1,"late":1,"overdue":0,"pending":0,"breach_rate":0.5}]`, w.Body.String())
+}
@@ -0,0 +1,42 @@
+package sla
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+func (h *SLAHandler) SetTeamSettings(c *gin.Context) {
+	var req model.TeamSLA
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil || req.TeamName == "" {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	settings, err := h.service.SetTeamSettings(c.Request.Context(), &req)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sla": settings,
+	})
+}
+
+func (h *SLAHandler) GetTeamSettings(c *gin.Context) {
+	settings, err := h.service.GetTeamSettings(c.Request.Context(), c.Query("team_name"))
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sla": settings,
+	})
+}
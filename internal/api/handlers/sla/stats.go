@@ -0,0 +1,19 @@
+package sla
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"PR/internal/api/handlers"
+)
+
+func (h *SLAHandler) GetStats(c *gin.Context) {
+	stats, err := h.service.GetStats(c.Request.Context())
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
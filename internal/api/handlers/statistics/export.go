@@ -0,0 +1,152 @@
+package statistics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"PR/internal/api/handlers"
+)
+
+type exportFormat int
+
+const (
+	formatJSON exportFormat = iota
+	formatCSV
+	formatNDJSON
+)
+
+const (
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// через сколько строк сбрасывать буфер клиенту
+	flushEvery = 100
+)
+
+// negotiateFormat выбирает формат ответа: параметр format имеет приоритет над заголовком Accept
+func negotiateFormat(c *gin.Context) exportFormat {
+	switch strings.ToLower(c.Query("format")) {
+	case "csv":
+		return formatCSV
+	case "ndjson":
+		return formatNDJSON
+	case "json":
+		return formatJSON
+	}
+
+	accept := c.GetHeader("Accept")
+	switch {
+	case strings.Contains(accept, contentTypeCSV):
+		return formatCSV
+	case strings.Contains(accept, contentTypeNDJSON):
+		return formatNDJSON
+	}
+	return formatJSON
+}
+
+// exportWriter пишет строки в ответ по мере их получения из репозитория.
+// Заголовки ответа отправляются только с первой строкой, поэтому ошибку,
+// случившуюся до нее, еще можно вернуть обычным ErrorResponse.
+type exportWriter struct {
+	c        *gin.Context
+	format   exportFormat
+	filename string
+	header   []string
+	started  bool
+	rows     int
+
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func newExportWriter(c *gin.Context, format exportFormat, filename string, header []string) *exportWriter {
+	return &exportWriter{
+		c:        c,
+		format:   format,
+		filename: filename,
+		header:   header,
+	}
+}
+
+func (w *exportWriter) start() error {
+	w.started = true
+
+	switch w.format {
+	case formatCSV:
+		w.c.Header("Content-Type", contentTypeCSV+"; charset=utf-8")
+		w.c.Header("Content-Disposition", `attachment; filename="`+w.filename+`.csv"`)
+		w.c.Status(http.StatusOK)
+		w.csv = csv.NewWriter(w.c.Writer)
+		return w.csv.Write(w.header)
+	default:
+		w.c.Header("Content-Type", contentTypeNDJSON)
+		w.c.Status(http.StatusOK)
+		w.json = json.NewEncoder(w.c.Writer)
+		return nil
+	}
+}
+
+// Write отправляет одну строку: record используется для CSV, v для NDJSON
+func (w *exportWriter) Write(v any, record []string) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if w.format == formatCSV {
+		err = w.csv.Write(record)
+	} else {
+		err = w.json.Encode(v)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.rows++
+	if w.rows%flushEvery == 0 {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *exportWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+// Finish завершает выгрузку. Если ошибка случилась после отправки заголовков,
+// ответ просто обрывается: клиент увидит неполный файл.
+func (w *exportWriter) Finish(err error) {
+	if err != nil {
+		if !w.started {
+			handlers.NewErrorResponse(w.c, mappingServiceError(err))
+			return
+		}
+		zerolog.Ctx(w.c.Request.Context()).Error().Msgf("export %s interrupted after %d rows: %v", w.filename, w.rows, err)
+		w.c.Abort()
+		return
+	}
+
+	if !w.started {
+		if err := w.start(); err != nil {
+			zerolog.Ctx(w.c.Request.Context()).Error().Msgf("export %s error: %v", w.filename, err)
+			return
+		}
+	}
+	if err := w.flush(); err != nil {
+		zerolog.Ctx(w.c.Request.Context()).Error().Msgf("export %s flush error: %v", w.filename, err)
+	}
+}
@@ -2,14 +2,27 @@ package statistics
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"PR/internal/api/handlers"
+	"PR/internal/model"
 )
 
 func (h *StatisticsHandler) GetReviewerStats(c *gin.Context) {
-	stats, err := h.service.GetReviewerStatistics(c.Request.Context())
+	f, ok := parseFilter(c)
+	if !ok {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	if format := negotiateFormat(c); format != formatJSON {
+		h.exportReviewerStats(c, f, format)
+		return
+	}
+
+	stats, err := h.service.GetReviewerStatistics(c.Request.Context(), f)
 	if err != nil {
 		handlers.NewErrorResponse(c, mappingServiceError(err))
 		return
@@ -19,7 +32,18 @@ func (h *StatisticsHandler) GetReviewerStats(c *gin.Context) {
 }
 
 func (h *StatisticsHandler) GetPRStats(c *gin.Context) {
-	stats, err := h.service.GetPRStatistics(c.Request.Context())
+	f, ok := parseFilter(c)
+	if !ok {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	if format := negotiateFormat(c); format != formatJSON {
+		h.exportPRStats(c, f, format)
+		return
+	}
+
+	stats, err := h.service.GetPRStatistics(c.Request.Context(), f)
 	if err != nil {
 		handlers.NewErrorResponse(c, mappingServiceError(err))
 		return
@@ -27,3 +51,42 @@ func (h *StatisticsHandler) GetPRStats(c *gin.Context) {
 
 	c.JSON(http.StatusOK, stats)
 }
+
+func parseFilter(c *gin.Context) (model.StatisticsFilter, bool) {
+	var f model.StatisticsFilter
+	if v := c.Query("include_history"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return f, false
+		}
+		f.IncludeHistory = b
+	}
+	return f, true
+}
+
+func (h *StatisticsHandler) exportReviewerStats(c *gin.Context, f model.StatisticsFilter, format exportFormat) {
+	w := newExportWriter(c, format, "reviewers", []string{"reviewer_id", "reviewer_name", "assigned_count"})
+
+	err := h.service.StreamReviewerStatistics(c.Request.Context(), f, func(s *model.ReviewerStats) error {
+		return w.Write(s, []string{
+			s.ReviewerID.String(),
+			s.ReviewerName,
+			strconv.Itoa(s.AssignedCount),
+		})
+	})
+	w.Finish(err)
+}
+
+func (h *StatisticsHandler) exportPRStats(c *gin.Context, f model.StatisticsFilter, format exportFormat) {
+	w := newExportWriter(c, format, "prs", []string{"pr_id", "pr_name", "status", "reviewer_count"})
+
+	err := h.service.StreamPRStatistics(c.Request.Context(), f, func(s *model.PRStats) error {
+		return w.Write(s, []string{
+			s.PRID.String(),
+			s.PRName,
+			s.Status,
+			strconv.Itoa(s.ReviewerCount),
+		})
+	})
+	w.Finish(err)
+}
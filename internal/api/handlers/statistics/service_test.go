@@ -27,7 +27,7 @@ func TestGetReviewerStats(t *testing.T) {
 		{
 			name: "success",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetReviewerStatistics", mock.Anything).
+				m.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return([]*model.ReviewerStats{
 						{
 							ReviewerID:    uuid.New(),
@@ -53,7 +53,7 @@ func TestGetReviewerStats(t *testing.T) {
 		{
 			name: "service_error",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetReviewerStatistics", mock.Anything).
+				m.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return(([]*model.ReviewerStats)(nil), errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -62,7 +62,7 @@ func TestGetReviewerStats(t *testing.T) {
 		{
 			name: "empty_result",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetReviewerStatistics", mock.Anything).
+				m.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return([]*model.ReviewerStats{}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -107,7 +107,7 @@ func TestGetPRStats(t *testing.T) {
 		{
 			name: "success",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetPRStatistics", mock.Anything).
+				m.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return([]*model.PRStats{
 						{
 							PRID:          uuid.New(),
@@ -136,7 +136,7 @@ func TestGetPRStats(t *testing.T) {
 		{
 			name: "service_error",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetPRStatistics", mock.Anything).
+				m.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return(([]*model.PRStats)(nil), errors.New("connection timeout"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -145,7 +145,7 @@ func TestGetPRStats(t *testing.T) {
 		{
 			name: "empty_result",
 			setupMock: func(m *mocks.MockStatisticsService) {
-				m.On("GetPRStatistics", mock.Anything).
+				m.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).
 					Return([]*model.PRStats{}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -179,3 +179,152 @@ func TestGetPRStats(t *testing.T) {
 		})
 	}
 }
+
+func TestExportStats(t *testing.T) {
+	reviewerID := uuid.New()
+	prID := uuid.New()
+
+	tests := []struct {
+		name           string
+		path           string
+		accept         string
+		setupMock      func(*mocks.MockStatisticsService)
+		expectedStatus int
+		expectedType   string
+		expectedBody   string
+	}{
+		{
+			name:   "reviewers_csv_by_accept",
+			path:   "/statistics/reviewers",
+			accept: "text/csv",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("StreamReviewerStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						fn := args.Get(2).(func(*model.ReviewerStats) error)
+						_ = fn(&model.ReviewerStats{ReviewerID: reviewerID, ReviewerName: "john", AssignedCount: 5})
+					}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/csv; charset=utf-8",
+			expectedBody:   "reviewer_id,reviewer_name,assigned_count\n" + reviewerID.String() + ",john,5\n",
+		},
+		{
+			name: "prs_ndjson_by_query",
+			path: "/statistics/prs?format=ndjson",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("StreamPRStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						fn := args.Get(2).(func(*model.PRStats) error)
+						_ = fn(&model.PRStats{PRID: prID, PRName: "Add search", Status: "OPEN", ReviewerCount: 2})
+						_ = fn(&model.PRStats{PRID: prID, PRName: "Fix bug", Status: "MERGED", ReviewerCount: 1})
+					}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/x-ndjson",
+			expectedBody: `{"pr_id":"` + prID.String() + `","pr_name":"Add search","reviewer_count":2,"status":"OPEN"}` + "\n" +
+				`{"pr_id":"` + prID.String() + `","pr_name":"Fix bug","reviewer_count":1,"status":"MERGED"}` + "\n",
+		},
+		{
+			name:   "query_overrides_accept",
+			path:   "/statistics/prs?format=csv",
+			accept: "application/x-ndjson",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("StreamPRStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/csv; charset=utf-8",
+			expectedBody:   "pr_id,pr_name,status,reviewer_count\n",
+		},
+		{
+			name:   "error_before_first_row",
+			path:   "/statistics/reviewers",
+			accept: "application/x-ndjson",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("StreamReviewerStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).
+					Return(errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedType:   "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockStatisticsService(t)
+			tt.setupMock(mockService)
+
+			handler := statistics.NewHandler(mockService)
+			router.GET("/statistics/reviewers", handler.GetReviewerStats)
+			router.GET("/statistics/prs", handler.GetPRStats)
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"))
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestStats_IncludeHistory(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockStatisticsService)
+		expectedStatus int
+	}{
+		{
+			name:  "include_history_true",
+			query: "?include_history=true",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{IncludeHistory: true}).
+					Return([]*model.ReviewerStats{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "include_history_with_csv",
+			query: "?include_history=1&format=csv",
+			setupMock: func(m *mocks.MockStatisticsService) {
+				m.On("StreamReviewerStatistics", mock.Anything, model.StatisticsFilter{IncludeHistory: true}, mock.Anything).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "include_history_invalid",
+			query:          "?include_history=maybe",
+			setupMock:      func(m *mocks.MockStatisticsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockStatisticsService(t)
+			tt.setupMock(mockService)
+
+			handler := statistics.NewHandler(mockService)
+			router.GET("/statistics/reviewers", handler.GetReviewerStats)
+
+			req, _ := http.NewRequest("GET", "/statistics/reviewers"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package team
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+)
+
+// maxRosterSize ограничивает тело запроса импорта
+const maxRosterSize = 5 << 20
+
+// rosterYAML повторяет тело /team/add для нескольких команд
+type rosterYAML struct {
+	Teams []struct {
+		TeamName string `yaml:"team_name"`
+		Members  []struct {
+			ID       string `yaml:"user_id"`
+			Username string `yaml:"username"`
+			IsActive *bool  `yaml:"is_active"`
+		} `yaml:"members"`
+	} `yaml:"teams"`
+}
+
+var csvColumns = []string{"team_name", "user_id", "username", "is_active"}
+
+// Import принимает список сотрудников в YAML или CSV; ?dry_run=true возвращает план без изменений
+func (h *TeamHandler) Import(c *gin.Context) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+		return
+	}
+
+	format := rosterFormat(c)
+	if format == "" {
+		handlers.NewErrorResponse(c, handlers.Error{
+			Code:    "UNSUPPORTED_FORMAT",
+			Message: "roster must be text/csv or application/yaml",
+			Status:  http.StatusUnsupportedMediaType,
+		})
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxRosterSize)
+	var roster []*model.RosterEntry
+	if format == "csv" {
+		roster, err = parseRosterCSV(body)
+	} else {
+		roster, err = parseRosterYAML(body)
+	}
+	if err != nil {
+		handlers.NewErrorResponse(c, invalidRosterError(err))
+		return
+	}
+
+	plan, err := h.service.Import(c.Request.Context(), roster, dryRun)
+	if err != nil {
+		handlers.NewErrorResponse(c, mappingServiceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// rosterFormat берет формат из ?format=, иначе из Content-Type; пустая строка — формат не поддерживается
+func rosterFormat(c *gin.Context) string {
+	format := c.Query("format")
+	if format == "" {
+		mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		format = mediaType
+	}
+
+	switch format {
+	case "csv", "text/csv":
+		return "csv"
+	case "yaml", "yml", "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return "yaml"
+	default:
+		return ""
+	}
+}
+
+func parseRosterYAML(r io.Reader) ([]*model.RosterEntry, error) {
+	var doc rosterYAML
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var roster []*model.RosterEntry
+	for _, t := range doc.Teams {
+		for _, m := range t.Members {
+			active := m.IsActive == nil || *m.IsActive
+			roster = append(roster, newRosterEntry(t.TeamName, m.ID, m.Username, active))
+		}
+	}
+	return roster, nil
+}
+
+// parseRosterCSV ожидает заголовок с колонками team_name, user_id, username и
+// необязательной is_active (по умолчанию true) в любом порядке
+func parseRosterCSV(r io.Reader) ([]*model.RosterEntry, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	idx := make(map[string]int, len(header))
+	for i, col := range header {
+		idx[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	for _, col := range csvColumns[:3] {
+		if _, ok := idx[col]; !ok {
+			return nil, fmt.Errorf("missing column %q", col)
+		}
+	}
+
+	var roster []*model.RosterEntry
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return roster, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		active := true
+		if i, ok := idx["is_active"]; ok && strings.TrimSpace(rec[i]) != "" {
+			active, err = strconv.ParseBool(strings.TrimSpace(rec[i]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid is_active %q", line, rec[i])
+			}
+		}
+		roster = append(roster, newRosterEntry(
+			strings.TrimSpace(rec[idx["team_name"]]),
+			strings.TrimSpace(rec[idx["user_id"]]),
+			strings.TrimSpace(rec[idx["username"]]),
+			active,
+		))
+	}
+}
+
+// newRosterEntry разбирает id так же, как /team/add: не-uuid строки хешируются,
+// пустой id остается uuid.Nil и отклоняется сервисом
+func newRosterEntry(team, id, username string, active bool) *model.RosterEntry {
+	var userID uuid.UUID
+	if id != "" {
+		var err error
+		userID, err = uuid.Parse(id)
+		if err != nil {
+			userID = handlers.StringToUUID(id)
+		}
+	}
+	return &model.RosterEntry{TeamName: team, UserID: userID, Username: username, IsActive: active}
+}
+
+func invalidRosterError(err error) handlers.Error {
+	return handlers.Error{
+		Code:    "INVALID_ROSTER",
+		Message: err.Error(),
+		Status:  http.StatusBadRequest,
+	}
+}
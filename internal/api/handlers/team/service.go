@@ -1,6 +1,7 @@
 package team
 
 import (
+	"errors"
 	"net/http"
 
 	"PR/internal/api/handlers"
@@ -17,6 +18,10 @@ func NewTeamHandler(serv service.TeamService) *TeamHandler {
 }
 
 func mappingServiceError(err error) handlers.Error {
+	if errors.Is(err, team.ErrInvalidRoster) {
+		return invalidRosterError(err)
+	}
+
 	var e handlers.Error
 	switch err {
 	case team.ErrTeamExist:
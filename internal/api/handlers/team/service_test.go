@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"PR/internal/api/handlers"
 	"PR/internal/api/handlers/team"
 	"PR/internal/mocks"
 	"PR/internal/model"
@@ -205,3 +207,103 @@ func TestGetTeamByName(t *testing.T) {
 		})
 	}
 }
+
+func TestImport(t *testing.T) {
+	alice := handlers.StringToUUID("u1")
+	bob := uuid.New()
+	wantRoster := []*model.RosterEntry{
+		{TeamName: "backend", UserID: alice, Username: "alice", IsActive: true},
+		{TeamName: "backend", UserID: bob, Username: "bob", IsActive: false},
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		contentType    string
+		body           string
+		setupMock      func(*mocks.MockTeamService)
+		expectedStatus int
+	}{
+		{
+			name:        "csv",
+			url:         "/team/import",
+			contentType: "text/csv; charset=utf-8",
+			body:        "username,team_name,user_id,is_active\nalice,backend,u1,\nbob,backend," + bob.String() + ",false\n",
+			setupMock: func(m *mocks.MockTeamService) {
+				m.On("Import", mock.Anything, wantRoster, false).Return(&model.ImportPlan{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "yaml dry run",
+			url:         "/team/import?dry_run=true",
+			contentType: "application/yaml",
+			body: `teams:
+  - team_name: backend
+    members:
+      - {user_id: u1, username: alice}
+      - {user_id: ` + bob.String() + `, username: bob, is_active: false}
+`,
+			setupMock: func(m *mocks.MockTeamService) {
+				m.On("Import", mock.Anything, wantRoster, true).Return(&model.ImportPlan{DryRun: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "format from query, missing column",
+			url:            "/team/import?format=csv",
+			contentType:    "application/octet-stream",
+			body:           "team_name,user_id\nbackend,u1\n",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unsupported format",
+			url:            "/team/import",
+			contentType:    "application/json",
+			body:           "{}",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:           "invalid is_active",
+			url:            "/team/import",
+			contentType:    "text/csv",
+			body:           "team_name,user_id,username,is_active\nbackend,u1,alice,maybe\n",
+			setupMock:      func(m *mocks.MockTeamService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "invalid roster from service",
+			url:         "/team/import",
+			contentType: "text/csv",
+			body:        "team_name,user_id,username\n,u1,alice\n",
+			setupMock: func(m *mocks.MockTeamService) {
+				m.On("Import", mock.Anything, mock.Anything, false).
+					Return(nil, fmt.Errorf("%w: row 1: team_name is required", serviceTeam.ErrInvalidRoster))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockTeamService(t)
+			tt.setupMock(mockService)
+
+			handler := team.NewTeamHandler(mockService)
+			router.POST("/team/import", handler.Import)
+
+			req, _ := http.NewRequest("POST", tt.url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+	"PR/internal/service"
+	"PR/internal/service/apikey"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuth проверяет ключ из заголовка X-API-Key и кладет вызывающего в контекст запроса
+func APIKeyAuth(keys service.APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, err := keys.Authenticate(c.Request.Context(), c.GetHeader(APIKeyHeader))
+		if err != nil {
+			if !errors.Is(err, apikey.ErrInvalidKey) {
+				zerolog.Ctx(c.Request.Context()).Error().Msgf("api key auth error: %v", err)
+			}
+			handlers.NewErrorResponse(c, handlers.UnauthorizedError())
+			return
+		}
+
+		a := &actor.Actor{
+			Name:   key.Name,
+			Role:   key.Role,
+			UserID: key.UserID,
+		}
+		if key.ID != uuid.Nil {
+			id := key.ID
+			a.KeyID = &id
+		}
+
+		setActor(c, a)
+		c.Next()
+	}
+}
+
+// RequireRole пропускает запрос, только если роль вызывающего не ниже требуемой
+func RequireRole(role model.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		a, ok := actor.FromContext(c.Request.Context())
+		if !ok {
+			handlers.NewErrorResponse(c, handlers.UnauthorizedError())
+			return
+		}
+		if !a.Role.Allows(role) {
+			handlers.NewErrorResponse(c, handlers.ForbiddenError())
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,132 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/actor"
+	"PR/internal/api/middleware"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/service/apikey"
+)
+
+func TestAPIKeyAuth(t *testing.T) {
+	tests := []struct {
+		name           string
+		key            string
+		required       model.Role
+		setupMock      func(*mocks.MockAPIKeyService)
+		expectedStatus int
+	}{
+		{
+			name:     "admin_on_admin_route",
+			key:      "admin-key",
+			required: model.RoleAdmin,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "admin-key").
+					Return(&model.APIKey{ID: uuid.New(), Name: "admin", Role: model.RoleAdmin}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "admin_on_reader_route",
+			key:      "admin-key",
+			required: model.RoleReader,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "admin-key").
+					Return(&model.APIKey{ID: uuid.New(), Name: "admin", Role: model.RoleAdmin}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "member_on_admin_route",
+			key:      "member-key",
+			required: model.RoleAdmin,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "member-key").
+					Return(&model.APIKey{ID: uuid.New(), Name: "ci", Role: model.RoleMember}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:     "reader_on_member_route",
+			key:      "reader-key",
+			required: model.RoleMember,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "reader-key").
+					Return(&model.APIKey{ID: uuid.New(), Name: "dashboard", Role: model.RoleReader}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:     "missing_key",
+			required: model.RoleReader,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "").Return(nil, apikey.ErrInvalidKey)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:     "service_error",
+			key:      "any-key",
+			required: model.RoleReader,
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "any-key").Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockAPIKeyService(t)
+			tt.setupMock(mockService)
+
+			router.GET("/protected",
+				middleware.APIKeyAuth(mockService),
+				middleware.RequireRole(tt.required),
+				func(c *gin.Context) {
+					a, ok := actor.FromContext(c.Request.Context())
+					assert.True(t, ok)
+					assert.NotNil(t, a.KeyID)
+					c.Status(http.StatusOK)
+				},
+			)
+
+			req, _ := http.NewRequest("GET", "/protected", nil)
+			if tt.key != "" {
+				req.Header.Set(middleware.APIKeyHeader, tt.key)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRequireRole_NoActor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	router.GET("/protected", middleware.RequireRole(model.RoleReader), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest("GET", "/protected", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// выставляется на ответах, которые отданы из сохраненной записи
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// Idempotency сохраняет ответ на запрос с заголовком Idempotency-Key и отдает его же на повторы.
+// Ключ действует в пределах вызывающего и маршрута; повтор с другим телом получает 422.
+// Ответы 5xx не сохраняются, чтобы повтор мог выполниться заново.
+// Незавершенный запрос держит ключ только lease: если реплика упала, повтор сможет выполниться после его истечения
+func Idempotency(store repository.IdempotencyRepository, lease, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			handlers.NewErrorResponse(c, handlers.BadRequestError())
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			handlers.NewErrorResponse(c, handlers.BadRequestError())
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// created_at вместе с хешем тела отличает эту резервацию от повтора; Postgres хранит микросекунды
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		rec := &model.IdempotencyRecord{
+			Scope:       idempotencyScope(c),
+			Key:         key,
+			RequestHash: requestHash(body),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(lease),
+		}
+
+		existing, reserved, err := store.Reserve(c.Request.Context(), rec)
+		if err != nil {
+			zerolog.Ctx(c.Request.Context()).Error().Msgf("idempotency reserve error: %v", err)
+			handlers.NewErrorResponse(c, handlers.InternalError(err))
+			return
+		}
+		if !reserved {
+			replay(c, rec, existing)
+			return
+		}
+
+		// CI повторяет запрос по таймауту, поэтому ответ сохраняем, даже если клиент уже отключился
+		ctx := context.WithoutCancel(c.Request.Context())
+		release := func() {
+			if err := store.Delete(ctx, rec); err != nil {
+				zerolog.Ctx(ctx).Error().Msgf("idempotency delete error: %v", err)
+			}
+		}
+
+		// gin.Recovery стоит снаружи, поэтому после паники ключ освобождаем здесь
+		defer func() {
+			if r := recover(); r != nil {
+				release()
+				panic(r)
+			}
+		}()
+
+		w := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		if w.Status() >= http.StatusInternalServerError {
+			release()
+			return
+		}
+
+		rec.StatusCode = w.Status()
+		rec.ContentType = w.Header().Get("Content-Type")
+		rec.Body = w.body.Bytes()
+		rec.ExpiresAt = time.Now().UTC().Add(ttl)
+		if err := store.Complete(ctx, rec); err != nil {
+			zerolog.Ctx(ctx).Error().Msgf("idempotency complete error: %v", err)
+		}
+	}
+}
+
+func replay(c *gin.Context, rec, existing *model.IdempotencyRecord) {
+	if existing.RequestHash != rec.RequestHash {
+		handlers.NewErrorResponse(c, handlers.IdempotencyMismatchError())
+		return
+	}
+	if !existing.Completed() {
+		handlers.NewErrorResponse(c, handlers.IdempotencyInProgressError())
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+	c.Abort()
+}
+
+// idempotencyScope не дает разным вызывающим и маршрутам делить один ключ
+func idempotencyScope(c *gin.Context) string {
+	scope := c.Request.Method + " " + c.FullPath() + " "
+
+	a, ok := actor.FromContext(c.Request.Context())
+	switch {
+	case !ok:
+		return scope + "anonymous"
+	case a.UserID != nil:
+		return scope + "user:" + a.UserID.String()
+	case a.KeyID != nil:
+		return scope + "key:" + a.KeyID.String()
+	default:
+		return scope + "name:" + a.Name
+	}
+}
+
+func requestHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,235 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/api/middleware"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/repository/memory"
+)
+
+func TestIdempotency(t *testing.T) {
+	const body = `{"pull_request_id":"pr-1"}`
+
+	tests := []struct {
+		name           string
+		key            string
+		handlerStatus  int
+		setupMock      func(*mocks.MockIdempotencyRepository)
+		expectedStatus int
+		expectedBody   string
+		expectedCalls  int
+		expectedReplay bool
+	}{
+		{
+			name:           "no_key",
+			handlerStatus:  http.StatusOK,
+			setupMock:      func(m *mocks.MockIdempotencyRepository) {},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+		},
+		{
+			name:           "too_long_key",
+			key:            strings.Repeat("k", 256),
+			setupMock:      func(m *mocks.MockIdempotencyRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "first_request_stored",
+			key:           "retry-1",
+			handlerStatus: http.StatusOK,
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.MatchedBy(func(r *model.IdempotencyRecord) bool {
+					return r.Key == "retry-1" && r.Scope == "POST /pullRequest/reassign anonymous" &&
+						r.ExpiresAt.Sub(r.CreatedAt) == time.Minute
+				})).Return(func(_ context.Context, r *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+					return r, true, nil
+				})
+				m.On("Complete", mock.Anything, mock.MatchedBy(func(r *model.IdempotencyRecord) bool {
+					return r.StatusCode == http.StatusOK && string(r.Body) == `{"calls":1}` &&
+						strings.HasPrefix(r.ContentType, "application/json") &&
+						r.ExpiresAt.Sub(r.CreatedAt) >= time.Hour
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"calls":1}`,
+			expectedCalls:  1,
+		},
+		{
+			name:          "server_error_not_stored",
+			key:           "retry-1",
+			handlerStatus: http.StatusInternalServerError,
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.Anything).
+					Return(func(_ context.Context, r *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+						return r, true, nil
+					})
+				m.On("Delete", mock.Anything, mock.MatchedBy(func(r *model.IdempotencyRecord) bool {
+					return r.Key == "retry-1" && r.Scope == "POST /pullRequest/reassign anonymous"
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+		{
+			name: "replay",
+			key:  "retry-1",
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.Anything).
+					Return(func(_ context.Context, r *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+						return &model.IdempotencyRecord{
+							RequestHash: r.RequestHash,
+							StatusCode:  http.StatusOK,
+							ContentType: "application/json; charset=utf-8",
+							Body:        []byte(`{"calls":1}`),
+						}, false, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"calls":1}`,
+			expectedReplay: true,
+		},
+		{
+			name: "body_mismatch",
+			key:  "retry-1",
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.Anything).
+					Return(&model.IdempotencyRecord{RequestHash: "other", StatusCode: http.StatusOK}, false, nil)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "in_progress",
+			key:  "retry-1",
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.Anything).
+					Return(func(_ context.Context, r *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+						return &model.IdempotencyRecord{RequestHash: r.RequestHash}, false, nil
+					})
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "store_error",
+			key:  "retry-1",
+			setupMock: func(m *mocks.MockIdempotencyRepository) {
+				m.On("Reserve", mock.Anything, mock.Anything).Return(nil, false, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			store := mocks.NewMockIdempotencyRepository(t)
+			tt.setupMock(store)
+
+			calls := 0
+			router.POST("/pullRequest/reassign", middleware.Idempotency(store, time.Minute, time.Hour), func(c *gin.Context) {
+				calls++
+				// тело должно дойти до обработчика нетронутым
+				raw, _ := c.GetRawData()
+				assert.Equal(t, body, string(raw))
+				c.JSON(tt.handlerStatus, gin.H{"calls": calls})
+			})
+
+			req, _ := http.NewRequest("POST", "/pullRequest/reassign", bytes.NewBufferString(body))
+			if tt.key != "" {
+				req.Header.Set(middleware.IdempotencyKeyHeader, tt.key)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedCalls, calls)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+			if tt.expectedReplay {
+				assert.Equal(t, "true", w.Header().Get(middleware.IdempotentReplayedHeader))
+			}
+		})
+	}
+}
+
+func TestIdempotency_PanicReleasesKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	store := mocks.NewMockIdempotencyRepository(t)
+	store.On("Reserve", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, r *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+			return r, true, nil
+		})
+	store.On("Delete", mock.Anything, mock.MatchedBy(func(r *model.IdempotencyRecord) bool {
+		return r.Key == "retry-1" && r.Scope == "POST /pullRequest/reassign anonymous"
+	})).Return(nil)
+
+	// Recovery снаружи, как в сервере
+	router.Use(gin.Recovery())
+	router.POST("/pullRequest/reassign", middleware.Idempotency(store, time.Minute, time.Hour), func(c *gin.Context) {
+		panic("boom")
+	})
+
+	req, _ := http.NewRequest("POST", "/pullRequest/reassign", bytes.NewBufferString(`{}`))
+	req.Header.Set(middleware.IdempotencyKeyHeader, "retry-1")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	store.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything)
+}
+
+// Первый запрос пережил аренду, и ключ занял повтор: ошибка первого не должна снять чужую запись
+func TestIdempotency_LeaseExpired(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	store := memory.NewIdempotencyRepository(memory.NewStore())
+
+	calls := 0
+	router.POST("/pullRequest/reassign", middleware.Idempotency(store, time.Millisecond, time.Hour), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			time.Sleep(5 * time.Millisecond)
+			retry := httptest.NewRecorder()
+			router.ServeHTTP(retry, newIdempotentRequest("retry-1"))
+			assert.Equal(t, http.StatusOK, retry.Code)
+
+			c.JSON(http.StatusInternalServerError, gin.H{"calls": calls})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"calls": calls})
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("retry-1"))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newIdempotentRequest("retry-1"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "true", w.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.JSONEq(t, `{"calls":2}`, w.Body.String())
+	assert.Equal(t, 2, calls)
+}
+
+func newIdempotentRequest(key string) *http.Request {
+	req, _ := http.NewRequest("POST", "/pullRequest/reassign", bytes.NewBufferString(`{}`))
+	req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	return req
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+	"PR/internal/service"
+	"PR/internal/token"
+)
+
+const bearerPrefix = "Bearer "
+
+type TokenVerifier interface {
+	Verify(raw string) (*token.Claims, error)
+}
+
+// Authenticate принимает Bearer токен пользователя или ключ X-API-Key.
+// Если tokens == nil, запросы с Bearer токеном отклоняются.
+func Authenticate(keys service.APIKeyService, tokens TokenVerifier) gin.HandlerFunc {
+	byKey := APIKeyAuth(keys)
+	return func(c *gin.Context) {
+		raw, ok := bearerToken(c)
+		if !ok {
+			byKey(c)
+			return
+		}
+
+		if tokens == nil {
+			handlers.NewErrorResponse(c, handlers.UnauthorizedError())
+			return
+		}
+
+		claims, err := tokens.Verify(raw)
+		if err != nil {
+			zerolog.Ctx(c.Request.Context()).Warn().Msgf("jwt auth error: %v", err)
+			handlers.NewErrorResponse(c, handlers.UnauthorizedError())
+			return
+		}
+
+		a, ok := actorFromClaims(claims)
+		if !ok {
+			handlers.NewErrorResponse(c, handlers.UnauthorizedError())
+			return
+		}
+
+		setActor(c, a)
+		c.Next()
+	}
+}
+
+func bearerToken(c *gin.Context) (string, bool) {
+	h := c.GetHeader("Authorization")
+	if len(h) < len(bearerPrefix) || !strings.EqualFold(h[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(h[len(bearerPrefix):]), true
+}
+
+// actorFromClaims: sub разбирается так же, как user_id в запросах, роль по умолчанию member
+func actorFromClaims(claims *token.Claims) (*actor.Actor, bool) {
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		userID = handlers.StringToUUID(claims.Subject)
+	}
+
+	role := model.RoleMember
+	if claims.Role != "" {
+		role = model.Role(claims.Role)
+		if !role.Valid() {
+			return nil, false
+		}
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.Subject
+	}
+
+	return &actor.Actor{
+		Name:   name,
+		Role:   role,
+		UserID: &userID,
+	}, true
+}
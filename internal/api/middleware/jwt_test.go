@@ -0,0 +1,139 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/api/middleware"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/token"
+)
+
+type verifierFunc func(raw string) (*token.Claims, error)
+
+func (f verifierFunc) Verify(raw string) (*token.Claims, error) {
+	return f(raw)
+}
+
+func claims(sub, role string) *token.Claims {
+	return &token.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: sub},
+		Role:             role,
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		header         string
+		apiKey         string
+		tokens         middleware.TokenVerifier
+		setupMock      func(*mocks.MockAPIKeyService)
+		expectedStatus int
+		expectedUserID *uuid.UUID
+		expectedRole   model.Role
+	}{
+		{
+			name:   "bearer_uuid_sub",
+			header: "Bearer good",
+			tokens: verifierFunc(func(string) (*token.Claims, error) {
+				return claims(userID.String(), ""), nil
+			}),
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusOK,
+			expectedUserID: &userID,
+			expectedRole:   model.RoleMember,
+		},
+		{
+			name:   "bearer_string_sub_with_admin_role",
+			header: "bearer good",
+			tokens: verifierFunc(func(string) (*token.Claims, error) {
+				return claims("u1", "admin"), nil
+			}),
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusOK,
+			expectedUserID: func() *uuid.UUID { id := handlers.StringToUUID("u1"); return &id }(),
+			expectedRole:   model.RoleAdmin,
+		},
+		{
+			name:   "bearer_unknown_role",
+			header: "Bearer good",
+			tokens: verifierFunc(func(string) (*token.Claims, error) {
+				return claims("u1", "owner"), nil
+			}),
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "bearer_invalid",
+			header: "Bearer bad",
+			tokens: verifierFunc(func(string) (*token.Claims, error) {
+				return nil, token.ErrInvalidToken
+			}),
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "bearer_without_configured_keys",
+			header:         "Bearer good",
+			setupMock:      func(m *mocks.MockAPIKeyService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "fallback_to_api_key",
+			apiKey: "member-key",
+			tokens: verifierFunc(func(string) (*token.Claims, error) {
+				return nil, errors.New("must not be called")
+			}),
+			setupMock: func(m *mocks.MockAPIKeyService) {
+				m.On("Authenticate", mock.Anything, "member-key").
+					Return(&model.APIKey{ID: uuid.New(), Name: "ci", Role: model.RoleMember}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedRole:   model.RoleMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			mockService := mocks.NewMockAPIKeyService(t)
+			tt.setupMock(mockService)
+
+			router.GET("/protected", middleware.Authenticate(mockService, tt.tokens), func(c *gin.Context) {
+				a, ok := actor.FromContext(c.Request.Context())
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedRole, a.Role)
+				assert.Equal(t, tt.expectedUserID, a.UserID)
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest("GET", "/protected", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			if tt.apiKey != "" {
+				req.Header.Set(middleware.APIKeyHeader, tt.apiKey)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
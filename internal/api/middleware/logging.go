@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"PR/internal/actor"
+)
+
+// AccessLog пишет по строке JSON лога на каждый запрос логгером из контекста,
+// поэтому в записи есть request_id и вызывающий, если он прошел авторизацию
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// c.Request к этому моменту уже содержит контекст, обогащенный авторизацией
+		l := zerolog.Ctx(c.Request.Context())
+		status := c.Writer.Status()
+
+		var e *zerolog.Event
+		switch {
+		case status >= http.StatusInternalServerError:
+			e = l.Error()
+		case status >= http.StatusBadRequest:
+			e = l.Warn()
+		default:
+			e = l.Info()
+		}
+
+		e.Str("path", c.Request.URL.Path).
+			Int("status", status).
+			Int("size", c.Writer.Size()).
+			Dur("latency", time.Since(start)).
+			Str("client_ip", c.ClientIP()).
+			Msg("request")
+	}
+}
+
+// setActor кладет вызывающего в контекст запроса и добавляет его в логгер запроса
+func setActor(c *gin.Context, a *actor.Actor) {
+	ctx := actor.WithContext(c.Request.Context(), a)
+
+	lc := zerolog.Ctx(ctx).With().Str("user", a.Name).Str("role", string(a.Role))
+	if a.UserID != nil {
+		lc = lc.Str("user_id", a.UserID.String())
+	}
+	if a.KeyID != nil {
+		lc = lc.Str("key_id", a.KeyID.String())
+	}
+	l := lc.Logger()
+
+	c.Request = c.Request.WithContext(l.WithContext(ctx))
+}
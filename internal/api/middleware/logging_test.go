@@ -0,0 +1,106 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/api/handlers"
+	"PR/internal/api/middleware"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/requestid"
+)
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	prev := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() { log.Logger = prev })
+	return &buf
+}
+
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	for _, raw := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if raw == "" {
+			continue
+		}
+		var m map[string]any
+		require.NoError(t, json.Unmarshal([]byte(raw), &m))
+		lines = append(lines, m)
+	}
+	return lines
+}
+
+func TestAccessLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	buf := captureLog(t)
+
+	keyID := uuid.New()
+	keys := mocks.NewMockAPIKeyService(t)
+	keys.On("Authenticate", mock.Anything, "ci-key").
+		Return(&model.APIKey{ID: keyID, Name: "ci", Role: model.RoleMember}, nil)
+
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.AccessLog())
+	router.POST("/pullRequest/merge", middleware.APIKeyAuth(keys), func(c *gin.Context) {
+		// так пишут сервисы: логгером из контекста
+		zerolog.Ctx(c.Request.Context()).Error().Msg("service.pr.Merge error: boom")
+		handlers.NewErrorResponse(c, handlers.BadRequestError())
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/pullRequest/merge", nil)
+	req.Header.Set(requestid.Header, "trace-42")
+	req.Header.Set(middleware.APIKeyHeader, "ci-key")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error":{"code":"BAD_REQUEST","message":"invalid data","request_id":"trace-42"}}`, w.Body.String())
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 2)
+
+	for _, l := range lines {
+		assert.Equal(t, "trace-42", l["request_id"])
+		assert.Equal(t, "/pullRequest/merge", l["route"])
+		assert.Equal(t, "ci", l["user"])
+		assert.Equal(t, keyID.String(), l["key_id"])
+	}
+
+	assert.Equal(t, "service.pr.Merge error: boom", lines[0]["message"])
+	assert.Equal(t, "request", lines[1]["message"])
+	assert.Equal(t, "warn", lines[1]["level"])
+	assert.EqualValues(t, http.StatusBadRequest, lines[1]["status"])
+}
+
+func TestAccessLog_Unauthenticated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	buf := captureLog(t)
+
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.AccessLog())
+	router.GET("/health/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/health/live", nil)
+	router.ServeHTTP(w, req)
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, w.Header().Get(requestid.Header), lines[0]["request_id"])
+	assert.Equal(t, "info", lines[0]["level"])
+	assert.NotContains(t, lines[0], "user")
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/model"
+	"PR/internal/ratelimit"
+)
+
+type RateLimiter interface {
+	Take(ctx context.Context, key string, l model.RateLimit) (*model.RateDecision, error)
+}
+
+// RateLimit ограничивает частоту запросов отдельно для каждого клиента и маршрута.
+// Клиент — API ключ или пользователь JWT, без авторизации — IP адрес.
+// Если хранилище лимитов недоступно, запрос пропускается
+func RateLimit(limiter RateLimiter, cfg *ratelimit.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		limit, ok := cfg.For(route)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		take(c, limiter, clientKey(c)+" "+route, limit)
+	}
+}
+
+// RateLimitIP ограничивает частоту запросов с одного IP адреса до авторизации,
+// чтобы перебор ключей и токенов тоже упирался в лимит. Бакет общий для всех
+// маршрутов; если хранилище лимитов недоступно, запрос пропускается
+func RateLimitIP(limiter RateLimiter, cfg *ratelimit.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if cfg.IP == nil {
+			c.Next()
+			return
+		}
+		take(c, limiter, "preauth ip:"+c.ClientIP(), *cfg.IP)
+	}
+}
+
+// take списывает токен из бакета key и отвечает 429, если токенов нет
+func take(c *gin.Context, limiter RateLimiter, key string, limit model.RateLimit) {
+	decision, err := limiter.Take(c.Request.Context(), key, limit)
+	if err != nil {
+		zerolog.Ctx(c.Request.Context()).Error().Msgf("rate limit error: %v", err)
+		c.Next()
+		return
+	}
+
+	if !decision.Allowed {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+		handlers.NewErrorResponse(c, handlers.TooManyRequestsError())
+		return
+	}
+	c.Next()
+}
+
+func clientKey(c *gin.Context) string {
+	a, ok := actor.FromContext(c.Request.Context())
+	switch {
+	case !ok:
+		return "ip:" + c.ClientIP()
+	case a.KeyID != nil:
+		return "key:" + a.KeyID.String()
+	case a.UserID != nil:
+		return "user:" + a.UserID.String()
+	default:
+		return "name:" + a.Name
+	}
+}
@@ -0,0 +1,124 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/actor"
+	"PR/internal/api/middleware"
+	"PR/internal/mocks"
+	"PR/internal/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg, err := ratelimit.ParseConfig("", "POST /pullRequest/reassign=2/1h")
+	require.NoError(t, err)
+
+	keyA := uuid.New()
+	keyB := uuid.New()
+
+	router := gin.New()
+	withActor := func(c *gin.Context) {
+		if id := c.GetHeader("X-Test-Key"); id != "" {
+			keyID := uuid.MustParse(id)
+			a := &actor.Actor{Name: "bot", KeyID: &keyID}
+			c.Request = c.Request.WithContext(actor.WithContext(c.Request.Context(), a))
+		}
+		c.Next()
+	}
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	limit := middleware.RateLimit(ratelimit.NewMemory(), cfg)
+	router.POST("/pullRequest/reassign", withActor, limit, ok)
+	router.POST("/pullRequest/create", withActor, limit, ok)
+
+	send := func(path, key string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, nil)
+		if key != "" {
+			req.Header.Set("X-Test-Key", key)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send("/pullRequest/reassign", keyA.String()).Code)
+	assert.Equal(t, http.StatusOK, send("/pullRequest/reassign", keyA.String()).Code)
+
+	w := send("/pullRequest/reassign", keyA.String())
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1800", w.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"error":{"code":"TOO_MANY_REQUESTS","message":"rate limit exceeded"}}`, w.Body.String())
+
+	// другой ключ и клиент без ключа (по IP) не делят бакет с первым
+	assert.Equal(t, http.StatusOK, send("/pullRequest/reassign", keyB.String()).Code)
+	assert.Equal(t, http.StatusOK, send("/pullRequest/reassign", "").Code)
+
+	// маршрут без лимита не ограничивается
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, send("/pullRequest/create", keyA.String()).Code)
+	}
+}
+
+func TestRateLimit_LimiterError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg, err := ratelimit.ParseConfig("1/1h", "")
+	require.NoError(t, err)
+
+	limiter := mocks.NewMockRateLimitRepository(t)
+	limiter.On("Take", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	router := gin.New()
+	router.GET("/team/get", middleware.RateLimit(limiter, cfg), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/team/get", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRateLimitIP_BeforeAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg, err := ratelimit.ParseConfig("", "")
+	require.NoError(t, err)
+	ipLimit, err := ratelimit.ParseLimit("2/1h")
+	require.NoError(t, err)
+	cfg.IP = &ipLimit
+
+	router := gin.New()
+	denyAuth := func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) }
+	limit := middleware.RateLimitIP(ratelimit.NewMemory(), cfg)
+	router.GET("/team/get", limit, denyAuth)
+	router.GET("/users/getReview", limit, denyAuth)
+
+	send := func(path, ip string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		req.RemoteAddr = ip + ":1234"
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// неудачные попытки авторизации тоже списывают токены
+	assert.Equal(t, http.StatusUnauthorized, send("/team/get", "10.0.0.1"))
+	assert.Equal(t, http.StatusUnauthorized, send("/users/getReview", "10.0.0.1"))
+	// бакет общий для всех маршрутов
+	assert.Equal(t, http.StatusTooManyRequests, send("/team/get", "10.0.0.1"))
+
+	// другой адрес не делит бакет с первым
+	assert.Equal(t, http.StatusUnauthorized, send("/team/get", "10.0.0.2"))
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
+
+	"PR/internal/requestid"
+)
+
+// RequestID берет идентификатор из X-Request-ID или генерирует новый,
+// возвращает его в том же заголовке ответа и кладет в контекст логгер с этим идентификатором
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Header(requestid.Header, id)
+
+		lc := log.With().
+			Str("request_id", id).
+			Str("method", c.Request.Method).
+			Str("route", c.FullPath())
+		// спан запроса открывает otelgin раньше, по trace_id логи находятся из трейса
+		if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+			lc = lc.Str("trace_id", sc.TraceID().String())
+		}
+		l := lc.Logger()
+
+		ctx := requestid.WithContext(c.Request.Context(), id)
+		c.Request = c.Request.WithContext(l.WithContext(ctx))
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"PR/internal/api/middleware"
+	"PR/internal/requestid"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{name: "generated_when_missing", incoming: "", keep: false},
+		{name: "incoming_is_kept", incoming: "trace-123", keep: true},
+		{name: "too_long_is_replaced", incoming: strings.Repeat("a", 200), keep: false},
+		{name: "non_printable_is_replaced", incoming: "bad\tid", keep: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var inContext string
+			router.GET("/", middleware.RequestID(), func(c *gin.Context) {
+				inContext = requestid.FromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest("GET", "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(requestid.Header, tt.incoming)
+			}
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get(requestid.Header)
+			assert.NotEmpty(t, got)
+			assert.Equal(t, got, inContext)
+			if tt.keep {
+				assert.Equal(t, tt.incoming, got)
+			} else {
+				assert.NotEqual(t, tt.incoming, got)
+			}
+		})
+	}
+}
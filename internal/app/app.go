@@ -3,15 +3,31 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
 
+	"PR/internal/actor"
+	"PR/internal/api/middleware"
 	"PR/internal/closer"
+	"PR/internal/migrator"
+	"PR/internal/model"
+	"PR/internal/tracing"
 )
 
+// номер последней встроенной миграции, с которой совместим бинарник
+var schemaVersion = func() uint {
+	version, err := migrator.Latest()
+	if err != nil {
+		panic(fmt.Sprintf("read embedded migrations: %v", err))
+	}
+	return version
+}()
+
 type App struct {
 	serviceProvider *serviceProvider
 	server          *http.Server
@@ -29,10 +45,14 @@ func NewApp(ctx context.Context) (*App, error) {
 }
 func (a *App) Run() error {
 	defer func() {
-		closer.CloseAll()
+		if err := closer.CloseAll(); err != nil {
+			log.Error().Msgf("Shutdown error: %v", err)
+		}
 		closer.Wait()
 	}()
 
+	a.startSLAScheduler(context.Background())
+	a.startAutoReassign(context.Background())
 	return a.runServer()
 
 }
@@ -40,7 +60,12 @@ func (a *App) Run() error {
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initServiceProvider,
+		a.initMigrations,
+		a.initTracing,
 		a.initServer,
+		a.initIdempotencyCleanup,
+		a.initRateLimitCleanup,
+		a.initEventListener,
 		a.initLogger,
 	}
 
@@ -55,6 +80,8 @@ func (a *App) initDeps(ctx context.Context) error {
 
 func (a *App) initLogger(_ context.Context) error {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	// вызовы zerolog.Ctx без логгера запроса в контексте пишут в общий логгер
+	zerolog.DefaultContextLogger = &log.Logger
 	return nil
 }
 
@@ -63,10 +90,56 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initMigrations применяет встроенные миграции до того, как сервер начнет принимать запросы
+func (a *App) initMigrations(ctx context.Context) error {
+	cfg := a.serviceProvider.Config()
+	if !cfg.Migrate.OnStart {
+		return nil
+	}
+	if cfg.Storage == "memory" {
+		log.Info().Msg("MIGRATE_ON_START is ignored with STORAGE=memory")
+		return nil
+	}
+
+	m, err := migrator.New(cfg.Postgre.DSN(), cfg.Migrate.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			log.Error().Msgf("Close migrator error: %v", err)
+		}
+	}()
+
+	if err := m.Up(ctx); err != nil {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+	version, _, err := m.Version()
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Database schema is at version %d", version)
+	return nil
+}
+
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx, a.serviceProvider.Config().Tracing)
+	if err != nil {
+		return err
+	}
+	// досылаем спаны после остановки сервера и воркеров
+	closer.AddWithPriority(closer.PriorityStorage, "tracer provider", closer.DefaultTimeout, shutdown)
+	return nil
+}
+
 func (a *App) initServer(ctx context.Context) error {
 	handler := a.serviceProvider.GetHandlerContainer(ctx)
-	engine := gin.New()
-	setupRoutes(handler, engine)
+	mw := a.serviceProvider.GetMiddlewareContainer(ctx)
+	engine, err := newEngine(a.serviceProvider.Config().Server.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	setupRoutes(handler, mw, engine)
 
 	server := &http.Server{
 		Addr:              ":" + a.serviceProvider.Config().Server.Port,
@@ -75,11 +148,174 @@ func (a *App) initServer(ctx context.Context) error {
 	}
 	a.server = server
 
-	closer.Add(server.Close)
+	cfg := a.serviceProvider.Config().Server
+	closer.AddWithPriority(closer.PriorityServer, "http server", cfg.DrainDelay+cfg.ShutdownTimeout, shutdownServer(server, cfg.DrainDelay))
 
 	return nil
 }
 
+// newEngine создает gin.Engine, который верит X-Forwarded-For только от
+// trustedProxies. По умолчанию gin верит любому адресу, и клиент мог бы подменить
+// свой IP, от которого считаются лимиты по IP
+func newEngine(trustedProxies []string) (*gin.Engine, error) {
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("TRUSTED_PROXIES: %w", err)
+	}
+	return engine, nil
+}
+
+// shutdownServer останавливает сервер не сразу: readiness уже отдает 503, и за
+// drainDelay балансировщик успевает убрать реплику, пока она еще принимает запросы
+func shutdownServer(server *http.Server, drainDelay time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if drainDelay > 0 {
+			log.Info().Msgf("Draining for %s before shutting down http server", drainDelay)
+			select {
+			case <-time.After(drainDelay):
+			case <-ctx.Done():
+			}
+		}
+
+		err := server.Shutdown(ctx)
+		if errors.Is(err, context.DeadlineExceeded) {
+			// не дождались активных запросов — обрываем соединения принудительно
+			return errors.Join(err, server.Close())
+		}
+		return err
+	}
+}
+
+// initIdempotencyCleanup периодически удаляет просроченные Idempotency-Key
+func (a *App) initIdempotencyCleanup(ctx context.Context) error {
+	repo := a.serviceProvider.GetRepoContainer(ctx).Idempotency
+
+	runPeriodic(ctx, "idempotency cleanup", a.serviceProvider.Config().Idempotency.CleanupInterval, func(ctx context.Context) {
+		n, err := repo.DeleteExpired(ctx, time.Now().UTC())
+		if err != nil {
+			log.Error().Msgf("Delete expired idempotency keys error: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Info().Msgf("Deleted %d expired idempotency keys", n)
+		}
+	})
+	return nil
+}
+
+// initRateLimitCleanup удаляет из Postgres бакеты, которые за время простоя успели наполниться
+func (a *App) initRateLimitCleanup(ctx context.Context) error {
+	if a.serviceProvider.Config().RateLimit.Backend != "postgres" || a.serviceProvider.RateLimitConfig().Empty() {
+		return nil
+	}
+	repo := a.serviceProvider.GetRepoContainer(ctx).RateLimit
+	idle := a.serviceProvider.RateLimitConfig().MaxRefillTime()
+
+	runPeriodic(ctx, "rate limit cleanup", a.serviceProvider.Config().RateLimit.CleanupInterval, func(ctx context.Context) {
+		n, err := repo.DeleteStale(ctx, time.Now().UTC().Add(-idle))
+		if err != nil {
+			log.Error().Msgf("Delete stale rate limit buckets error: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Info().Msgf("Deleted %d stale rate limit buckets", n)
+		}
+	})
+	return nil
+}
+
+// initEventListener передает в шину реплики события, опубликованные через NOTIFY любой репликой
+func (a *App) initEventListener(ctx context.Context) error {
+	if a.serviceProvider.Config().Events.Backend != "postgres" {
+		return nil
+	}
+	listener := a.serviceProvider.EventListener()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		listener.Run(ctx)
+	}()
+
+	closer.AddWithPriority(closer.PriorityWorkers, "event listener", closer.DefaultTimeout, func(ctx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	return nil
+}
+
+// startSLAScheduler раз в SLA_CHECK_INTERVAL напоминает о ревью, вышедших за SLA.
+// Запускается из Run, а не из initDeps, чтобы команды CLI не рассылали напоминания
+func (a *App) startSLAScheduler(ctx context.Context) {
+	sla := a.serviceProvider.GetServiceContainer(ctx).SLA
+
+	runPeriodic(ctx, "sla scheduler", a.serviceProvider.Config().SLA.CheckInterval, func(ctx context.Context) {
+		n, err := sla.SendReminders(ctx, time.Now().UTC())
+		if err != nil {
+			log.Error().Msgf("Send SLA reminders error: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Info().Msgf("Sent %d SLA reminders", n)
+		}
+	})
+}
+
+// startAutoReassign раз в SLA_REASSIGN_INTERVAL заменяет ревьюеров, не оставивших
+// ревью за срок политики своей команды. Изменения пишутся в историю и аудит от имени воркера
+func (a *App) startAutoReassign(ctx context.Context) {
+	prs := a.serviceProvider.GetServiceContainer(ctx).PullRequest
+	ctx = actor.WithContext(ctx, &actor.Actor{Name: "worker:auto-reassign", Role: model.RoleAdmin})
+
+	runPeriodic(ctx, "auto reassign", a.serviceProvider.Config().SLA.ReassignInterval, func(ctx context.Context) {
+		n, err := prs.ReassignStale(ctx, time.Now().UTC(), a.serviceProvider.Config().SLA.BatchSize)
+		if err != nil {
+			log.Error().Msgf("Reassign stale reviews error: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Info().Msgf("Reassigned %d stale reviews", n)
+		}
+	})
+}
+
+// runPeriodic запускает fn раз в interval до остановки приложения. При остановке
+// ctx, переданный в fn, отменяется, чтобы прервать текущий проход (например,
+// зависшие вебхуки), а не ждать его до таймаута closer
+func runPeriodic(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	}()
+
+	closer.AddWithPriority(closer.PriorityWorkers, name, closer.DefaultTimeout, func(ctx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
 func (a *App) runServer() error {
 	if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
@@ -87,8 +323,11 @@ func (a *App) runServer() error {
 	return nil
 }
 
-func setupRoutes(h *HandlerContainer, e *gin.Engine) {
-	e.Use(gin.Logger(), gin.Recovery())
+func setupRoutes(h *HandlerContainer, m *MiddlewareContainer, e *gin.Engine) {
+	e.Use(m.Tracing, middleware.RequestID(), middleware.AccessLog(), gin.Recovery())
+
+	e.GET("/health/live", h.Health.Live)
+	e.GET("/health/ready", h.Health.Ready)
 
 	e.StaticFS("/swagger", http.Dir("./api/dist"))
 
@@ -97,20 +336,54 @@ func setupRoutes(h *HandlerContainer, e *gin.Engine) {
 		c.File("./api/openapi.yaml")
 	})
 
-	e.POST("/team/add", h.Team.Create)
-	e.GET("/team/get", h.Team.GetTeamByName)
+	// лимит по IP стоит до Auth, чтобы неудачные попытки авторизации тоже ограничивались
+	reader := e.Group("", m.RateLimitIP, m.Auth, m.RateLimit, m.RequireRole(model.RoleReader))
+	member := e.Group("", m.RateLimitIP, m.Auth, m.RateLimit, m.RequireRole(model.RoleMember))
+	admin := e.Group("", m.RateLimitIP, m.Auth, m.RateLimit, m.RequireRole(model.RoleAdmin))
+
+	admin.POST("/team/add", h.Team.Create)
+	admin.POST("/team/import", h.Team.Import)
+	reader.GET("/team/get", h.Team.GetTeamByName)
+	admin.POST("/team/sla", h.SLA.SetTeamSettings)
+	reader.GET("/team/sla", h.SLA.GetTeamSettings)
+	admin.POST("/team/workingHours", h.Calendar.SetTeamHours)
+	reader.GET("/team/workingHours", h.Calendar.GetTeamHours)
 
-	e.POST("/pullRequest/create", h.PullRequest.Create)
-	e.POST("/pullRequest/merge", h.PullRequest.Merge)
-	e.POST("/pullRequest/reassign", h.PullRequest.Reassign)
+	member.POST("/pullRequest/create", m.Idempotency, h.PullRequest.Create)
+	member.POST("/pullRequest/merge", m.Idempotency, h.PullRequest.Merge)
+	member.POST("/pullRequest/reassign", m.Idempotency, h.PullRequest.Reassign)
+	member.POST("/pullRequest/addReviewer", m.Idempotency, h.PullRequest.AddReviewer)
+	member.POST("/pullRequest/removeReviewer", m.Idempotency, h.PullRequest.RemoveReviewer)
+	member.POST("/pullRequest/review", m.Idempotency, h.PullRequest.Review)
 
-	e.POST("/users/setIsActive", h.User.SetActive)
-	e.GET("/users/getReview", h.PullRequest.GetByReviewer)
+	admin.POST("/users/setIsActive", h.User.SetActive)
+	reader.GET("/users/getReview", h.PullRequest.GetByReviewer)
+	admin.POST("/users/workingHours", h.Calendar.SetUserHours)
+	reader.GET("/users/workingHours", h.Calendar.GetUserHours)
+	reader.GET("/pullRequest/history", h.PullRequest.GetHistory)
+	reader.GET("/events/stream", h.Events.Stream)
 
-	stats := e.Group("/statistics")
+	stats := reader.Group("/statistics")
 	{
 		stats.GET("/reviewers", h.Statistics.GetReviewerStats)
 		stats.GET("/prs", h.Statistics.GetPRStats)
+		stats.GET("/sla", h.SLA.GetStats)
 	}
 
+	keys := admin.Group("/apiKeys")
+	{
+		keys.POST("/create", h.APIKey.Create)
+		keys.GET("/list", h.APIKey.List)
+		keys.POST("/revoke", h.APIKey.Revoke)
+	}
+
+	admin.POST("/holidays/add", h.Calendar.AddHoliday)
+	admin.POST("/holidays/remove", h.Calendar.RemoveHoliday)
+	reader.GET("/holidays/list", h.Calendar.ListHolidays)
+
+	admin.GET("/audit", h.Audit.List)
+
+	admin.GET("/admin/export", h.Archive.Export)
+	admin.POST("/admin/import", h.Archive.Import)
+
 }
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/api/middleware"
+	"PR/internal/closer"
+	"PR/internal/ratelimit"
+)
+
+func TestShutdownServer_DrainsBeforeShutdown(t *testing.T) {
+	c := closer.New()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "http://" + ln.Addr().String() + "/health/ready"
+
+	// readiness как в health.Ready: 503 с начала остановки
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-c.Closing():
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})}
+	go func() { _ = server.Serve(ln) }()
+
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	get := func() (int, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	code, err := get()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	c.AddWithPriority(closer.PriorityServer, "http server", time.Second, shutdownServer(server, 300*time.Millisecond))
+	done := make(chan error, 1)
+	go func() { done <- c.CloseAll() }()
+	<-c.Closing()
+
+	// пока идет drain, сервер принимает запросы и сообщает, что не готов
+	code, err = get()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	require.NoError(t, <-done)
+	_, err = get()
+	assert.Error(t, err)
+}
+
+func TestShutdownServer_DrainRespectsTimeout(t *testing.T) {
+	server := &http.Server{}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_ = shutdownServer(server, time.Minute)(ctx)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestNewEngine_SpoofedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &ratelimit.Config{}
+	limit, err := ratelimit.ParseLimit("1/1h")
+	require.NoError(t, err)
+	cfg.IP = &limit
+
+	send := func(engine *gin.Engine, remote, forwardedFor string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/team/get", nil)
+		req.RemoteAddr = remote + ":1234"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		engine.ServeHTTP(w, req)
+		return w.Code
+	}
+	newTestEngine := func(trusted []string) *gin.Engine {
+		engine, err := newEngine(trusted)
+		require.NoError(t, err)
+		engine.GET("/team/get", middleware.RateLimitIP(ratelimit.NewMemory(), cfg), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return engine
+	}
+
+	// без доверенных прокси подмененный X-Forwarded-For попадает в бакет адреса соединения
+	engine := newTestEngine(nil)
+	assert.Equal(t, http.StatusOK, send(engine, "203.0.113.7", "198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, send(engine, "203.0.113.7", "198.51.100.2"))
+
+	// от доверенного прокси берется адрес клиента из заголовка
+	engine = newTestEngine([]string{"10.0.0.0/8"})
+	assert.Equal(t, http.StatusOK, send(engine, "10.0.0.1", "198.51.100.1"))
+	assert.Equal(t, http.StatusOK, send(engine, "10.0.0.1", "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, send(engine, "10.0.0.1", "198.51.100.2"))
+
+	_, err = newEngine([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,354 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"PR/internal/actor"
+	"PR/internal/api/handlers"
+	"PR/internal/closer"
+	"PR/internal/model"
+	"PR/internal/service"
+)
+
+const cliUsage = `usage: PR [command]
+
+commands:
+  serve                                   start the HTTP server (default)
+  migrate up|down [N]|version|force V     manage the database schema
+  team import [-o FORMAT] FILE            create a team from a JSON file (as in /team/add), "-" for stdin
+  user deactivate [-o FORMAT] USER_ID...  deactivate users so they are no longer picked as reviewers
+  pr reassign [-o FORMAT] -pr ID -old ID [-new ID]
+                                          replace a reviewer, randomly unless -new is given
+  stats [-o FORMAT] [-prs] [-history]     reviewer statistics, or per PR with -prs
+
+FORMAT is table (default) or json. IDs are parsed like in the API: non-uuid strings are hashed.`
+
+// RunCLI выполняет подкоманду бинарника; без аргументов запускает сервер.
+// Команды над данными вызывают сервисы напрямую, минуя HTTP, и пишутся в аудит от имени "cli:<пользователь ОС>"
+func RunCLI(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return serve(ctx)
+	}
+
+	switch args[0] {
+	case "serve":
+		return serve(ctx)
+	case "migrate":
+		return RunMigrate(ctx, args[1:], out)
+	case "team", "user", "pr", "stats":
+		cmd, err := lookupCommand(args)
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return runWithServices(ctx, func(ctx context.Context, c *ServiceContraier) error {
+			return cmd(ctx, c, out)
+		})
+	case "help", "-h", "--help":
+		_, err := fmt.Fprintln(out, cliUsage)
+		return err
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], cliUsage)
+	}
+}
+
+func serve(ctx context.Context) error {
+	a, err := NewApp(ctx)
+	if err != nil {
+		return err
+	}
+	return a.Run()
+}
+
+type cliCommand func(ctx context.Context, c *ServiceContraier, out io.Writer) error
+
+// lookupCommand разбирает аргументы до подключения к базе, чтобы опечатка не требовала рабочего окружения
+func lookupCommand(args []string) (cliCommand, error) {
+	name := args[0]
+	rest := args[1:]
+	if name != "stats" {
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("%s: missing subcommand\n%s", name, cliUsage)
+		}
+		name += " " + rest[0]
+		rest = rest[1:]
+	}
+
+	switch name {
+	case "team import":
+		return parseTeamImport(rest)
+	case "user deactivate":
+		return parseUserDeactivate(rest)
+	case "pr reassign":
+		return parsePRReassign(rest)
+	case "stats":
+		return parseStats(rest)
+	default:
+		return nil, fmt.Errorf("unknown command %q\n%s", name, cliUsage)
+	}
+}
+
+// runWithServices поднимает сервисы как для сервера и закрывает соединения после команды
+func runWithServices(ctx context.Context, fn func(ctx context.Context, c *ServiceContraier) error) error {
+	defer func() {
+		if err := closer.CloseAll(); err != nil {
+			log.Error().Msgf("Close error: %v", err)
+		}
+	}()
+
+	ctx = actor.WithContext(ctx, cliActor())
+	return fn(ctx, NewServiceProvider().GetServiceContainer(ctx))
+}
+
+func cliActor() *actor.Actor {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	return &actor.Actor{Name: "cli:" + name, Role: model.RoleAdmin}
+}
+
+func newFlagSet(name string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	format := fs.String("o", "table", "output format: table or json")
+	return fs, format
+}
+
+func checkFormat(format string) error {
+	if format != "table" && format != "json" {
+		return fmt.Errorf("unknown output format %q", format)
+	}
+	return nil
+}
+
+// parseID принимает uuid или произвольную строку так же, как обработчики API
+func parseID(s string) uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return handlers.StringToUUID(s)
+	}
+	return id
+}
+
+func parseTeamImport(args []string) (cliCommand, error) {
+	fs, format := newFlagSet("team import")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := checkFormat(*format); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 1 {
+		return nil, errors.New("team import: expected exactly one FILE")
+	}
+	path := fs.Arg(0)
+
+	return func(ctx context.Context, c *ServiceContraier, out io.Writer) error {
+		return teamImport(ctx, c.Team, path, *format, out)
+	}, nil
+}
+
+func teamImport(ctx context.Context, svc service.TeamService, path, format string, out io.Writer) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var req model.CreateTeamRequest
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+	if req.TeamName == "" {
+		return errors.New("team_name is required")
+	}
+
+	team := &model.Team{
+		TeamName: req.TeamName,
+		Members:  make([]*model.TeamMember, 0, len(req.Members)),
+	}
+	for _, m := range req.Members {
+		team.Members = append(team.Members, &model.TeamMember{
+			ID:       parseID(m.ID),
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+
+	if err := svc.Create(ctx, team); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		rows = append(rows, []string{team.TeamName, m.ID.String(), m.Username, strconv.FormatBool(m.IsActive)})
+	}
+	return printResult(out, format, team, []string{"TEAM", "USER_ID", "USERNAME", "ACTIVE"}, rows)
+}
+
+func parseUserDeactivate(args []string) (cliCommand, error) {
+	fs, format := newFlagSet("user deactivate")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := checkFormat(*format); err != nil {
+		return nil, err
+	}
+	if fs.NArg() == 0 {
+		return nil, errors.New("user deactivate: expected at least one USER_ID")
+	}
+	ids := fs.Args()
+
+	return func(ctx context.Context, c *ServiceContraier, out io.Writer) error {
+		return userDeactivate(ctx, c.User, ids, *format, out)
+	}, nil
+}
+
+// userDeactivate останавливается на первой ошибке; уже деактивированные
+// пользователи все равно выводятся, а ошибка сообщает, сколько из них успело
+func userDeactivate(ctx context.Context, svc service.UserService, ids []string, format string, out io.Writer) error {
+	users := make([]*model.User, 0, len(ids))
+	for _, id := range ids {
+		u, err := svc.SetActive(ctx, &model.UserSetActive{UserID: parseID(id), IsActive: false})
+		if err != nil {
+			err = fmt.Errorf("deactivate %s (deactivated %d of %d): %w", id, len(users), len(ids), err)
+			if len(users) == 0 {
+				return err
+			}
+			return errors.Join(err, printUsers(out, format, users))
+		}
+		users = append(users, u)
+	}
+	return printUsers(out, format, users)
+}
+
+func printUsers(out io.Writer, format string, users []*model.User) error {
+	rows := make([][]string, 0, len(users))
+	for _, u := range users {
+		rows = append(rows, []string{u.ID.String(), u.Username, u.TeamName, strconv.FormatBool(u.IsActive)})
+	}
+	return printResult(out, format, users, []string{"USER_ID", "USERNAME", "TEAM", "ACTIVE"}, rows)
+}
+
+func parsePRReassign(args []string) (cliCommand, error) {
+	fs, format := newFlagSet("pr reassign")
+	prID := fs.String("pr", "", "pull request id")
+	oldID := fs.String("old", "", "reviewer to replace")
+	newID := fs.String("new", "", "replacement reviewer, random from the team if empty")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := checkFormat(*format); err != nil {
+		return nil, err
+	}
+	if *prID == "" || *oldID == "" {
+		return nil, errors.New("pr reassign: -pr and -old are required")
+	}
+
+	return func(ctx context.Context, c *ServiceContraier, out io.Writer) error {
+		return prReassign(ctx, c.PullRequest, *prID, *oldID, *newID, *format, out)
+	}, nil
+}
+
+func prReassign(ctx context.Context, svc service.PullRequestService, prID, oldID, newID, format string, out io.Writer) error {
+	var target *uuid.UUID
+	if newID != "" {
+		id := parseID(newID)
+		target = &id
+	}
+
+	pr, replacedBy, err := svc.ReassignReviewers(ctx, parseID(oldID), parseID(prID), target)
+	if err != nil {
+		return err
+	}
+
+	reviewers := make([]string, 0, len(pr.AssignedReviewers))
+	for _, id := range pr.AssignedReviewers {
+		reviewers = append(reviewers, id.String())
+	}
+	result := struct {
+		PR         *model.PullRequest `json:"pr"`
+		ReplacedBy uuid.UUID          `json:"replaced_by"`
+	}{pr, replacedBy}
+
+	return printResult(out, format, result,
+		[]string{"PR_ID", "STATUS", "REPLACED_BY", "REVIEWERS"},
+		[][]string{{pr.ID.String(), pr.Status, replacedBy.String(), strings.Join(reviewers, ",")}},
+	)
+}
+
+func parseStats(args []string) (cliCommand, error) {
+	fs, format := newFlagSet("stats")
+	prs := fs.Bool("prs", false, "statistics per pull request instead of per reviewer")
+	history := fs.Bool("history", false, "count unassigned reviewers from history too")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := checkFormat(*format); err != nil {
+		return nil, err
+	}
+	f := model.StatisticsFilter{IncludeHistory: *history}
+
+	return func(ctx context.Context, c *ServiceContraier, out io.Writer) error {
+		return stats(ctx, c.Statistics, *prs, f, *format, out)
+	}, nil
+}
+
+func stats(ctx context.Context, svc service.StatisticsService, prs bool, f model.StatisticsFilter, format string, out io.Writer) error {
+	if prs {
+		list, err := svc.GetPRStatistics(ctx, f)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(list))
+		for _, s := range list {
+			rows = append(rows, []string{s.PRID.String(), s.PRName, s.Status, strconv.Itoa(s.ReviewerCount)})
+		}
+		return printResult(out, format, list, []string{"PR_ID", "NAME", "STATUS", "REVIEWERS"}, rows)
+	}
+
+	list, err := svc.GetReviewerStatistics(ctx, f)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(list))
+	for _, s := range list {
+		rows = append(rows, []string{s.ReviewerID.String(), s.ReviewerName, strconv.Itoa(s.AssignedCount)})
+	}
+	return printResult(out, format, list, []string{"REVIEWER_ID", "USERNAME", "ASSIGNED"}, rows)
+}
+
+// printResult пишет v как JSON или header и rows таблицей, выровненной по колонкам
+func printResult(out io.Writer, format string, v any, header []string, rows [][]string) error {
+	if format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/api/handlers"
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func TestLookupCommand_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing subcommand", []string{"team"}, "missing subcommand"},
+		{"unknown subcommand", []string{"user", "delete", "u1"}, `unknown command "user delete"`},
+		{"no file", []string{"team", "import"}, "expected exactly one FILE"},
+		{"no users", []string{"user", "deactivate"}, "at least one USER_ID"},
+		{"reassign without old", []string{"pr", "reassign", "-pr", "p1"}, "-pr and -old are required"},
+		{"bad format", []string{"stats", "-o", "yaml"}, `unknown output format "yaml"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := lookupCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTeamImport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"team_name": "backend",
+		"members": [{"user_id": "u1", "username": "alice", "is_active": true}]
+	}`), 0o600))
+
+	svc := mocks.NewMockTeamService(t)
+	svc.EXPECT().Create(mock.Anything, mock.MatchedBy(func(team *model.Team) bool {
+		return team.TeamName == "backend" && len(team.Members) == 1 &&
+			team.Members[0].ID == handlers.StringToUUID("u1")
+	})).Return(nil)
+
+	var out bytes.Buffer
+	require.NoError(t, teamImport(context.Background(), svc, path, "table", &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, []string{"TEAM", "USER_ID", "USERNAME", "ACTIVE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"backend", handlers.StringToUUID("u1").String(), "alice", "true"}, strings.Fields(lines[1]))
+}
+
+func TestPRReassign_JSON(t *testing.T) {
+	prID := uuid.New()
+	oldID := uuid.New()
+	newID := uuid.New()
+
+	svc := mocks.NewMockPullRequestService(t)
+	svc.EXPECT().ReassignReviewers(mock.Anything, oldID, prID, &newID).Return(&model.PullRequest{
+		ID:                prID,
+		Status:            "OPEN",
+		AssignedReviewers: []uuid.UUID{newID},
+	}, newID, nil)
+
+	var out bytes.Buffer
+	err := prReassign(context.Background(), svc, prID.String(), oldID.String(), newID.String(), "json", &out)
+	require.NoError(t, err)
+
+	var got struct {
+		PR         model.PullRequest `json:"pr"`
+		ReplacedBy uuid.UUID         `json:"replaced_by"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, prID, got.PR.ID)
+	assert.Equal(t, newID, got.ReplacedBy)
+}
+
+func TestUserDeactivate_StopsOnError(t *testing.T) {
+	svc := mocks.NewMockUserService(t)
+	svc.EXPECT().SetActive(mock.Anything, &model.UserSetActive{UserID: handlers.StringToUUID("u1")}).
+		Return(&model.User{ID: handlers.StringToUUID("u1"), Username: "alice", TeamName: "backend"}, nil)
+	svc.EXPECT().SetActive(mock.Anything, &model.UserSetActive{UserID: handlers.StringToUUID("u2")}).
+		Return(nil, assert.AnError)
+
+	var out bytes.Buffer
+	err := userDeactivate(context.Background(), svc, []string{"u1", "u2", "u3"}, "table", &out)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "deactivate u2 (deactivated 1 of 3)")
+
+	// уже деактивированный пользователь выводится, несмотря на ошибку
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, []string{handlers.StringToUUID("u1").String(), "alice", "backend", "false"}, strings.Fields(lines[1]))
+}
+
+func TestStats_Table(t *testing.T) {
+	svc := mocks.NewMockStatisticsService(t)
+	svc.EXPECT().GetReviewerStatistics(mock.Anything, model.StatisticsFilter{IncludeHistory: true}).
+		Return([]*model.ReviewerStats{{ReviewerID: uuid.Nil, ReviewerName: "alice", AssignedCount: 3}}, nil)
+
+	var out bytes.Buffer
+	err := stats(context.Background(), svc, false, model.StatisticsFilter{IncludeHistory: true}, "table", &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, "REVIEWER_ID                           USERNAME  ASSIGNED\n"+
+		uuid.Nil.String()+"  alice     3\n", out.String())
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"PR/internal/migrator"
+)
+
+const migrateUsage = `usage: migrate <command>
+
+commands:
+  up              apply all pending migrations
+  down [N]        roll back N migrations (default 1)
+  version         print the current schema version
+  force V         mark version V as applied without running it (fixes a dirty state)`
+
+// RunMigrate выполняет подкоманду migrate с аргументами args и пишет результат в out
+func RunMigrate(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	var run func(m *migrator.Migrator) error
+	switch args[0] {
+	case "up":
+		run = func(m *migrator.Migrator) error { return m.Up(ctx) }
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid steps %q", args[1])
+			}
+			steps = n
+		}
+		run = func(m *migrator.Migrator) error { return m.Down(ctx, steps) }
+	case "force":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		run = func(m *migrator.Migrator) error { return m.Force(ctx, version) }
+	case "version":
+		// версия печатается после любой команды
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], migrateUsage)
+	}
+
+	cfg := NewServiceProvider().Config()
+	m, err := migrator.New(cfg.Postgre.DSN(), cfg.Migrate.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if run != nil {
+		if err := run(m); err != nil {
+			return err
+		}
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if dirty {
+		_, err = fmt.Fprintf(out, "version %d (dirty), embedded %d\n", version, schemaVersion)
+		return err
+	}
+	_, err = fmt.Fprintf(out, "version %d, embedded %d\n", version, schemaVersion)
+	return err
+}
@@ -2,29 +2,58 @@ package app
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	"PR/internal/client/db"
+	"PR/internal/client/db/notify"
 	"PR/internal/client/db/pg"
 	"PR/internal/client/db/transaction"
+	"PR/internal/client/webhook"
 	"PR/internal/closer"
 	"PR/internal/config"
+	"PR/internal/events"
+	"PR/internal/model"
+	"PR/internal/ratelimit"
+	"PR/internal/token"
 
+	apiKeyHandler "PR/internal/api/handlers/apikey"
+	archiveHandler "PR/internal/api/handlers/archive"
+	auditHandler "PR/internal/api/handlers/audit"
+	calendarHandler "PR/internal/api/handlers/calendar"
+	eventsHandler "PR/internal/api/handlers/events"
+	healthHandler "PR/internal/api/handlers/health"
 	prHandler "PR/internal/api/handlers/pr"
+	slaHandler "PR/internal/api/handlers/sla"
 	statHandler "PR/internal/api/handlers/statistics"
 	teamHandler "PR/internal/api/handlers/team"
 	userHandler "PR/internal/api/handlers/user"
+	"PR/internal/api/middleware"
 
 	"PR/internal/repository"
+	apiKeyRepo "PR/internal/repository/apikey"
+	archiveRepo "PR/internal/repository/archive"
+	auditRepo "PR/internal/repository/audit"
+	calendarRepo "PR/internal/repository/calendar"
+	idempotencyRepo "PR/internal/repository/idempotency"
+	"PR/internal/repository/memory"
 	prRepo "PR/internal/repository/pr"
+	rateLimitRepo "PR/internal/repository/ratelimit"
+	schemaRepo "PR/internal/repository/schema"
+	slaRepo "PR/internal/repository/sla"
 	statRepo "PR/internal/repository/statistics"
 	teamRepo "PR/internal/repository/team"
 	userRepo "PR/internal/repository/user"
 
 	"PR/internal/service"
+	apiKeyService "PR/internal/service/apikey"
+	archiveService "PR/internal/service/archive"
+	auditService "PR/internal/service/audit"
+	calendarService "PR/internal/service/calendar"
 	prService "PR/internal/service/pr"
+	slaService "PR/internal/service/sla"
 	statService "PR/internal/service/statistics"
 	teamService "PR/internal/service/team"
 	userService "PR/internal/service/user"
@@ -33,12 +62,18 @@ import (
 type serviceProvider struct {
 	config *config.Config
 
-	dbClient  db.Client
-	txManager db.TxManager
+	dbClient    db.Client
+	memoryStore *memory.Store
+	txManager   db.TxManager
+	eventBus    *events.Bus
+	publisher   events.Publisher
 
-	handlerContainer *HandlerContainer
-	serviceContraier *ServiceContraier
-	repoContainer    *RepoContainer
+	rateLimitConfig *ratelimit.Config
+
+	handlerContainer    *HandlerContainer
+	middlewareContainer *MiddlewareContainer
+	serviceContraier    *ServiceContraier
+	repoContainer       *RepoContainer
 }
 
 type HandlerContainer struct {
@@ -46,6 +81,23 @@ type HandlerContainer struct {
 	Team        *teamHandler.TeamHandler
 	PullRequest *prHandler.PullRequestHandler
 	Statistics  *statHandler.StatisticsHandler
+	Health      *healthHandler.HealthHandler
+	APIKey      *apiKeyHandler.APIKeyHandler
+	Audit       *auditHandler.AuditHandler
+	Archive     *archiveHandler.ArchiveHandler
+	Events      *eventsHandler.EventsHandler
+	SLA         *slaHandler.SLAHandler
+	Calendar    *calendarHandler.CalendarHandler
+}
+
+type MiddlewareContainer struct {
+	Auth        gin.HandlerFunc
+	RequireRole func(role model.Role) gin.HandlerFunc
+	Idempotency gin.HandlerFunc
+	RateLimit   gin.HandlerFunc
+	// лимит по IP до авторизации
+	RateLimitIP gin.HandlerFunc
+	Tracing     gin.HandlerFunc
 }
 
 type ServiceContraier struct {
@@ -53,6 +105,11 @@ type ServiceContraier struct {
 	Team        service.TeamService
 	PullRequest service.PullRequestService
 	Statistics  service.StatisticsService
+	APIKey      service.APIKeyService
+	Audit       service.AuditService
+	Archive     service.ArchiveService
+	SLA         service.SLAService
+	Calendar    service.CalendarService
 }
 
 type RepoContainer struct {
@@ -60,6 +117,14 @@ type RepoContainer struct {
 	Team        repository.TeamRepository
 	PullRequest repository.PullRequestRepository
 	Statistics  repository.StatisticsRepository
+	Schema      repository.SchemaRepository
+	APIKey      repository.APIKeyRepository
+	Audit       repository.AuditRepository
+	Idempotency repository.IdempotencyRepository
+	RateLimit   repository.RateLimitRepository
+	Archive     repository.ArchiveRepository
+	SLA         repository.SLARepository
+	Calendar    repository.CalendarRepository
 }
 
 func (s *serviceProvider) Config() *config.Config {
@@ -80,11 +145,10 @@ func NewServiceProvider() *serviceProvider {
 
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
-		dsn := fmt.Sprintf(
-			"host=%s user=%s password=%s database=%s sslmode=disable",
-			s.Config().Postgre.Host, s.Config().Postgre.User, s.Config().Postgre.Password, s.Config().Postgre.DBName,
-		)
-		db, err := pg.New(ctx, dsn)
+		db, err := pg.New(ctx, s.Config().Postgre.DSN(), pg.QueryLogConfig{
+			SlowThreshold: s.Config().Postgre.SlowQueryThreshold,
+			Debug:         s.Config().Postgre.QueryDebug,
+		})
 		if err != nil {
 			log.Fatal().Msgf("Connect database error: %v", err)
 		}
@@ -93,9 +157,8 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 			log.Fatal().Msgf("Ping database error: %v", err)
 		}
 
-		closer.Add(func() error {
-			err := db.Close()
-			return err
+		closer.AddWithPriority(closer.PriorityStorage, "db pool", closer.DefaultTimeout, func(context.Context) error {
+			return db.Close()
 		})
 		s.dbClient = db
 	}
@@ -104,48 +167,167 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 
 }
 
+func (s *serviceProvider) MemoryStore() *memory.Store {
+	if s.memoryStore == nil {
+		log.Warn().Msg("STORAGE=memory, data is lost on restart")
+		s.memoryStore = memory.NewStore()
+	}
+	return s.memoryStore
+}
+
+func (s *serviceProvider) Pinger(ctx context.Context) db.Pinger {
+	if s.Config().Storage == "memory" {
+		return s.MemoryStore()
+	}
+	return s.DBClient(ctx).DB()
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
-		tx := transaction.NewTransactionManager(s.dbClient.DB())
+		if s.Config().Storage == "memory" {
+			s.txManager = memory.NewTxManager(s.MemoryStore())
+			return s.txManager
+		}
+		tx := transaction.NewTransactionManager(s.DBClient(ctx).DB())
 		s.txManager = tx
 	}
 	return s.txManager
 }
 
-func (s *serviceProvider) GetRepoContainer(ctx context.Context) *RepoContainer {
-	if s.repoContainer == nil {
-		user := userRepo.NewRepository(s.DBClient(ctx))
-		team := teamRepo.NewRepository(s.DBClient(ctx))
-		pr := prRepo.NewRepository(s.DBClient(ctx))
-		stat := statRepo.NewRepository(s.DBClient(ctx))
+// EventBus — шина событий процесса, из нее читает /events/stream
+func (s *serviceProvider) EventBus() *events.Bus {
+	if s.eventBus == nil {
+		s.eventBus = events.NewBus(s.Config().Events.BufferSize)
+	}
+	return s.eventBus
+}
 
-		s.repoContainer = &RepoContainer{
-			User:        user,
-			Team:        team,
-			PullRequest: pr,
-			Statistics:  stat,
+// EventPublisher — куда сервисы публикуют события. С EVENTS_BACKEND=postgres
+// события идут через NOTIFY и попадают в шину каждой реплики через EventListener
+func (s *serviceProvider) EventPublisher(ctx context.Context) events.Publisher {
+	if s.publisher == nil {
+		switch s.Config().Events.Backend {
+		case "memory":
+			s.publisher = s.EventBus()
+		case "postgres":
+			if s.Config().Storage != "postgres" {
+				log.Fatal().Msg("EVENTS_BACKEND=postgres requires STORAGE=postgres")
+			}
+			s.publisher = notify.NewNotifier(s.DBClient(ctx))
+		default:
+			log.Fatal().Msgf("Unknown EVENTS_BACKEND %q", s.Config().Events.Backend)
 		}
+	}
+	return s.publisher
+}
+
+func (s *serviceProvider) EventListener() *notify.Listener {
+	return notify.NewListener(s.Config().Postgre.DSN(), s.EventBus())
+}
 
+func (s *serviceProvider) GetRepoContainer(ctx context.Context) *RepoContainer {
+	if s.repoContainer == nil {
+		switch s.Config().Storage {
+		case "postgres":
+			s.repoContainer = s.postgresRepoContainer(ctx)
+		case "memory":
+			s.repoContainer = s.memoryRepoContainer()
+		default:
+			log.Fatal().Msgf("Unknown STORAGE %q", s.Config().Storage)
+		}
 	}
 	return s.repoContainer
 }
 
+// memoryRepoContainer — репозитории над одним Store; лимиты запросов в нем
+// не хранятся, для них есть RATE_LIMIT_BACKEND=memory
+func (s *serviceProvider) memoryRepoContainer() *RepoContainer {
+	store := s.MemoryStore()
+	return &RepoContainer{
+		User:        memory.NewUserRepository(store),
+		Team:        memory.NewTeamRepository(store),
+		PullRequest: memory.NewPullRequestRepository(store),
+		Statistics:  memory.NewStatisticsRepository(store),
+		Schema:      memory.NewSchemaRepository(schemaVersion),
+		APIKey:      memory.NewAPIKeyRepository(store),
+		Audit:       memory.NewAuditRepository(store),
+		Idempotency: memory.NewIdempotencyRepository(store),
+		Archive:     memory.NewArchiveRepository(store),
+		SLA:         memory.NewSLARepository(store),
+		Calendar:    memory.NewCalendarRepository(store),
+	}
+}
+
+func (s *serviceProvider) postgresRepoContainer(ctx context.Context) *RepoContainer {
+	return &RepoContainer{
+		User:        userRepo.NewRepository(s.DBClient(ctx)),
+		Team:        teamRepo.NewRepository(s.DBClient(ctx)),
+		PullRequest: prRepo.NewRepository(s.DBClient(ctx)),
+		Statistics:  statRepo.NewRepository(s.DBClient(ctx)),
+		Schema:      schemaRepo.NewRepository(s.DBClient(ctx)),
+		APIKey:      apiKeyRepo.NewRepository(s.DBClient(ctx)),
+		Audit:       auditRepo.NewRepository(s.DBClient(ctx)),
+		Idempotency: idempotencyRepo.NewRepository(s.DBClient(ctx)),
+		RateLimit:   rateLimitRepo.NewRepository(s.DBClient(ctx)),
+		Archive:     archiveRepo.NewRepository(s.DBClient(ctx)),
+		SLA:         slaRepo.NewRepository(s.DBClient(ctx)),
+		Calendar:    calendarRepo.NewRepository(s.DBClient(ctx)),
+	}
+}
+
 func (s *serviceProvider) GetServiceContainer(ctx context.Context) *ServiceContraier {
 	if s.serviceContraier == nil {
-		user := userService.NewService(s.GetRepoContainer(ctx).User, s.TxManager(ctx))
-		team := teamService.NewService(s.GetRepoContainer(ctx).Team, s.TxManager(ctx))
-		pr := prService.NewService(
+		user := userService.NewService(s.GetRepoContainer(ctx).User, s.GetRepoContainer(ctx).Audit, s.TxManager(ctx), s.EventPublisher(ctx))
+		team := teamService.WithTracing(
+			teamService.NewService(
+				s.GetRepoContainer(ctx).Team,
+				s.GetRepoContainer(ctx).User,
+				s.GetRepoContainer(ctx).Audit,
+				s.TxManager(ctx),
+				s.EventPublisher(ctx),
+			),
+		)
+		pr := prService.WithTracing(prService.NewService(
 			s.GetRepoContainer(ctx).PullRequest,
 			s.GetRepoContainer(ctx).User,
+			s.GetRepoContainer(ctx).Calendar,
+			s.GetRepoContainer(ctx).Audit,
 			s.TxManager(ctx),
-		)
+			s.EventPublisher(ctx),
+		))
 		stat := statService.NewService(s.GetRepoContainer(ctx).Statistics, s.TxManager(ctx))
+		apiKey := apiKeyService.NewService(
+			s.GetRepoContainer(ctx).APIKey,
+			s.TxManager(ctx),
+			s.Config().Auth.BootstrapKey,
+		)
 
 		s.serviceContraier = &ServiceContraier{
 			User:        user,
 			Team:        team,
 			PullRequest: pr,
 			Statistics:  stat,
+			APIKey:      apiKey,
+			Audit:       auditService.NewService(s.GetRepoContainer(ctx).Audit),
+			Archive: archiveService.NewService(
+				s.GetRepoContainer(ctx).Archive,
+				s.GetRepoContainer(ctx).Audit,
+				s.TxManager(ctx),
+			),
+			SLA: slaService.NewService(
+				s.GetRepoContainer(ctx).SLA,
+				s.GetRepoContainer(ctx).Calendar,
+				s.GetRepoContainer(ctx).Audit,
+				s.TxManager(ctx),
+				s.EventPublisher(ctx),
+				webhook.NewClient(s.Config().SLA.WebhookTimeout),
+				s.Config().SLA.BatchSize,
+			),
+			Calendar: calendarService.NewService(
+				s.GetRepoContainer(ctx).Calendar,
+				s.GetRepoContainer(ctx).Audit,
+				s.TxManager(ctx),
+			),
 		}
 	}
 	return s.serviceContraier
@@ -157,13 +339,122 @@ func (s *serviceProvider) GetHandlerContainer(ctx context.Context) *HandlerConta
 		team := teamHandler.NewTeamHandler(s.GetServiceContainer(ctx).Team)
 		pr := prHandler.NewPullRequestHandler(s.GetServiceContainer(ctx).PullRequest)
 		stat := statHandler.NewHandler(s.GetServiceContainer(ctx).Statistics)
+		health := healthHandler.NewHandler(
+			s.Pinger(ctx),
+			s.GetRepoContainer(ctx).Schema,
+			schemaVersion,
+			closer.Closing(),
+		)
 
 		s.handlerContainer = &HandlerContainer{
 			User:        user,
 			Team:        team,
 			PullRequest: pr,
 			Statistics:  stat,
+			Health:      health,
+			APIKey:      apiKeyHandler.NewAPIKeyHandler(s.GetServiceContainer(ctx).APIKey),
+			Audit:       auditHandler.NewAuditHandler(s.GetServiceContainer(ctx).Audit),
+			Archive:     archiveHandler.NewArchiveHandler(s.GetServiceContainer(ctx).Archive),
+			Events: eventsHandler.NewEventsHandler(
+				s.EventBus(),
+				s.GetServiceContainer(ctx).Team,
+				s.Config().Events.HeartbeatInterval,
+				closer.Closing(),
+			),
+			SLA:      slaHandler.NewSLAHandler(s.GetServiceContainer(ctx).SLA),
+			Calendar: calendarHandler.NewCalendarHandler(s.GetServiceContainer(ctx).Calendar),
 		}
 	}
 	return s.handlerContainer
 }
+
+// GetMiddlewareContainer при выключенной авторизации возвращает пропускающие заглушки
+func (s *serviceProvider) GetMiddlewareContainer(ctx context.Context) *MiddlewareContainer {
+	if s.middlewareContainer == nil {
+		idempotency := middleware.Idempotency(s.GetRepoContainer(ctx).Idempotency, s.Config().Idempotency.Lease, s.Config().Idempotency.TTL)
+		rateLimit, rateLimitIP := s.rateLimitMiddleware(ctx)
+		tracing := otelgin.Middleware(s.Config().Tracing.ServiceName)
+
+		if !s.Config().Auth.Enabled {
+			log.Warn().Msg("Auth is disabled, all endpoints are public")
+			pass := func(c *gin.Context) { c.Next() }
+			s.middlewareContainer = &MiddlewareContainer{
+				Auth:        pass,
+				RequireRole: func(model.Role) gin.HandlerFunc { return pass },
+				Idempotency: idempotency,
+				RateLimit:   rateLimit,
+				RateLimitIP: rateLimitIP,
+				Tracing:     tracing,
+			}
+			return s.middlewareContainer
+		}
+
+		if s.Config().Auth.BootstrapKey == "" {
+			log.Warn().Msg("AUTH_BOOTSTRAP_KEY is empty, only keys stored in database are accepted")
+		}
+
+		verifier, err := token.NewVerifier(s.Config().Auth.JWT)
+		if err != nil {
+			log.Fatal().Msgf("Load JWT keys error: %v", err)
+		}
+		// без ключей nil-указатель нельзя класть в интерфейс: middleware проверяет tokens == nil
+		var tokens middleware.TokenVerifier
+		if verifier != nil {
+			tokens = verifier
+		} else {
+			log.Warn().Msg("JWT keys are not configured, bearer tokens are rejected")
+		}
+
+		s.middlewareContainer = &MiddlewareContainer{
+			Auth:        middleware.Authenticate(s.GetServiceContainer(ctx).APIKey, tokens),
+			RequireRole: middleware.RequireRole,
+			Idempotency: idempotency,
+			RateLimit:   rateLimit,
+			RateLimitIP: rateLimitIP,
+			Tracing:     tracing,
+		}
+	}
+	return s.middlewareContainer
+}
+
+func (s *serviceProvider) RateLimitConfig() *ratelimit.Config {
+	if s.rateLimitConfig == nil {
+		c, err := ratelimit.ParseConfig(s.Config().RateLimit.Default, s.Config().RateLimit.Routes)
+		if err != nil {
+			log.Fatal().Msgf("Parse rate limits error: %v", err)
+		}
+		if raw := s.Config().RateLimit.IP; raw != "" {
+			l, err := ratelimit.ParseLimit(raw)
+			if err != nil {
+				log.Fatal().Msgf("Parse RATE_LIMIT_IP error: %v", err)
+			}
+			c.IP = &l
+		}
+		s.rateLimitConfig = c
+	}
+	return s.rateLimitConfig
+}
+
+// rateLimitMiddleware возвращает лимит по клиенту и маршруту и лимит по IP до
+// авторизации; оба работают с одним хранилищем бакетов
+func (s *serviceProvider) rateLimitMiddleware(ctx context.Context) (gin.HandlerFunc, gin.HandlerFunc) {
+	if s.RateLimitConfig().Empty() {
+		log.Warn().Msg("Rate limits are not configured")
+		pass := func(c *gin.Context) { c.Next() }
+		return pass, pass
+	}
+
+	var limiter middleware.RateLimiter
+	switch s.Config().RateLimit.Backend {
+	case "memory":
+		limiter = ratelimit.NewMemory()
+	case "postgres":
+		if s.Config().Storage == "memory" {
+			log.Fatal().Msg("RATE_LIMIT_BACKEND=postgres requires STORAGE=postgres")
+		}
+		limiter = s.GetRepoContainer(ctx).RateLimit
+	default:
+		log.Fatal().Msgf("Unknown RATE_LIMIT_BACKEND %q", s.Config().RateLimit.Backend)
+	}
+	return middleware.RateLimit(limiter, s.RateLimitConfig()), middleware.RateLimitIP(limiter, s.RateLimitConfig())
+}
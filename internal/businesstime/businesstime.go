@@ -0,0 +1,241 @@
+// Package businesstime считает рабочее время: с понедельника по пятницу с
+// начала до конца рабочего дня по местному времени, без праздников. Границы
+// дня вычисляются заново для каждой даты в часовом поясе календаря, поэтому
+// переходы на летнее время удлиняют или укорачивают только тот день, на
+// который приходятся.
+package businesstime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PR/internal/model"
+
+	// база часовых поясов встроена в бинарник: в контейнере ее может не быть
+	_ "time/tzdata"
+)
+
+const (
+	ClockLayout = "15:04"
+	DateLayout  = "2006-01-02"
+
+	// MaxHolidayName — длина holidays.name в схеме
+	MaxHolidayName = 200
+)
+
+var (
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	ErrInvalidClock    = errors.New("invalid clock, expected HH:MM")
+	ErrInvalidHours    = errors.New("work start must be before work end")
+	ErrInvalidDate     = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+// Calendar — рабочий график в одном часовом поясе. nil календарь считает
+// время по часам, без выходных и праздников
+type Calendar struct {
+	loc        *time.Location
+	start, end clock
+	holidays   map[date]struct{}
+}
+
+// clock — время суток в минутах от полуночи, 24:00 допустимо как конец дня
+type clock int
+
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// New собирает календарь. start и end — "HH:MM" по местному времени,
+// holidays — даты "YYYY-MM-DD" в том же часовом поясе
+func New(timezone, start, end string, holidays []string) (*Calendar, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := parseHours(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Calendar{loc: loc, start: from, end: to, holidays: make(map[date]struct{}, len(holidays))}
+	for _, h := range holidays {
+		d, err := ParseDate(h)
+		if err != nil {
+			return nil, err
+		}
+		c.holidays[dateOf(d)] = struct{}{}
+	}
+	return c, nil
+}
+
+// LoadLocation принимает только IANA имена: "Local" зависит от машины,
+// на которой запущен сервис, а пустая строка неотличима от незаданного пояса
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" || timezone == "Local" {
+		return nil, ErrInvalidTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTimezone, timezone)
+	}
+	return loc, nil
+}
+
+// ValidateHours проверяет, что рабочий день не пустой и не переходит через полночь
+func ValidateHours(start, end string) error {
+	_, _, err := parseHours(start, end)
+	return err
+}
+
+// ValidateWorkingHours проверяет график целиком: часовой пояс из базы IANA,
+// рабочий день "HH:MM"-"HH:MM" не пустой
+func ValidateWorkingHours(wh model.WorkingHours) error {
+	if _, err := LoadLocation(wh.Timezone); err != nil {
+		return err
+	}
+	return ValidateHours(wh.WorkStart, wh.WorkEnd)
+}
+
+func parseHours(start, end string) (clock, clock, error) {
+	from, err := parseClock(start)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := parseClock(end)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from >= to {
+		return 0, 0, ErrInvalidHours
+	}
+	return from, to, nil
+}
+
+// ValidateDate проверяет дату "YYYY-MM-DD"
+func ValidateDate(s string) error {
+	_, err := ParseDate(s)
+	return err
+}
+
+func ParseDate(s string) (time.Time, error) {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidDate, s)
+	}
+	return d, nil
+}
+
+func parseClock(s string) (clock, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	// time.Parse допускает час из одной цифры, формат же строго HH:MM
+	t, err := time.Parse(ClockLayout, s)
+	if err != nil || len(s) != len(ClockLayout) {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidClock, s)
+	}
+	return clock(t.Hour()*60 + t.Minute()), nil
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{year: y, month: m, day: d}
+}
+
+func (d date) next() date {
+	return dateOf(time.Date(d.year, d.month, d.day+1, 0, 0, 0, 0, time.UTC))
+}
+
+func (d date) after(o date) bool {
+	if d.year != o.year {
+		return d.year > o.year
+	}
+	if d.month != o.month {
+		return d.month > o.month
+	}
+	return d.day > o.day
+}
+
+// at — момент времени суток cl даты d в поясе календаря. Несуществующее
+// из-за перевода часов время time.Date сдвигает на час
+func (c *Calendar) at(d date, cl clock) time.Time {
+	return time.Date(d.year, d.month, d.day, int(cl)/60, int(cl)%60, 0, 0, c.loc)
+}
+
+func (c *Calendar) isWorkday(d date) bool {
+	switch time.Date(d.year, d.month, d.day, 12, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	_, holiday := c.holidays[d]
+	return !holiday
+}
+
+// IsWorkday — рабочий ли день по местному времени календаря
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	if c == nil {
+		return true
+	}
+	return c.isWorkday(dateOf(t.In(c.loc)))
+}
+
+// Between — сколько рабочего времени прошло с from до to; 0, если to не позже from
+func (c *Calendar) Between(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	if c == nil {
+		return to.Sub(from)
+	}
+
+	var total time.Duration
+	last := dateOf(to.In(c.loc))
+	for d := dateOf(from.In(c.loc)); !d.after(last); d = d.next() {
+		if !c.isWorkday(d) {
+			continue
+		}
+		start, end := c.at(d, c.start), c.at(d, c.end)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+	return total
+}
+
+// Add — момент, когда с from пройдет d рабочего времени. Если from вне
+// рабочих часов, отсчет начинается с ближайшего начала рабочего дня
+func (c *Calendar) Add(from time.Time, d time.Duration) time.Time {
+	if c == nil {
+		return from.Add(d)
+	}
+	if d <= 0 {
+		return from
+	}
+
+	for day := dateOf(from.In(c.loc)); ; day = day.next() {
+		if !c.isWorkday(day) {
+			continue
+		}
+		start, end := c.at(day, c.start), c.at(day, c.end)
+		if start.Before(from) {
+			start = from
+		}
+		if !end.After(start) {
+			continue
+		}
+		if left := end.Sub(start); d > left {
+			d -= left
+			continue
+		}
+		return start.Add(d).In(from.Location())
+	}
+}
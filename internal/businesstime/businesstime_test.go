@@ -0,0 +1,231 @@
+package businesstime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/model"
+)
+
+type schedule struct {
+	tz         string
+	start, end string
+	holidays   []string
+}
+
+var (
+	utc      = schedule{tz: "UTC", start: "09:00", end: "18:00"}
+	newYork  = schedule{tz: "America/New_York", start: "09:00", end: "18:00"}
+	berlin   = schedule{tz: "Europe/Berlin", start: "09:00", end: "18:00"}
+	tokyo    = schedule{tz: "Asia/Tokyo", start: "09:00", end: "18:00"}
+	la       = schedule{tz: "America/Los_Angeles", start: "09:00", end: "18:00"}
+	xmas     = schedule{tz: "Europe/Berlin", start: "09:00", end: "18:00", holidays: []string{"2026-12-25"}}
+	xmasLong = schedule{tz: "Europe/Berlin", start: "09:00", end: "18:00", holidays: []string{"2026-12-24", "2026-12-25", "2026-12-31", "2027-01-01"}}
+	// в Израиле и Египте часы переводят в пятницу и четверг, то есть в будни
+	jerusalem24 = schedule{tz: "Asia/Jerusalem", start: "00:00", end: "24:00"}
+	jerusalemAM = schedule{tz: "Asia/Jerusalem", start: "01:00", end: "05:00"}
+	cairo24     = schedule{tz: "Africa/Cairo", start: "00:00", end: "24:00"}
+	cairo       = schedule{tz: "Africa/Cairo", start: "09:00", end: "18:00"}
+	evening     = schedule{tz: "UTC", start: "20:00", end: "24:00"}
+)
+
+func (s schedule) calendar(t *testing.T) *Calendar {
+	t.Helper()
+	if s.tz == "" {
+		return nil
+	}
+	c, err := New(s.tz, s.start, s.end, s.holidays)
+	require.NoError(t, err)
+	return c
+}
+
+func ts(t *testing.T, s string) time.Time {
+	t.Helper()
+	v, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return v
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       schedule
+		wantErr error
+	}{
+		{name: "обычный день", s: utc},
+		{name: "до полуночи", s: evening},
+		{name: "круглые сутки", s: jerusalem24},
+		{name: "праздники", s: xmasLong},
+		{name: "неизвестный пояс", s: schedule{tz: "Mars/Olympus", start: "09:00", end: "18:00"}, wantErr: ErrInvalidTimezone},
+		{name: "пустой пояс", s: schedule{tz: "", start: "09:00", end: "18:00"}, wantErr: ErrInvalidTimezone},
+		{name: "локальный пояс", s: schedule{tz: "Local", start: "09:00", end: "18:00"}, wantErr: ErrInvalidTimezone},
+		{name: "одна цифра часа", s: schedule{tz: "UTC", start: "9:00", end: "18:00"}, wantErr: ErrInvalidClock},
+		{name: "25 часов", s: schedule{tz: "UTC", start: "09:00", end: "25:00"}, wantErr: ErrInvalidClock},
+		{name: "24:00 как начало", s: schedule{tz: "UTC", start: "24:00", end: "24:00"}, wantErr: ErrInvalidHours},
+		{name: "пустой день", s: schedule{tz: "UTC", start: "09:00", end: "09:00"}, wantErr: ErrInvalidHours},
+		{name: "через полночь", s: schedule{tz: "UTC", start: "22:00", end: "06:00"}, wantErr: ErrInvalidHours},
+		{name: "неверный праздник", s: schedule{tz: "UTC", start: "09:00", end: "18:00", holidays: []string{"2026-13-01"}}, wantErr: ErrInvalidDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.s.tz, tt.s.start, tt.s.end, tt.s.holidays)
+			// без праздников ValidateWorkingHours проверяет то же, что New
+			var validateErr error
+			if len(tt.s.holidays) == 0 {
+				validateErr = ValidateWorkingHours(model.WorkingHours{Timezone: tt.s.tz, WorkStart: tt.s.start, WorkEnd: tt.s.end})
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				if len(tt.s.holidays) == 0 {
+					assert.ErrorIs(t, validateErr, tt.wantErr)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, validateErr)
+		})
+	}
+}
+
+func TestIsWorkday(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        schedule
+		at       string
+		expected bool
+	}{
+		{name: "понедельник", s: utc, at: "2026-03-02T12:00:00Z", expected: true},
+		{name: "суббота", s: utc, at: "2026-03-07T12:00:00Z", expected: false},
+		{name: "воскресенье", s: utc, at: "2026-03-08T12:00:00Z", expected: false},
+		{name: "праздник", s: xmas, at: "2026-12-25T12:00:00+01:00", expected: false},
+		// 23:00 пятницы по UTC — уже суббота в Токио
+		{name: "дата берется по поясу календаря", s: tokyo, at: "2026-03-06T23:00:00Z", expected: false},
+		// 23:30 по UTC в четверг — уже пятница, 25 декабря, в Берлине
+		{name: "праздник по местной дате", s: xmas, at: "2026-12-24T23:30:00Z", expected: false},
+		{name: "без календаря", s: schedule{}, at: "2026-03-07T12:00:00Z", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.s.calendar(t).IsWorkday(ts(t, tt.at)))
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        schedule
+		from, to string
+		expected time.Duration
+	}{
+		{name: "внутри дня", s: utc, from: "2026-03-02T10:00:00Z", to: "2026-03-02T12:30:00Z", expected: 150 * time.Minute},
+		{name: "обрезается рабочим днем", s: utc, from: "2026-03-02T07:00:00Z", to: "2026-03-02T20:00:00Z", expected: 9 * time.Hour},
+		{name: "после конца дня", s: utc, from: "2026-03-02T18:30:00Z", to: "2026-03-02T23:00:00Z", expected: 0},
+		{name: "через ночь", s: utc, from: "2026-03-02T17:00:00Z", to: "2026-03-03T10:00:00Z", expected: 2 * time.Hour},
+		{name: "через выходные", s: utc, from: "2026-03-06T17:00:00Z", to: "2026-03-09T10:00:00Z", expected: 2 * time.Hour},
+		{name: "целиком в выходные", s: utc, from: "2026-03-07T10:00:00Z", to: "2026-03-08T20:00:00Z", expected: 0},
+		{name: "рабочая неделя", s: utc, from: "2026-03-02T09:00:00Z", to: "2026-03-09T09:00:00Z", expected: 45 * time.Hour},
+		{name: "to раньше from", s: utc, from: "2026-03-02T12:00:00Z", to: "2026-03-02T10:00:00Z", expected: 0},
+		{name: "пустой интервал", s: utc, from: "2026-03-02T12:00:00Z", to: "2026-03-02T12:00:00Z", expected: 0},
+		{name: "праздник пропускается", s: xmas, from: "2026-12-24T17:00:00+01:00", to: "2026-12-28T10:00:00+01:00", expected: 2 * time.Hour},
+		{name: "праздник другого года не мешает", s: schedule{tz: "Europe/Berlin", start: "09:00", end: "18:00", holidays: []string{"2025-12-25"}},
+			from: "2026-12-24T17:00:00+01:00", to: "2026-12-28T10:00:00+01:00", expected: 11 * time.Hour},
+		{name: "праздники подряд", s: xmasLong, from: "2026-12-23T09:00:00+01:00", to: "2027-01-04T18:00:00+01:00", expected: 5 * 9 * time.Hour},
+		{name: "ночь по UTC внутри рабочего дня", s: tokyo, from: "2026-03-02T00:00:00Z", to: "2026-03-02T09:00:00Z", expected: 9 * time.Hour},
+		{name: "рабочий день переходит полночь UTC", s: la, from: "2026-03-03T01:00:00Z", to: "2026-03-03T18:00:00Z", expected: 2 * time.Hour},
+		{name: "конец дня в 24:00", s: evening, from: "2026-03-02T22:00:00Z", to: "2026-03-03T01:00:00Z", expected: 2 * time.Hour},
+		{name: "без календаря по часам", s: schedule{}, from: "2026-03-07T10:00:00Z", to: "2026-03-08T10:00:00Z", expected: 24 * time.Hour},
+
+		// переход на летнее время в Нью-Йорке 8 марта и обратно 1 ноября
+		// приходится на воскресенье: рабочие часы по местному времени те же
+		{name: "Нью-Йорк: весенний перевод в выходные", s: newYork, from: "2026-03-06T17:00:00-05:00", to: "2026-03-09T10:00:00-04:00", expected: 2 * time.Hour},
+		{name: "Нью-Йорк: осенний перевод в выходные", s: newYork, from: "2026-10-30T17:00:00-04:00", to: "2026-11-02T10:00:00-05:00", expected: 2 * time.Hour},
+		{name: "Нью-Йорк: неделя до и после перевода", s: newYork, from: "2026-03-02T09:00:00-05:00", to: "2026-03-13T18:00:00-04:00", expected: 90 * time.Hour},
+		{name: "Берлин: неделя с весенним переводом", s: berlin, from: "2026-03-23T09:00:00+01:00", to: "2026-03-30T09:00:00+02:00", expected: 45 * time.Hour},
+		{name: "Берлин: неделя с осенним переводом", s: berlin, from: "2026-10-19T09:00:00+02:00", to: "2026-10-26T09:00:00+01:00", expected: 45 * time.Hour},
+		{name: "Берлин: понедельник после перевода по UTC", s: berlin, from: "2026-03-30T07:00:00Z", to: "2026-03-30T16:00:00Z", expected: 9 * time.Hour},
+
+		// переход в рабочий день
+		{name: "Иерусалим: сутки весеннего перевода короче", s: jerusalem24, from: "2026-03-27T00:00:00+02:00", to: "2026-03-28T00:00:00+03:00", expected: 23 * time.Hour},
+		{name: "Иерусалим: рабочие часы захватывают пропущенный час", s: jerusalemAM, from: "2026-03-26T12:00:00+02:00", to: "2026-03-28T12:00:00+03:00", expected: 3 * time.Hour},
+		{name: "Каир: полночь весеннего перевода не существует", s: cairo24, from: "2026-04-23T12:00:00+02:00", to: "2026-04-25T00:00:00+03:00", expected: 35 * time.Hour},
+		{name: "Каир: сутки осеннего перевода длиннее", s: cairo24, from: "2026-10-29T00:00:00+03:00", to: "2026-10-30T00:00:00+02:00", expected: 25 * time.Hour},
+		{name: "Каир: рабочий день осеннего перевода обычный", s: cairo, from: "2026-10-29T00:00:00+03:00", to: "2026-10-30T00:00:00+02:00", expected: 9 * time.Hour},
+		{name: "Каир: повторный час после перевода", s: cairo24, from: "2026-10-29T23:30:00+03:00", to: "2026-10-29T23:30:00+02:00", expected: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s.calendar(t).Between(ts(t, tt.from), ts(t, tt.to))
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        schedule
+		from     string
+		d        time.Duration
+		expected string
+	}{
+		{name: "внутри дня", s: utc, from: "2026-03-02T10:00:00Z", d: 2 * time.Hour, expected: "2026-03-02T12:00:00Z"},
+		{name: "ровно до конца дня", s: utc, from: "2026-03-02T09:00:00Z", d: 9 * time.Hour, expected: "2026-03-02T18:00:00Z"},
+		{name: "переходит на следующий день", s: utc, from: "2026-03-02T09:00:00Z", d: 9*time.Hour + time.Minute, expected: "2026-03-03T09:01:00Z"},
+		{name: "до начала дня", s: utc, from: "2026-03-02T07:00:00Z", d: time.Hour, expected: "2026-03-02T10:00:00Z"},
+		{name: "после конца дня", s: utc, from: "2026-03-02T20:00:00Z", d: time.Hour, expected: "2026-03-03T10:00:00Z"},
+		{name: "через выходные", s: utc, from: "2026-03-06T17:00:00Z", d: 3 * time.Hour, expected: "2026-03-09T11:00:00Z"},
+		{name: "из субботы", s: utc, from: "2026-03-07T12:00:00Z", d: time.Hour, expected: "2026-03-09T10:00:00Z"},
+		{name: "ноль", s: utc, from: "2026-03-07T12:00:00Z", d: 0, expected: "2026-03-07T12:00:00Z"},
+		{name: "несколько недель", s: utc, from: "2026-03-02T09:00:00Z", d: 100 * time.Hour, expected: "2026-03-17T10:00:00Z"},
+		{name: "праздник", s: xmas, from: "2026-12-24T17:00:00+01:00", d: 2 * time.Hour, expected: "2026-12-28T10:00:00+01:00"},
+		{name: "праздники подряд", s: xmasLong, from: "2026-12-23T17:00:00+01:00", d: 10 * time.Hour, expected: "2026-12-28T18:00:00+01:00"},
+		{name: "через Новый год", s: xmasLong, from: "2026-12-30T17:00:00+01:00", d: 2 * time.Hour, expected: "2027-01-04T10:00:00+01:00"},
+		{name: "Токио", s: tokyo, from: "2026-03-06T08:00:00Z", d: 2 * time.Hour, expected: "2026-03-09T01:00:00Z"},
+		{name: "конец дня в 24:00", s: evening, from: "2026-03-02T23:00:00Z", d: 2 * time.Hour, expected: "2026-03-03T21:00:00Z"},
+		{name: "без календаря по часам", s: schedule{}, from: "2026-03-07T12:00:00Z", d: 3 * time.Hour, expected: "2026-03-07T15:00:00Z"},
+
+		{name: "Нью-Йорк: весенний перевод в выходные", s: newYork, from: "2026-03-06T17:00:00-05:00", d: 3 * time.Hour, expected: "2026-03-09T11:00:00-04:00"},
+		{name: "Нью-Йорк: осенний перевод в выходные", s: newYork, from: "2026-10-30T17:00:00-04:00", d: 3 * time.Hour, expected: "2026-11-02T11:00:00-05:00"},
+		{name: "Берлин: неделя с весенним переводом", s: berlin, from: "2026-03-23T09:00:00+01:00", d: 45 * time.Hour, expected: "2026-03-27T18:00:00+01:00"},
+		{name: "Берлин: за неделю с весенним переводом", s: berlin, from: "2026-03-23T09:00:00+01:00", d: 45*time.Hour + time.Minute, expected: "2026-03-30T09:01:00+02:00"},
+		{name: "Берлин: за неделю с осенним переводом", s: berlin, from: "2026-10-23T17:00:00+02:00", d: 2 * time.Hour, expected: "2026-10-26T10:00:00+01:00"},
+
+		{name: "Иерусалим: сутки весеннего перевода короче", s: jerusalem24, from: "2026-03-27T00:00:00+02:00", d: 23 * time.Hour, expected: "2026-03-28T00:00:00+03:00"},
+		{name: "Иерусалим: остаток переносится на понедельник", s: jerusalem24, from: "2026-03-27T00:00:00+02:00", d: 23*time.Hour + 30*time.Minute, expected: "2026-03-30T00:30:00+03:00"},
+		{name: "Иерусалим: рабочие часы захватывают пропущенный час", s: jerusalemAM, from: "2026-03-27T01:00:00+02:00", d: 3 * time.Hour, expected: "2026-03-27T05:00:00+03:00"},
+		{name: "Каир: полночь весеннего перевода не существует", s: cairo24, from: "2026-04-23T23:00:00+02:00", d: 2 * time.Hour, expected: "2026-04-24T02:00:00+03:00"},
+		{name: "Каир: сутки осеннего перевода длиннее", s: cairo24, from: "2026-10-29T00:00:00+03:00", d: 24 * time.Hour, expected: "2026-10-29T23:00:00+02:00"},
+		{name: "Каир: рабочий день осеннего перевода обычный", s: cairo, from: "2026-10-29T17:00:00+03:00", d: 2 * time.Hour, expected: "2026-10-30T10:00:00+02:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := ts(t, tt.from)
+			got := tt.s.calendar(t).Add(from, tt.d)
+			assert.True(t, ts(t, tt.expected).Equal(got), "ожидалось %s, получено %s", tt.expected, got.Format(time.RFC3339))
+			assert.Equal(t, from.Location(), got.Location())
+		})
+	}
+}
+
+// Add и Between согласованы: рабочее время до дедлайна равно прибавленному
+func TestAddBetweenRoundTrip(t *testing.T) {
+	for _, s := range []schedule{utc, newYork, berlin, xmasLong, jerusalem24, jerusalemAM, cairo24, evening} {
+		c := s.calendar(t)
+		from := ts(t, "2026-01-01T00:00:00Z")
+		for h := 0; h < 24*366; h += 7 {
+			start := from.Add(time.Duration(h) * time.Hour)
+			for _, d := range []time.Duration{time.Minute, 90 * time.Minute, 8 * time.Hour, 50 * time.Hour} {
+				due := c.Add(start, d)
+				require.Equal(t, d, c.Between(start, due), "%s: %s + %s = %s", s.tz, start, d, due)
+			}
+		}
+	}
+}
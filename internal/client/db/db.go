@@ -17,14 +17,45 @@ type Handler func(ctx context.Context) error
 type Query struct {
 	Name     string
 	QueryRaw string
+	// аргументы содержат секреты (например, хеши ключей) и не пишутся в лог
+	Redact bool
+}
+
+type afterCommitKey struct{}
+
+// AfterCommit откладывает fn до успешного коммита внешней транзакции из ctx.
+// При откате fn не вызывается; вне транзакции fn выполняется сразу
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*[]func())
+	if !ok {
+		fn()
+		return
+	}
+	*hooks = append(*hooks, fn)
+}
+
+// WithAfterCommit используется менеджерами транзакций: ctx передается в транзакцию,
+// а run вызывается только после ее коммита
+func WithAfterCommit(ctx context.Context) (_ context.Context, run func()) {
+	hooks := new([]func())
+	return context.WithValue(ctx, afterCommitKey{}, hooks), func() {
+		for _, fn := range *hooks {
+			fn()
+		}
+	}
 }
 
 type Transactor interface {
 	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
 }
 
+// TxManager открывает транзакцию с нужным уровнем изоляции. RepeatableRead и
+// Serializable сами повторяют f при конфликте сериализации, поэтому f должна
+// быть идемпотентной относительно внешнего состояния.
 type TxManager interface {
 	ReadCommited(ctx context.Context, f Handler) error
+	RepeatableRead(ctx context.Context, f Handler) error
+	Serializable(ctx context.Context, f Handler) error
 }
 
 type SQLExecer interface {
@@ -38,7 +69,7 @@ type NamedExecer interface {
 }
 
 type QueryExecer interface {
-	SendBatch(ctx context.Context, batch *pgx.Batch) pgx.BatchResults
+	SendBatch(ctx context.Context, q Query, batch *pgx.Batch) pgx.BatchResults
 	ExecContext(ctx context.Context, q Query, args ...any) (pgconn.CommandTag, error)
 	QueryContext(ctx context.Context, q Query, args ...any) (pgx.Rows, error)
 	QueryRowContext(ctx context.Context, q Query, args ...any) pgx.Row
@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog/log"
+
+	"PR/internal/model"
+)
+
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+)
+
+// Sink — локальная шина реплики, *events.Bus
+type Sink interface {
+	Publish(ctx context.Context, e *model.Event) error
+	Reset()
+}
+
+// Conn — часть *pgx.Conn, которая нужна слушателю
+type Conn interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Close(ctx context.Context) error
+}
+
+// Listener держит отдельное от пула соединение: LISTEN действует только
+// в своей сессии, а соединения пула переиспользуются запросами
+type Listener struct {
+	connect    func(ctx context.Context) (Conn, error)
+	sink       Sink
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func NewListener(dsn string, sink Sink) *Listener {
+	return &Listener{
+		connect: func(ctx context.Context) (Conn, error) {
+			return pgx.Connect(ctx, dsn)
+		},
+		sink:       sink,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+// Run слушает канал до отмены ctx и переподключается с экспоненциальной
+// задержкой. Пока соединения не было, уведомления терялись, поэтому после
+// переподключения локальная шина сбрасывается: клиенты потока получат reset
+// и заново загрузят состояние
+func (l *Listener) Run(ctx context.Context) {
+	backoff := l.minBackoff
+	connected := false
+	for {
+		conn, err := l.listen(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error().Msgf("Listen %s error, retry in %s: %v", Channel, backoff, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, l.maxBackoff)
+			continue
+		}
+
+		if connected {
+			log.Info().Msgf("Listen %s reconnected, local event bus is reset", Channel)
+			l.sink.Reset()
+		}
+		connected = true
+		backoff = l.minBackoff
+
+		err = l.receive(ctx, conn)
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+		if cerr := conn.Close(closeCtx); cerr != nil {
+			log.Error().Msgf("Close listen connection error: %v", cerr)
+		}
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Msgf("Listen %s connection lost: %v", Channel, err)
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) (Conn, error) {
+	conn, err := l.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return nil, errors.Join(err, conn.Close(context.WithoutCancel(ctx)))
+	}
+	return conn, nil
+}
+
+func (l *Listener) receive(ctx context.Context, conn Conn) error {
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		if n.Channel != Channel {
+			continue
+		}
+		e, err := decode(n.Payload)
+		if err != nil {
+			log.Error().Msgf("Decode %s notification error: %v", Channel, err)
+			continue
+		}
+		// вне транзакции шина рассылает событие сразу
+		if err := l.sink.Publish(ctx, e); err != nil {
+			log.Error().Msgf("Publish %s notification error: %v", Channel, err)
+		}
+	}
+}
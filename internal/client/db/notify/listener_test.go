@@ -0,0 +1,161 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/model"
+)
+
+type fakeConn struct {
+	notifications chan *pgconn.Notification
+	closed        chan struct{}
+	closeOnce     sync.Once
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{notifications: make(chan *pgconn.Notification, 10), closed: make(chan struct{})}
+}
+
+func (c *fakeConn) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.NewCommandTag("LISTEN"), nil
+}
+
+// WaitForNotification возвращает ошибку, когда канал уведомлений закрыт, — как при обрыве соединения
+func (c *fakeConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case n, ok := <-c.notifications:
+		if !ok {
+			return nil, errors.New("connection lost")
+		}
+		return n, nil
+	}
+}
+
+func (c *fakeConn) Close(context.Context) error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+type fakeSink struct {
+	mu     sync.Mutex
+	events []*model.Event
+	resets int
+}
+
+func (s *fakeSink) Publish(_ context.Context, e *model.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *fakeSink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resets++
+}
+
+func (s *fakeSink) state() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events), s.resets
+}
+
+func notification(t *testing.T, e *model.Event) *pgconn.Notification {
+	payload, err := encode(e, time.Now)
+	require.NoError(t, err)
+	return &pgconn.Notification{Channel: Channel, Payload: string(payload)}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	prID := uuid.New()
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	e := &model.Event{
+		ID:         "ignored-1",
+		Type:       model.EventPRCreated,
+		OccurredAt: at,
+		TeamName:   "backend",
+		UserIDs:    []uuid.UUID{prID},
+		Data:       model.PREventData{PullRequest: &model.PullRequest{ID: prID, Name: "feature"}},
+	}
+
+	payload, err := encode(e, time.Now)
+	require.NoError(t, err)
+	got, err := decode(string(payload))
+	require.NoError(t, err)
+
+	assert.Empty(t, got.ID)
+	assert.Equal(t, e.Type, got.Type)
+	assert.Equal(t, at, got.OccurredAt)
+	assert.Equal(t, e.TeamName, got.TeamName)
+	assert.Equal(t, e.UserIDs, got.UserIDs)
+
+	want, _ := json.Marshal(e.Data)
+	data, _ := json.Marshal(got.Data)
+	assert.JSONEq(t, string(want), string(data))
+}
+
+func TestListener_Reconnect(t *testing.T) {
+	first, second := newFakeConn(), newFakeConn()
+	sink := &fakeSink{}
+
+	attempts := 0
+	l := &Listener{
+		sink:       sink,
+		minBackoff: time.Millisecond,
+		maxBackoff: 2 * time.Millisecond,
+		connect: func(context.Context) (Conn, error) {
+			attempts++
+			switch attempts {
+			case 1:
+				return first, nil
+			case 2:
+				return nil, errors.New("connection refused")
+			default:
+				return second, nil
+			}
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		l.Run(ctx)
+	}()
+
+	first.notifications <- notification(t, &model.Event{Type: model.EventPRCreated})
+	first.notifications <- &pgconn.Notification{Channel: "other", Payload: "{}"}
+	first.notifications <- &pgconn.Notification{Channel: Channel, Payload: "not json"}
+	close(first.notifications)
+	<-first.closed
+
+	second.notifications <- notification(t, &model.Event{Type: model.EventPRMerged})
+	require.Eventually(t, func() bool {
+		n, _ := sink.state()
+		return n == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+	<-second.closed
+
+	events, resets := sink.state()
+	assert.Equal(t, 2, events)
+	assert.Equal(t, 1, resets, "шина сбрасывается только после переподключения")
+	assert.Equal(t, model.EventPRCreated, sink.events[0].Type)
+	assert.Equal(t, model.EventPRMerged, sink.events[1].Type)
+	assert.Equal(t, 3, attempts)
+}
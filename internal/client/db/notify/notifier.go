@@ -0,0 +1,113 @@
+// Package notify — шина событий между репликами через LISTEN/NOTIFY Postgres.
+// Notifier отправляет событие pg_notify в транзакции изменения: Postgres
+// доставит его только после коммита и в порядке коммитов. Listener каждой
+// реплики держит отдельное соединение с LISTEN и передает события в локальную
+// шину, из которой читает /events/stream.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+)
+
+// Channel — канал NOTIFY для событий сервиса
+const Channel = "pr_events"
+
+// maxPayload — Postgres не принимает уведомления длиннее 8000 байт
+const maxPayload = 7999
+
+// refetchData заменяет данные события, которые не помещаются в уведомление:
+// клиент получает тип и участников и сам перечитывает состояние через API
+var refetchData = json.RawMessage(`{"refetch":true}`)
+
+// message — событие в уведомлении; ID назначает шина каждой реплики
+type message struct {
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	TeamName   string          `json:"team_name,omitempty"`
+	UserIDs    []uuid.UUID     `json:"user_ids"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type Notifier struct {
+	db  db.Client
+	now func() time.Time
+}
+
+func NewNotifier(db db.Client) *Notifier {
+	return &Notifier{db: db, now: time.Now}
+}
+
+// Publish выполняет pg_notify в транзакции из ctx, при откате уведомление
+// отбрасывается. Ошибку pg_notify нужно вернуть из транзакции: Postgres уже
+// прервал ее, и следующий запрос упадет с "current transaction is aborted".
+// Если событие не помещается в уведомление, вместо данных уходит refetchData
+func (n *Notifier) Publish(ctx context.Context, e *model.Event) error {
+	const op = "notify.Publish"
+
+	payload, err := encode(e, n.now)
+	if err != nil {
+		return fmt.Errorf("%s encode %s: %w", op, e.Type, err)
+	}
+	if len(payload) > maxPayload {
+		zerolog.Ctx(ctx).Warn().Msgf("%s %s payload is %d bytes, data is replaced with refetch marker", op, e.Type, len(payload))
+		payload, err = encodeMessage(e, refetchData, n.now)
+		if err != nil {
+			return fmt.Errorf("%s encode %s: %w", op, e.Type, err)
+		}
+		if len(payload) > maxPayload {
+			return fmt.Errorf("%s %s: payload is %d bytes even without data", op, e.Type, len(payload))
+		}
+	}
+
+	q := db.Query{Name: "notify.Publish", QueryRaw: `SELECT pg_notify($1, $2)`}
+	if _, err := n.db.DB().ExecContext(ctx, q, Channel, string(payload)); err != nil {
+		return fmt.Errorf("%s %s: %w", op, e.Type, err)
+	}
+	return nil
+}
+
+func encode(e *model.Event, now func() time.Time) ([]byte, error) {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	return encodeMessage(e, data, now)
+}
+
+func encodeMessage(e *model.Event, data json.RawMessage, now func() time.Time) ([]byte, error) {
+	m := message{
+		Type:       e.Type,
+		OccurredAt: e.OccurredAt,
+		TeamName:   e.TeamName,
+		UserIDs:    e.UserIDs,
+		Data:       data,
+	}
+	// время изменения одно для всех реплик, а не время получения
+	if m.OccurredAt.IsZero() {
+		m.OccurredAt = now().UTC()
+	}
+	return json.Marshal(m)
+}
+
+func decode(payload string) (*model.Event, error) {
+	var m message
+	if err := json.Unmarshal([]byte(payload), &m); err != nil {
+		return nil, err
+	}
+	return &model.Event{
+		Type:       m.Type,
+		OccurredAt: m.OccurredAt,
+		TeamName:   m.TeamName,
+		UserIDs:    m.UserIDs,
+		Data:       m.Data,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+)
+
+// fakeClient отдает fakeDB, который запоминает payload pg_notify
+type fakeClient struct {
+	fakeDB
+}
+
+func (c *fakeClient) DB() db.DB    { return &c.fakeDB }
+func (c *fakeClient) Close() error { return nil }
+
+// fakeDB — остальные методы db.DB уведомителю не нужны
+type fakeDB struct {
+	db.DB
+	payloads []string
+	err      error
+}
+
+func (c *fakeDB) ExecContext(_ context.Context, _ db.Query, args ...any) (pgconn.CommandTag, error) {
+	c.payloads = append(c.payloads, args[1].(string))
+	return pgconn.CommandTag{}, c.err
+}
+
+func TestNotifier_OversizedPayload(t *testing.T) {
+	client := &fakeClient{}
+	userID := uuid.New()
+	e := &model.Event{
+		Type:     model.EventPRCreated,
+		TeamName: "backend",
+		UserIDs:  []uuid.UUID{userID},
+		Data:     map[string]string{"name": strings.Repeat("x", maxPayload)},
+	}
+
+	require.NoError(t, NewNotifier(client).Publish(context.Background(), e))
+	require.Len(t, client.payloads, 1)
+	assert.LessOrEqual(t, len(client.payloads[0]), maxPayload)
+
+	got, err := decode(client.payloads[0])
+	require.NoError(t, err)
+	assert.Equal(t, model.EventPRCreated, got.Type)
+	assert.Equal(t, "backend", got.TeamName)
+	assert.Equal(t, []uuid.UUID{userID}, got.UserIDs)
+	assert.JSONEq(t, `{"refetch":true}`, string(got.Data.(json.RawMessage)))
+}
+
+func TestNotifier_ReturnsError(t *testing.T) {
+	// после ошибки pg_notify транзакция прервана, поэтому ошибка уходит вызывающему
+	errNotify := errors.New("notify failed")
+	client := &fakeClient{fakeDB{err: errNotify}}
+
+	err := NewNotifier(client).Publish(context.Background(), &model.Event{Type: model.EventPRMerged})
+	assert.ErrorIs(t, err, errNotify)
+
+	err = NewNotifier(&fakeClient{}).Publish(context.Background(), &model.Event{Type: model.EventPRMerged, Data: make(chan int)})
+	assert.Error(t, err)
+}
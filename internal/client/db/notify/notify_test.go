@@ -0,0 +1,113 @@
+package notify_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/client/db"
+	"PR/internal/client/db/notify"
+	"PR/internal/client/db/transaction"
+	"PR/internal/events"
+	"PR/internal/model"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type NotifyTestSuite struct {
+	suite.Suite
+	db       *testingpkg.TestDatabase
+	manager  db.TxManager
+	notifier *notify.Notifier
+}
+
+func TestNotifySuite(t *testing.T) {
+	suite.Run(t, new(NotifyTestSuite))
+}
+
+func (s *NotifyTestSuite) SetupSuite() {
+	s.db = testingpkg.SetupTestDatabase(s.T())
+	s.manager = transaction.NewTransactionManager(s.db.Client.DB())
+	s.notifier = notify.NewNotifier(s.db.Client)
+}
+
+func (s *NotifyTestSuite) TearDownSuite() {
+	if s.db.Client != nil {
+		s.db.Client.Close()
+	}
+}
+
+// listen запускает слушателя с локальной шиной, как на второй реплике
+func (s *NotifyTestSuite) listen() *events.Subscription {
+	bus := events.NewBus(10)
+	sub, _, _ := bus.Subscribe(&model.EventFilter{}, "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		notify.NewListener(s.db.ConnStr, bus).Run(ctx)
+	}()
+	s.T().Cleanup(func() {
+		cancel()
+		<-done
+		sub.Close()
+	})
+
+	// LISTEN выполняется асинхронно: ждем, пока дойдет пробное уведомление
+	s.Require().Eventually(func() bool {
+		s.notifier.Publish(context.Background(), &model.Event{Type: "probe"})
+		select {
+		case <-sub.C:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+	for len(sub.C) > 0 {
+		<-sub.C
+	}
+	return sub
+}
+
+func (s *NotifyTestSuite) TestDeliveredAfterCommit() {
+	sub := s.listen()
+	ctx := context.Background()
+
+	err := s.manager.ReadCommited(ctx, func(ctx context.Context) error {
+		s.notifier.Publish(ctx, &model.Event{Type: model.EventPRCreated, TeamName: "backend"})
+		return nil
+	})
+	s.Require().NoError(err)
+
+	select {
+	case e := <-sub.C:
+		s.Equal(model.EventPRCreated, e.Type)
+		s.Equal("backend", e.TeamName)
+		s.NotEmpty(e.ID)
+		s.False(e.OccurredAt.IsZero())
+	case <-time.After(5 * time.Second):
+		s.Fail("event is not delivered")
+	}
+}
+
+func (s *NotifyTestSuite) TestDroppedOnRollback() {
+	sub := s.listen()
+	ctx := context.Background()
+
+	err := s.manager.ReadCommited(ctx, func(ctx context.Context) error {
+		s.notifier.Publish(ctx, &model.Event{Type: model.EventPRCreated})
+		return errors.New("rollback")
+	})
+	s.Require().Error(err)
+	s.notifier.Publish(ctx, &model.Event{Type: model.EventPRMerged})
+
+	select {
+	case e := <-sub.C:
+		s.Equal(model.EventPRMerged, e.Type, "событие отмененной транзакции не доставляется")
+	case <-time.After(5 * time.Second):
+		s.Fail("event is not delivered")
+	}
+}
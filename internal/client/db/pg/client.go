@@ -13,14 +13,14 @@ type pgClient struct {
 	masterDBC db.DB
 }
 
-func New(ctx context.Context, dsn string) (db.Client, error) {
+func New(ctx context.Context, dsn string, logCfg QueryLogConfig) (db.Client, error) {
 	dbc, err := pgxpool.New(ctx, dsn)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pgClient{
-		masterDBC: NewDB(dbc),
+		masterDBC: NewDB(dbc, logCfg),
 	}, nil
 }
 
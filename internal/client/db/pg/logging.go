@@ -0,0 +1,106 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"PR/internal/client/db"
+)
+
+const (
+	// длиннее строки в логе обрезаются: аргументами бывают тела ответов.
+	// Секреты так не скрыть, для них запрос помечается db.Query.Redact
+	maxArgLen = 64
+
+	redactedArgs = "<redacted>"
+)
+
+// QueryLogConfig — что пишет в лог клиент бд
+type QueryLogConfig struct {
+	// запросы дольше порога пишутся с уровнем warn; 0 — не отслеживать
+	SlowThreshold time.Duration
+	// писать каждый запрос с уровнем debug вместе с аргументами
+	Debug bool
+}
+
+func (c QueryLogConfig) write(ctx context.Context, q db.Query, args []any, d time.Duration, rows int64, err error) {
+	slow := c.SlowThreshold > 0 && d >= c.SlowThreshold
+	if !slow && !c.Debug {
+		return
+	}
+
+	l := zerolog.Ctx(ctx)
+	ev := l.Debug()
+	msg := "query"
+	if slow {
+		ev = l.Warn()
+		msg = "slow query"
+	}
+	if !ev.Enabled() {
+		return
+	}
+
+	name := q.Name
+	if name == "" {
+		name = operation(q.QueryRaw)
+	}
+
+	ev = ev.Str("query", name).
+		Dur("duration", d).
+		Int64("rows", rows)
+	switch {
+	case c.Debug && q.Redact:
+		ev = ev.Str("args", redactedArgs)
+	case c.Debug:
+		ev = ev.Interface("args", sanitizeArgs(args))
+	}
+	if err != nil {
+		ev = ev.Err(err)
+	}
+	ev.Msg(msg)
+}
+
+// sanitizeArgs готовит аргументы к логу: бинарные данные заменяются размером,
+// длинные строки обрезаются, указатели разыменовываются
+func sanitizeArgs(args []any) []any {
+	out := make([]any, len(args))
+	for i, a := range args {
+		out[i] = sanitizeArg(a)
+	}
+	return out
+}
+
+func sanitizeArg(a any) any {
+	if a == nil {
+		return nil
+	}
+	// указатели разыменовываются раньше fmt.Stringer: у nil *uuid.UUID String паникует
+	if rv := reflect.ValueOf(a); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		return sanitizeArg(rv.Elem().Interface())
+	}
+
+	switch v := a.(type) {
+	case []byte:
+		return fmt.Sprintf("<%d bytes>", len(v))
+	case string:
+		return truncate(v)
+	case fmt.Stringer:
+		return truncate(v.String())
+	}
+	return a
+}
+
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) <= maxArgLen {
+		return s
+	}
+	return fmt.Sprintf("%s...(%d chars)", string(r[:maxArgLen]), len(r))
+}
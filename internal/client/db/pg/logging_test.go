@@ -0,0 +1,99 @@
+package pg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+)
+
+func TestQueryLogConfig_Write(t *testing.T) {
+	q := db.Query{Name: "pr.GetByID", QueryRaw: "SELECT 1"}
+
+	tests := []struct {
+		name      string
+		cfg       QueryLogConfig
+		duration  time.Duration
+		wantLevel string
+		wantArgs  bool
+	}{
+		{name: "fast_is_silent", cfg: QueryLogConfig{SlowThreshold: time.Second}, duration: time.Millisecond},
+		{name: "disabled_threshold", cfg: QueryLogConfig{}, duration: time.Hour},
+		{
+			name:      "slow_is_warn",
+			cfg:       QueryLogConfig{SlowThreshold: time.Second},
+			duration:  2 * time.Second,
+			wantLevel: "warn",
+		},
+		{
+			name:      "debug_logs_args",
+			cfg:       QueryLogConfig{SlowThreshold: time.Second, Debug: true},
+			duration:  time.Millisecond,
+			wantLevel: "debug",
+			wantArgs:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			ctx := zerolog.New(&buf).WithContext(context.Background())
+
+			tt.cfg.write(ctx, q, []any{"x"}, tt.duration, 3, nil)
+
+			if tt.wantLevel == "" {
+				assert.Empty(t, buf.String())
+				return
+			}
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, tt.wantLevel, entry["level"])
+			assert.Equal(t, "pr.GetByID", entry["query"])
+			assert.EqualValues(t, 3, entry["rows"])
+			_, hasArgs := entry["args"]
+			assert.Equal(t, tt.wantArgs, hasArgs)
+		})
+	}
+}
+
+func TestQueryLogConfig_WriteRedacted(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := zerolog.New(&buf).WithContext(context.Background())
+
+	// sha256 в hex ровно maxArgLen символов и обрезкой не скрывается
+	hash := strings.Repeat("ab", 32)
+	q := db.Query{Name: "apikey.GetByHash", QueryRaw: "SELECT 1", Redact: true}
+	QueryLogConfig{Debug: true}.write(ctx, q, []any{hash}, time.Millisecond, 1, nil)
+
+	assert.NotContains(t, buf.String(), hash)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, redactedArgs, entry["args"])
+}
+
+func TestSanitizeArgs(t *testing.T) {
+	id := uuid.New()
+	var nilID *uuid.UUID
+	name := "alice"
+	long := strings.Repeat("я", maxArgLen+10)
+
+	got := sanitizeArgs([]any{id, nilID, &name, []byte("secret body"), long, 42, nil})
+
+	assert.Equal(t, id.String(), got[0])
+	assert.Nil(t, got[1])
+	assert.Equal(t, "alice", got[2])
+	assert.Equal(t, "<11 bytes>", got[3])
+	assert.Equal(t, strings.Repeat("я", maxArgLen)+"...(74 chars)", got[4])
+	assert.Equal(t, 42, got[5])
+	assert.Nil(t, got[6])
+}
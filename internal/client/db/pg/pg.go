@@ -7,6 +7,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 
 	"PR/internal/client/db"
 )
@@ -18,53 +20,89 @@ const (
 )
 
 type pg struct {
-	dbc *pgxpool.Pool
+	dbc    *pgxpool.Pool
+	tracer trace.Tracer
+	log    QueryLogConfig
 }
 
-func NewDB(dbc *pgxpool.Pool) db.DB {
+func NewDB(dbc *pgxpool.Pool, logCfg QueryLogConfig) db.DB {
 	return &pg{
-		dbc: dbc,
+		dbc:    dbc,
+		tracer: otel.Tracer(tracerName),
+		log:    logCfg,
 	}
 }
 
 func (p *pg) ScanOneContext(ctx context.Context, dest any, q db.Query, args ...any) error {
+	ctx, obs := p.observe(ctx, q, args)
 
-	row, err := p.QueryContext(ctx, q, args...)
-	if err != nil {
-		return err
+	rows, err := p.query(ctx, q, args...)
+	counted := &countedRows{Rows: rows}
+	if err == nil {
+		err = pgxscan.ScanOne(dest, counted)
 	}
 
-	return pgxscan.ScanOne(dest, row)
+	obs.finish(counted.read, err)
+	return err
 }
 
 func (p *pg) ScanAllContext(ctx context.Context, dest any, q db.Query, args ...any) error {
+	ctx, obs := p.observe(ctx, q, args)
 
-	rows, err := p.QueryContext(ctx, q, args...)
-	if err != nil {
-		return err
+	rows, err := p.query(ctx, q, args...)
+	counted := &countedRows{Rows: rows}
+	if err == nil {
+		err = pgxscan.ScanAll(dest, counted)
 	}
 
-	return pgxscan.ScanAll(dest, rows)
+	obs.finish(counted.read, err)
+	return err
 }
 
 func (p *pg) ExecContext(ctx context.Context, q db.Query, args ...any) (pgconn.CommandTag, error) {
+	ctx, obs := p.observe(ctx, q, args)
+
+	var tag pgconn.CommandTag
+	var err error
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.Exec(ctx, q.QueryRaw, args...)
+		tag, err = tx.Exec(ctx, q.QueryRaw, args...)
+	} else {
+		tag, err = p.dbc.Exec(ctx, q.QueryRaw, args...)
 	}
-	return p.dbc.Exec(ctx, q.QueryRaw, args...)
+
+	obs.finishExec(tag.RowsAffected(), err)
+	return tag, err
 }
 
-func (p *pg) SendBatch(ctx context.Context, batch *pgx.Batch) pgx.BatchResults {
+// SendBatch наблюдает батч как один запрос: спан и лог с именем q.Name
+// завершаются при закрытии результатов
+func (p *pg) SendBatch(ctx context.Context, q db.Query, batch *pgx.Batch) pgx.BatchResults {
+	ctx, obs := p.observeBatch(ctx, q, batch)
+
+	var results pgx.BatchResults
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.SendBatch(ctx, batch)
+		results = tx.SendBatch(ctx, batch)
+	} else {
+		results = p.dbc.SendBatch(ctx, batch)
 	}
-	return p.dbc.SendBatch(ctx, batch)
+	return &tracedBatch{BatchResults: results, obs: obs}
 }
 
 func (p *pg) QueryContext(ctx context.Context, q db.Query, args ...any) (pgx.Rows, error) {
+	ctx, obs := p.observe(ctx, q, args)
 
+	rows, err := p.query(ctx, q, args...)
+	if err != nil {
+		obs.finish(0, err)
+		return nil, err
+	}
+	return &tracedRows{countedRows: countedRows{Rows: rows}, obs: obs}, nil
+}
+
+// query выполняет запрос без наблюдения: его открывает вызывающий метод
+func (p *pg) query(ctx context.Context, q db.Query, args ...any) (pgx.Rows, error) {
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
 		return tx.Query(ctx, q.QueryRaw, args...)
@@ -74,11 +112,16 @@ func (p *pg) QueryContext(ctx context.Context, q db.Query, args ...any) (pgx.Row
 }
 
 func (p *pg) QueryRowContext(ctx context.Context, q db.Query, args ...any) pgx.Row {
+	ctx, obs := p.observe(ctx, q, args)
+
+	var row pgx.Row
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.QueryRow(ctx, q.QueryRaw, args...)
+		row = tx.QueryRow(ctx, q.QueryRaw, args...)
+	} else {
+		row = p.dbc.QueryRow(ctx, q.QueryRaw, args...)
 	}
-	return p.dbc.QueryRow(ctx, q.QueryRaw, args...)
+	return &tracedRow{Row: row, obs: obs}
 }
 
 func (p *pg) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
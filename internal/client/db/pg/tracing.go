@@ -0,0 +1,214 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"PR/internal/client/db"
+	"PR/internal/tracing"
+)
+
+const (
+	tracerName = "PR/internal/client/db/pg"
+
+	batchOperation = "BATCH"
+)
+
+// observation — один выполняемый запрос: его спан и данные для лога
+type observation struct {
+	ctx   context.Context
+	span  trace.Span
+	q     db.Query
+	args  []any
+	start time.Time
+	log   QueryLogConfig
+}
+
+// observe открывает клиентский спан запроса. Имя спана — db.Query.Name,
+// без него — SQL операция, чтобы не плодить имена из текста запроса
+func (p *pg) observe(ctx context.Context, q db.Query, args []any) (context.Context, *observation) {
+	return p.start(ctx, q, operation(q.QueryRaw), args)
+}
+
+// observeBatch открывает один спан на весь батч. Текст — запросы батча без
+// повторов, аргументы всех запросов идут в лог подряд
+func (p *pg) observeBatch(ctx context.Context, q db.Query, batch *pgx.Batch) (context.Context, *observation) {
+	var args []any
+	var texts []string
+	for _, qq := range batch.QueuedQueries {
+		args = append(args, qq.Arguments...)
+		if text := strings.TrimSpace(qq.SQL); !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	}
+	if q.QueryRaw == "" {
+		q.QueryRaw = strings.Join(texts, ";\n")
+	}
+
+	return p.start(ctx, q, batchOperation, args, semconv.DBOperationBatchSize(batch.Len()))
+}
+
+func (p *pg) start(ctx context.Context, q db.Query, op string, args []any, attrs ...attribute.KeyValue) (context.Context, *observation) {
+	name := q.Name
+	if name == "" {
+		name = op
+	}
+
+	ctx, span := p.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(q.QueryRaw),
+		),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx, &observation{ctx: ctx, span: span, q: q, args: args, start: time.Now(), log: p.log}
+}
+
+// finish завершает наблюдение запроса, который возвращает строки
+func (o *observation) finish(rows int64, err error) {
+	o.end(attribute.Int64("db.response.returned_rows", rows), rows, err)
+}
+
+// finishExec завершает наблюдение Exec: число измененных строк — не прочитанные строки
+func (o *observation) finishExec(affected int64, err error) {
+	o.end(attribute.Int64("db.response.affected_rows", affected), affected, err)
+}
+
+// end завершает спан и пишет запрос в лог. Отсутствие строк — обычный
+// результат запроса, а не ошибка
+func (o *observation) end(rowsAttr attribute.KeyValue, rows int64, err error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+
+	o.span.SetAttributes(rowsAttr)
+	tracing.End(o.span, err)
+
+	o.log.write(o.ctx, o.q, o.args, time.Since(o.start), rows, err)
+}
+
+func operation(raw string) string {
+	fields := strings.Fields(raw)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// countedRows считает прочитанные строки
+type countedRows struct {
+	pgx.Rows
+	read int64
+}
+
+func (r *countedRows) Next() bool {
+	ok := r.Rows.Next()
+	if ok {
+		r.read++
+	}
+	return ok
+}
+
+// tracedRows завершает наблюдение, когда строки дочитаны и закрыты
+type tracedRows struct {
+	countedRows
+	obs *observation
+}
+
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	if r.obs == nil {
+		return
+	}
+	r.obs.finish(r.read, r.Rows.Err())
+	r.obs = nil
+}
+
+// tracedRow завершает наблюдение при Scan: pgx выполняет QueryRow лениво
+type tracedRow struct {
+	pgx.Row
+	obs *observation
+}
+
+func (r *tracedRow) Scan(dest ...any) error {
+	err := r.Row.Scan(dest...)
+	var rows int64
+	if err == nil {
+		rows = 1
+	}
+	r.obs.finish(rows, err)
+	return err
+}
+
+// tracedBatch суммирует строки всех запросов батча и завершает наблюдение при Close.
+// Репозитории закрывают батч дважды — в defer и при возврате, наблюдение завершается один раз
+type tracedBatch struct {
+	pgx.BatchResults
+	rows int64
+	obs  *observation
+}
+
+func (b *tracedBatch) Exec() (pgconn.CommandTag, error) {
+	tag, err := b.BatchResults.Exec()
+	b.rows += tag.RowsAffected()
+	return tag, err
+}
+
+func (b *tracedBatch) Query() (pgx.Rows, error) {
+	rows, err := b.BatchResults.Query()
+	if err != nil {
+		return rows, err
+	}
+	return &batchRows{Rows: rows, batch: b}, nil
+}
+
+func (b *tracedBatch) QueryRow() pgx.Row {
+	return &batchRow{Row: b.BatchResults.QueryRow(), batch: b}
+}
+
+func (b *tracedBatch) Close() error {
+	err := b.BatchResults.Close()
+	if b.obs != nil {
+		b.obs.finishExec(b.rows, err)
+		b.obs = nil
+	}
+	return err
+}
+
+type batchRows struct {
+	pgx.Rows
+	batch *tracedBatch
+}
+
+func (r *batchRows) Next() bool {
+	ok := r.Rows.Next()
+	if ok {
+		r.batch.rows++
+	}
+	return ok
+}
+
+type batchRow struct {
+	pgx.Row
+	batch *tracedBatch
+}
+
+func (r *batchRow) Scan(dest ...any) error {
+	err := r.Row.Scan(dest...)
+	if err == nil {
+		r.batch.rows++
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"PR/internal/client/db"
+)
+
+func TestObserve_Span(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	p := &pg{tracer: provider.Tracer(tracerName)}
+
+	tests := []struct {
+		name     string
+		query    db.Query
+		err      error
+		wantName string
+		wantCode codes.Code
+	}{
+		{
+			name:     "named_query",
+			query:    db.Query{Name: "pr.GetByID", QueryRaw: "SELECT * FROM prs WHERE id = $1"},
+			wantName: "pr.GetByID",
+			wantCode: codes.Unset,
+		},
+		{
+			name:     "operation_fallback",
+			query:    db.Query{QueryRaw: "\n\t\tupdate prs SET status = 'MERGED'"},
+			wantName: "UPDATE",
+			wantCode: codes.Unset,
+		},
+		{
+			name:     "no_rows_is_not_error",
+			query:    db.Query{QueryRaw: "SELECT 1"},
+			err:      pgx.ErrNoRows,
+			wantName: "SELECT",
+			wantCode: codes.Unset,
+		},
+		{
+			name:     "error",
+			query:    db.Query{QueryRaw: "DELETE FROM prs"},
+			err:      errors.New("boom"),
+			wantName: "DELETE",
+			wantCode: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, obs := p.observe(context.Background(), tt.query, nil)
+			obs.finish(0, tt.err)
+
+			spans := recorder.Ended()
+			require.NotEmpty(t, spans)
+			got := spans[len(spans)-1]
+			assert.Equal(t, tt.wantName, got.Name())
+			assert.Equal(t, tt.wantCode, got.Status().Code)
+		})
+	}
+}
+
+func TestObserve_RowsAttribute(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	p := &pg{tracer: provider.Tracer(tracerName)}
+
+	_, obs := p.observe(context.Background(), db.Query{QueryRaw: "SELECT * FROM prs"}, nil)
+	obs.finish(3, nil)
+	_, obs = p.observe(context.Background(), db.Query{QueryRaw: "UPDATE prs SET status = 'MERGED'"}, nil)
+	obs.finishExec(2, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Contains(t, spans[0].Attributes(), attribute.Int64("db.response.returned_rows", 3))
+	assert.Contains(t, spans[1].Attributes(), attribute.Int64("db.response.affected_rows", 2))
+	for _, kv := range spans[1].Attributes() {
+		assert.NotEqual(t, attribute.Key("db.response.returned_rows"), kv.Key)
+	}
+}
+
+func TestTracedBatch(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	p := &pg{tracer: provider.Tracer(tracerName)}
+
+	batch := &pgx.Batch{}
+	batch.Queue("INSERT INTO teams(id, team_name) VALUES ($1, $2)", 1, "backend")
+	batch.Queue("INSERT INTO teams(id, team_name) VALUES ($1, $2)", 2, "frontend")
+	batch.Queue("UPDATE users SET is_active = false")
+
+	_, obs := p.observeBatch(context.Background(), db.Query{Name: "team.CreateTeams"}, batch)
+	results := &tracedBatch{BatchResults: &stubBatchResults{tags: []string{"INSERT 0 1", "INSERT 0 1", "UPDATE 3"}}, obs: obs}
+	for range batch.Len() {
+		_, err := results.Exec()
+		require.NoError(t, err)
+	}
+	require.NoError(t, results.Close())
+	// репозитории закрывают батч повторно
+	require.NoError(t, results.Close())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "team.CreateTeams", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("db.operation.name", "BATCH"))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("db.operation.batch.size", 3))
+	assert.Contains(t, spans[0].Attributes(), attribute.String("db.query.text",
+		"INSERT INTO teams(id, team_name) VALUES ($1, $2);\nUPDATE users SET is_active = false"))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int64("db.response.affected_rows", 5))
+	assert.Equal(t, []any{1, "backend", 2, "frontend"}, obs.args)
+}
+
+type stubBatchResults struct {
+	pgx.BatchResults
+	tags []string
+}
+
+func (b *stubBatchResults) Exec() (pgconn.CommandTag, error) {
+	tag := pgconn.NewCommandTag(b.tags[0])
+	b.tags = b.tags[1:]
+	return tag, nil
+}
+
+func (b *stubBatchResults) Close() error {
+	return nil
+}
@@ -0,0 +1,38 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"serialization_failure", &pgconn.PgError{Code: "40001"}, true},
+		{"deadlock_detected", &pgconn.PgError{Code: "40P01"}, true},
+		{"wrapped_commit_error", fmt.Errorf("tx commit error: %w", &pgconn.PgError{Code: "40001"}), true},
+		{"unique_violation", &pgconn.PgError{Code: "23505"}, false},
+		{"plain_error", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isRetryable(tt.err))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(attempt)
+		assert.Positive(t, d)
+		assert.LessOrEqual(t, d, maxDelay)
+	}
+}
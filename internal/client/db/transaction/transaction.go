@@ -2,20 +2,37 @@ package transaction
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"PR/internal/client/db"
 	"PR/internal/client/db/pg"
+	"PR/internal/tracing"
+)
+
+const (
+	// сколько раз повторять транзакцию после конфликта сериализации или дедлока
+	maxRetries = 5
+	baseDelay  = 10 * time.Millisecond
+	maxDelay   = 500 * time.Millisecond
 )
 
 type manager struct {
-	db db.Transactor
+	db     db.Transactor
+	tracer trace.Tracer
 }
 
 func NewTransactionManager(db db.Transactor) db.TxManager {
-	return &manager{db: db}
+	return &manager{db: db, tracer: otel.Tracer("PR/internal/client/db/transaction")}
 }
 
 func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Handler) (err error) {
@@ -24,12 +41,18 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 		return fn(ctx)
 	}
 
+	ctx, span := m.tracer.Start(ctx, "db.transaction",
+		trace.WithAttributes(attribute.String("db.transaction.isolation", string(opts.IsoLevel))))
+	// спан закрывается последним, чтобы в него попали commit и rollback
+	defer func() { tracing.End(span, err) }()
+
 	tx, err = m.db.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
 
 	ctx = pg.MakeContextTx(ctx, tx)
+	ctx, runAfterCommit := db.WithAfterCommit(ctx)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -46,7 +69,9 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 			err = tx.Commit(ctx)
 			if err != nil {
 				err = fmt.Errorf("tx commit error: %w", err)
+				return
 			}
+			runAfterCommit()
 		}
 
 	}()
@@ -57,7 +82,65 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 
 }
 
+// retryable повторяет транзакцию, если Postgres откатил ее из-за конфликта.
+// Вложенные вызовы не повторяются: повтор возможен только целиком с начала внешней транзакции.
+func (m *manager) retryable(ctx context.Context, opts pgx.TxOptions, fn db.Handler) error {
+	if _, ok := ctx.Value(pg.TxKey).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = m.transaction(ctx, opts, fn)
+		if err == nil || !isRetryable(err) || attempt >= maxRetries {
+			return err
+		}
+
+		delay := backoff(attempt)
+		zerolog.Ctx(ctx).Warn().Msgf("transaction conflict, retry %d/%d in %s: %v", attempt+1, maxRetries, delay, err)
+		trace.SpanFromContext(ctx).AddEvent("transaction.retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt+1),
+			attribute.String("error", err.Error()),
+		))
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	// serialization_failure, deadlock_detected
+	return pgErr.Code == "40001" || pgErr.Code == "40P01"
+}
+
+// backoff — экспоненциальная задержка с полным джиттером, чтобы
+// конфликтующие транзакции не повторялись синхронно
+func backoff(attempt int) time.Duration {
+	d := baseDelay << attempt
+	if d > maxDelay {
+		d = maxDelay
+	}
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
 func (m *manager) ReadCommited(ctx context.Context, f db.Handler) error {
 	txOpts := pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
 	return m.transaction(ctx, txOpts, f)
 }
+
+func (m *manager) RepeatableRead(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead}
+	return m.retryable(ctx, txOpts, f)
+}
+
+func (m *manager) Serializable(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.Serializable}
+	return m.retryable(ctx, txOpts, f)
+}
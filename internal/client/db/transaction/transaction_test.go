@@ -0,0 +1,243 @@
+package transaction_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/client/db"
+	"PR/internal/client/db/transaction"
+	testingpkg "PR/internal/repository/testing"
+)
+
+const maxReviewers = 2
+
+type TransactionManagerTestSuite struct {
+	suite.Suite
+	db      *testingpkg.TestDatabase
+	manager db.TxManager
+
+	prID      uuid.UUID
+	reviewers []uuid.UUID
+}
+
+func TestTransactionManagerSuite(t *testing.T) {
+	suite.Run(t, new(TransactionManagerTestSuite))
+}
+
+func (s *TransactionManagerTestSuite) SetupSuite() {
+	s.db = testingpkg.SetupTestDatabase(s.T())
+	s.manager = transaction.NewTransactionManager(s.db.Client.DB())
+}
+
+func (s *TransactionManagerTestSuite) TearDownSuite() {
+	if s.db.Client != nil {
+		s.db.Client.Close()
+	}
+}
+
+func (s *TransactionManagerTestSuite) SetupTest() {
+	s.db.CleanupTables(s.T())
+	s.seedTestData()
+}
+
+func (s *TransactionManagerTestSuite) exec(query string, args ...any) {
+	_, err := s.db.Client.DB().ExecContext(context.Background(), db.Query{QueryRaw: query}, args...)
+	require.NoError(s.T(), err)
+}
+
+// seedTestData создает PR с одним ревьюером и свободным местом ровно под одного
+func (s *TransactionManagerTestSuite) seedTestData() {
+	s.exec("INSERT INTO teams(id, team_name) VALUES ($1, $2)", uuid.New(), "tx-team")
+
+	authorID := uuid.New()
+	s.exec("INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
+		authorID, "author", "tx-team", true)
+
+	s.reviewers = nil
+	for i := 0; i < 3; i++ {
+		id := uuid.New()
+		s.exec("INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
+			id, fmt.Sprintf("reviewer-%d", i), "tx-team", true)
+		s.reviewers = append(s.reviewers, id)
+	}
+
+	s.prID = uuid.New()
+	s.exec("INSERT INTO prs(id, name, author_id, status, created_at) VALUES ($1, $2, $3, $4, NOW())",
+		s.prID, "tx-pr", authorID, "OPEN")
+	s.exec("INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at) VALUES ($1, $2, NOW())",
+		s.prID, s.reviewers[0])
+}
+
+// assignIfFree — типичный read-then-write сервиса: посчитать ревьюеров и
+// добавить нового, если есть место. barrier синхронизирует первую попытку
+// двух транзакций так, чтобы обе прочитали состояние до записи.
+func (s *TransactionManagerTestSuite) assignIfFree(reviewerID uuid.UUID, barrier *sync.WaitGroup, attempts *atomic.Int32) db.Handler {
+	return func(ctx context.Context) error {
+		attempt := attempts.Add(1)
+
+		var count int
+		err := s.db.Client.DB().QueryRowContext(ctx, db.Query{
+			QueryRaw: "SELECT COUNT(*) FROM pr_reviewers WHERE pr_id = $1",
+		}, s.prID).Scan(&count)
+		if err != nil {
+			return err
+		}
+
+		if attempt == 1 {
+			barrier.Done()
+			barrier.Wait()
+		}
+
+		if count >= maxReviewers {
+			return nil
+		}
+
+		_, err = s.db.Client.DB().ExecContext(ctx, db.Query{
+			QueryRaw: "INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at) VALUES ($1, $2, NOW())",
+		}, s.prID, reviewerID)
+		return err
+	}
+}
+
+func (s *TransactionManagerTestSuite) runConcurrently(txFunc func(context.Context, db.Handler) error) (int32, []error) {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+
+	var attempts atomic.Int32
+	errs := make([]error, 2)
+
+	var wg sync.WaitGroup
+	for i, reviewerID := range s.reviewers[1:] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = txFunc(context.Background(), s.assignIfFree(reviewerID, &barrier, &attempts))
+		}()
+	}
+	wg.Wait()
+
+	return attempts.Load(), errs
+}
+
+func (s *TransactionManagerTestSuite) reviewerCount() int {
+	var count int
+	err := s.db.Client.DB().QueryRowContext(context.Background(), db.Query{
+		QueryRaw: "SELECT COUNT(*) FROM pr_reviewers WHERE pr_id = $1",
+	}, s.prID).Scan(&count)
+	require.NoError(s.T(), err)
+	return count
+}
+
+func (s *TransactionManagerTestSuite) TestSerializable_PreventsDoubleAssignment() {
+	attempts, errs := s.runConcurrently(s.manager.Serializable)
+
+	for _, err := range errs {
+		assert.NoError(s.T(), err)
+	}
+	// одна из транзакций откатилась с 40001 и была повторена
+	assert.Equal(s.T(), int32(3), attempts)
+	assert.Equal(s.T(), maxReviewers, s.reviewerCount())
+}
+
+func (s *TransactionManagerTestSuite) TestReadCommitted_AllowsDoubleAssignment() {
+	attempts, errs := s.runConcurrently(s.manager.ReadCommited)
+
+	for _, err := range errs {
+		assert.NoError(s.T(), err)
+	}
+	assert.Equal(s.T(), int32(2), attempts)
+	assert.Equal(s.T(), maxReviewers+1, s.reviewerCount())
+}
+
+func (s *TransactionManagerTestSuite) TestRepeatableRead_RetriesConcurrentUpdate() {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	var attempts atomic.Int32
+
+	toggle := func(ctx context.Context) error {
+		attempt := attempts.Add(1)
+
+		var active bool
+		err := s.db.Client.DB().QueryRowContext(ctx, db.Query{
+			QueryRaw: "SELECT is_active FROM users WHERE id = $1",
+		}, s.reviewers[0]).Scan(&active)
+		if err != nil {
+			return err
+		}
+
+		if attempt <= 2 {
+			barrier.Done()
+			barrier.Wait()
+		}
+
+		_, err = s.db.Client.DB().ExecContext(ctx, db.Query{
+			QueryRaw: "UPDATE users SET is_active = $1 WHERE id = $2",
+		}, !active, s.reviewers[0])
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.manager.RepeatableRead(context.Background(), toggle)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(s.T(), err)
+	}
+	assert.Equal(s.T(), int32(3), attempts.Load())
+
+	// оба переключения применились последовательно
+	var active bool
+	err := s.db.Client.DB().QueryRowContext(context.Background(), db.Query{
+		QueryRaw: "SELECT is_active FROM users WHERE id = $1",
+	}, s.reviewers[0]).Scan(&active)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), active)
+}
+
+func (s *TransactionManagerTestSuite) TestSerializable_NestedDoesNotRetry() {
+	calls := 0
+	conflict := &pgconn.PgError{Code: "40001"}
+
+	err := s.manager.ReadCommited(context.Background(), func(ctx context.Context) error {
+		return s.manager.Serializable(ctx, func(context.Context) error {
+			calls++
+			return conflict
+		})
+	})
+
+	assert.ErrorIs(s.T(), err, conflict)
+	assert.Equal(s.T(), 1, calls)
+}
+
+func (s *TransactionManagerTestSuite) TestAfterCommit_OnlyForCommittedAttempt() {
+	var attempts, hooks atomic.Int32
+
+	err := s.manager.Serializable(context.Background(), func(ctx context.Context) error {
+		db.AfterCommit(ctx, func() { hooks.Add(1) })
+		// первая попытка откатывается как при конфликте, ее хук теряется
+		if attempts.Add(1) == 1 {
+			return &pgconn.PgError{Code: "40001"}
+		}
+		return nil
+	})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(2), attempts.Load())
+	assert.Equal(s.T(), int32(1), hooks.Load())
+}
@@ -0,0 +1,105 @@
+// Package webhook отправляет JSON уведомления на адреса, указанные командами
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress — адрес вебхука указывает во внутреннюю сеть
+var ErrPrivateAddress = errors.New("webhook address is not public")
+
+type Client struct {
+	http *http.Client
+}
+
+// NewClient соединяется только с публичными адресами: проверка стоит на
+// подключении, поэтому ее не обойти DNS именем или редиректом во внутреннюю сеть.
+// Прокси из окружения не используется — иначе проверялся бы адрес прокси
+func NewClient(timeout time.Duration) *Client {
+	return newClient(timeout, true)
+}
+
+func newClient(timeout time.Duration, publicOnly bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if publicOnly {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !PublicAddr(addr.Addr()) {
+				return fmt.Errorf("%w: %s", ErrPrivateAddress, addr.Addr())
+			}
+			return nil
+		}
+	}
+	return &Client{http: &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}}
+}
+
+// PublicAddr сообщает, доступен ли адрес из интернета: loopback, частные,
+// link-local, multicast и неуказанные адреса к ним не относятся
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
+
+// ValidURL — абсолютный http(s) адрес, хост которого не localhost и не IP
+// внутренней сети. Имена проверяются при подключении, см. NewClient
+func ValidURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return false
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return PublicAddr(addr)
+	}
+	return true
+}
+
+// Send отправляет payload POST запросом. Ответ не 2xx считается ошибкой;
+// повторов нет — следующее напоминание придет по расписанию
+func (c *Client) Send(ctx context.Context, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "pr-reviewer-service")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// дочитываем тело, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded %s", url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := newClient(time.Second, false)
+
+	require.NoError(t, c.Send(context.Background(), srv.URL+"/ok", map[string]string{"type": "pr.review_reminder"}))
+	assert.Equal(t, "pr.review_reminder", got["type"])
+
+	err := c.Send(context.Background(), srv.URL+"/fail", map[string]string{})
+	assert.ErrorContains(t, err, "502")
+}
+
+func TestSend_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	err := newClient(20*time.Millisecond, false).Send(context.Background(), srv.URL, struct{}{})
+	assert.Error(t, err)
+}
+
+func TestSend_RejectsPrivateAddress(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	err := NewClient(time.Second).Send(context.Background(), srv.URL, struct{}{})
+	assert.ErrorIs(t, err, ErrPrivateAddress)
+	assert.False(t, called)
+}
+
+func TestValidURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"https://hooks.example.com/sla", true},
+		{"http://93.184.216.34:8080/hook", true},
+		{"ftp://example.com", false},
+		{"/relative", false},
+		{"http://localhost:8080", false},
+		{"http://api.localhost", false},
+		{"http://127.0.0.1", false},
+		{"http://10.1.2.3", false},
+		{"http://192.168.0.1", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://0.0.0.0", false},
+		{"http://[::1]", false},
+		{"http://[fd00::1]", false},
+		{"http://[::ffff:10.0.0.1]", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.valid, ValidURL(tt.url), tt.url)
+	}
+	assert.True(t, PublicAddr(netip.MustParseAddr("2001:4860:4860::8888")))
+}
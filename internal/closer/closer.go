@@ -1,53 +1,107 @@
 package closer
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+// Priority задает фазу остановки: фазы выполняются по возрастанию,
+// функции внутри одной фазы — параллельно
+type Priority int
+
+const (
+	// PriorityServer — прием входящих запросов, останавливается первым
+	PriorityServer Priority = 0
+	// PriorityWorkers — фоновые воркеры, которые еще могут писать в БД
+	PriorityWorkers Priority = 10
+	// PriorityStorage — пулы соединений и прочие ресурсы, закрываются последними
+	PriorityStorage Priority = 20
+)
+
+// DefaultTimeout используется для функций, добавленных через Add
+const DefaultTimeout = 5 * time.Second
+
 var globalCloser = New(syscall.SIGTERM, syscall.SIGINT)
 
 func Add(f ...func() error) {
 	globalCloser.Add(f...)
 }
 
+func AddWithPriority(p Priority, name string, timeout time.Duration, f func(ctx context.Context) error) {
+	globalCloser.AddWithPriority(p, name, timeout, f)
+}
+
 func Wait() {
 
 	globalCloser.Wait()
 }
 
-func CloseAll() {
-	globalCloser.CloseAll()
+func CloseAll() error {
+	return globalCloser.CloseAll()
+}
+
+func Closing() <-chan struct{} {
+	return globalCloser.Closing()
+}
+
+type step struct {
+	priority Priority
+	name     string
+	timeout  time.Duration
+	fn       func(ctx context.Context) error
 }
 
 type Closer struct {
-	mu    sync.Mutex
-	once  sync.Once
-	done  chan struct{}
-	funcs []func() error
+	mu      sync.Mutex
+	once    sync.Once
+	closing chan struct{}
+	done    chan struct{}
+	steps   []step
+	err     error
 }
 
 func New(sig ...os.Signal) *Closer {
-	c := &Closer{done: make(chan struct{})}
+	c := &Closer{
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
 	if len(sig) > 0 {
 		go func() {
 			ch := make(chan os.Signal, 1)
 			signal.Notify(ch, sig...)
 			<-ch
 			signal.Stop(ch)
-			c.CloseAll()
+			// ошибку получит тот, кто ждет остановки через CloseAll
+			_ = c.CloseAll()
 		}()
 	}
 	return c
 }
 
+// Add регистрирует функции в последней фазе с таймаутом по умолчанию
 func (c *Closer) Add(f ...func() error) {
+	for _, fn := range f {
+		c.AddWithPriority(PriorityStorage, "close func", DefaultTimeout, func(context.Context) error {
+			return fn()
+		})
+	}
+}
+
+// AddWithPriority регистрирует именованный шаг остановки. Контекст шага
+// отменяется по истечении timeout; если шаг его не уважает, остановка все равно
+// переходит к следующей фазе, а шаг попадает в ошибку CloseAll.
+func (c *Closer) AddWithPriority(p Priority, name string, timeout time.Duration, f func(ctx context.Context) error) {
 	c.mu.Lock()
-	c.funcs = append(c.funcs, f...)
+	c.steps = append(c.steps, step{priority: p, name: name, timeout: timeout, fn: f})
 	c.mu.Unlock()
 }
 
@@ -55,27 +109,75 @@ func (c *Closer) Wait() {
 	<-c.done
 }
 
-func (c *Closer) CloseAll() {
+// Closing закрывается в момент начала остановки, еще до вызова зарегистрированных функций
+func (c *Closer) Closing() <-chan struct{} {
+	return c.closing
+}
+
+// CloseAll выполняет фазы остановки один раз; повторные вызовы ждут
+// завершения первого и возвращают ту же агрегированную ошибку
+func (c *Closer) CloseAll() error {
 	c.once.Do(func() {
+		close(c.closing)
 		defer close(c.done)
 
 		c.mu.Lock()
-		funcs := c.funcs
-		c.funcs = nil
+		steps := c.steps
+		c.steps = nil
 		c.mu.Unlock()
 
-		errs := make(chan error, len(funcs))
-		for _, f := range funcs {
-			go func(f func() error) {
-				errs <- f()
-			}(f)
-		}
+		sort.SliceStable(steps, func(i, j int) bool {
+			return steps[i].priority < steps[j].priority
+		})
 
-		for i := 0; i < cap(errs); i++ {
-			if err := <-errs; err != nil {
-				log.Error().Msgf("Close error: %v", err)
+		var errs []error
+		for start := 0; start < len(steps); {
+			end := start
+			for end < len(steps) && steps[end].priority == steps[start].priority {
+				end++
 			}
+			errs = append(errs, runPhase(steps[start:end])...)
+			start = end
 		}
+		c.err = errors.Join(errs...)
 	})
 
+	return c.err
+}
+
+func runPhase(steps []step) []error {
+	errs := make([]error, len(steps))
+
+	var wg sync.WaitGroup
+	for i, s := range steps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.run(); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", s.name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// run ждет завершения шага не дольше его дедлайна
+func (s step) run() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.fn(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		log.Error().Msgf("Close step %q timed out after %s", s.name, s.timeout)
+		return ctx.Err()
+	}
 }
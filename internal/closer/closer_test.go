@@ -0,0 +1,178 @@
+package closer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) step(name string) func(context.Context) error {
+	return func(context.Context) error {
+		r.mu.Lock()
+		r.order = append(r.order, name)
+		r.mu.Unlock()
+		return nil
+	}
+}
+
+func TestCloseAll_PhasesRunInPriorityOrder(t *testing.T) {
+	c := New()
+	rec := &recorder{}
+
+	// регистрация в "неправильном" порядке, как это бывает при ленивой инициализации
+	c.AddWithPriority(PriorityStorage, "db pool", time.Second, rec.step("db pool"))
+	c.AddWithPriority(PriorityWorkers, "worker", time.Second, rec.step("worker"))
+	c.AddWithPriority(PriorityServer, "http server", time.Second, rec.step("http server"))
+
+	err := c.CloseAll()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"http server", "worker", "db pool"}, rec.order)
+}
+
+func TestCloseAll_NextPhaseWaitsForPrevious(t *testing.T) {
+	c := New()
+
+	serverDone := make(chan struct{})
+	var dbClosedBeforeServer bool
+
+	c.AddWithPriority(PriorityServer, "http server", time.Second, func(context.Context) error {
+		time.Sleep(50 * time.Millisecond)
+		close(serverDone)
+		return nil
+	})
+	c.AddWithPriority(PriorityStorage, "db pool", time.Second, func(context.Context) error {
+		select {
+		case <-serverDone:
+		default:
+			dbClosedBeforeServer = true
+		}
+		return nil
+	})
+
+	require.NoError(t, c.CloseAll())
+	assert.False(t, dbClosedBeforeServer)
+}
+
+func TestCloseAll_SamePhaseRunsConcurrently(t *testing.T) {
+	c := New()
+
+	var started sync.WaitGroup
+	started.Add(2)
+	release := make(chan struct{})
+
+	for _, name := range []string{"worker-1", "worker-2"} {
+		c.AddWithPriority(PriorityWorkers, name, time.Second, func(context.Context) error {
+			started.Done()
+			<-release
+			return nil
+		})
+	}
+
+	go func() {
+		// оба шага должны стартовать, не дожидаясь друг друга
+		started.Wait()
+		close(release)
+	}()
+
+	assert.NoError(t, c.CloseAll())
+}
+
+func TestCloseAll_StepTimeout(t *testing.T) {
+	c := New()
+	rec := &recorder{}
+
+	stepCtxErr := make(chan error, 1)
+	c.AddWithPriority(PriorityServer, "http server", 20*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		stepCtxErr <- ctx.Err()
+		// шаг игнорирует отмену и продолжает висеть
+		time.Sleep(time.Second)
+		return nil
+	})
+	c.AddWithPriority(PriorityStorage, "db pool", time.Second, rec.step("db pool"))
+
+	start := time.Now()
+	err := c.CloseAll()
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "http server")
+	assert.Equal(t, context.DeadlineExceeded, <-stepCtxErr)
+	assert.Equal(t, []string{"db pool"}, rec.order)
+}
+
+func TestCloseAll_AggregatesErrors(t *testing.T) {
+	c := New()
+
+	errServer := errors.New("server error")
+	errDB := errors.New("db error")
+
+	c.AddWithPriority(PriorityServer, "http server", time.Second, func(context.Context) error { return errServer })
+	c.AddWithPriority(PriorityStorage, "db pool", time.Second, func(context.Context) error { return errDB })
+	c.Add(func() error { return nil })
+
+	err := c.CloseAll()
+
+	assert.ErrorIs(t, err, errServer)
+	assert.ErrorIs(t, err, errDB)
+	assert.ErrorContains(t, err, "http server: server error")
+	assert.ErrorContains(t, err, "db pool: db error")
+}
+
+func TestCloseAll_RunsOnce(t *testing.T) {
+	c := New()
+
+	calls := 0
+	errClose := errors.New("close error")
+	c.Add(func() error {
+		calls++
+		return errClose
+	})
+
+	first := c.CloseAll()
+	second := c.CloseAll()
+
+	assert.Equal(t, 1, calls)
+	assert.ErrorIs(t, first, errClose)
+	assert.Equal(t, first, second)
+}
+
+func TestClosing_ClosedBeforeSteps(t *testing.T) {
+	c := New()
+
+	var closingSeen bool
+	c.AddWithPriority(PriorityServer, "http server", time.Second, func(context.Context) error {
+		select {
+		case <-c.Closing():
+			closingSeen = true
+		default:
+		}
+		return nil
+	})
+
+	select {
+	case <-c.Closing():
+		t.Fatal("closing must not be signalled before CloseAll")
+	default:
+	}
+
+	require.NoError(t, c.CloseAll())
+	assert.True(t, closingSeen)
+
+	select {
+	case <-c.done:
+	default:
+		t.Fatal("done must be closed after CloseAll")
+	}
+}
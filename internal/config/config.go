@@ -1,17 +1,109 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server  ServerConfig
+	Server ServerConfig
+	// postgres или memory — все данные в памяти процесса, для демо и тестов
+	Storage string
 	Postgre PostgreConfig
+	Migrate MigrateConfig
+	Auth    AuthConfig
+
+	Idempotency IdempotencyConfig
+	RateLimit   RateLimitConfig
+	Tracing     TracingConfig
+	Events      EventsConfig
+	SLA         SLAConfig
 }
 
 type ServerConfig struct {
 	Port string
+	// сколько ждать завершения активных запросов при остановке
+	ShutdownTimeout time.Duration
+	// сколько после начала остановки отдавать 503 на readiness, прежде чем перестать принимать запросы
+	DrainDelay time.Duration
+	// адреса и подсети прокси, которым можно верить в X-Forwarded-For; пусто — никому
+	TrustedProxies []string
+}
+
+type AuthConfig struct {
+	Enabled bool
+	// ключ с ролью admin, который работает без записи в базе
+	BootstrapKey string
+	JWT          JWTConfig
+}
+
+// JWTConfig — если не задан ни один ключ, Bearer токены не принимаются
+type JWTConfig struct {
+	// общий секрет для HS256
+	HMACSecret string
+	// публичный ключ RS256 в PEM
+	RSAPublicKey string
+	// путь к локальному JWKS файлу с RSA ключами, выбираются по kid
+	JWKSFile string
+	Issuer   string
+	Audience string
+}
+
+type IdempotencyConfig struct {
+	// сколько хранится ответ на запрос с Idempotency-Key
+	TTL time.Duration
+	// сколько ключ занят незавершенным запросом; после истечения повтор выполняется заново
+	Lease time.Duration
+	// как часто удаляются просроченные ключи
+	CleanupInterval time.Duration
+}
+
+type RateLimitConfig struct {
+	// memory — бакеты в памяти реплики, postgres — общие для всех реплик
+	Backend string
+	// лимит для маршрутов без своего, например "100/1m"; пусто — без ограничений
+	Default string
+	// лимиты по маршрутам через ";", например "POST /pullRequest/reassign=10/1m"
+	Routes string
+	// общий лимит на IP адрес до авторизации, например "1200/1m"; пусто — без ограничений
+	IP string
+	// как часто из Postgres удаляются давно не используемые бакеты
+	CleanupInterval time.Duration
+}
+
+type TracingConfig struct {
+	// none, stdout или otlp
+	Exporter string
+	// host:port OTLP/HTTP коллектора; пусто — OTEL_EXPORTER_OTLP_ENDPOINT или localhost:4318
+	Endpoint    string
+	Insecure    bool
+	ServiceName string
+	// доля трассируемых корневых запросов от 0 до 1
+	SampleRatio float64
+}
+
+type EventsConfig struct {
+	// memory — события видят только клиенты своей реплики, postgres — все реплики через LISTEN/NOTIFY
+	Backend string
+	// сколько последних событий хранится для возобновления по Last-Event-ID
+	BufferSize int
+	// как часто в поток пишется комментарий, чтобы прокси не закрывали соединение
+	HeartbeatInterval time.Duration
+}
+
+type SLAConfig struct {
+	// как часто планировщик ищет ревью, вышедшие за SLA
+	CheckInterval time.Duration
+	// сколько напоминаний отправляется за один проход
+	BatchSize int
+	// сколько ждать ответа вебхука команды
+	WebhookTimeout time.Duration
+	// как часто воркер ищет ревью для автоматического переназначения
+	ReassignInterval time.Duration
 }
 
 type PostgreConfig struct {
@@ -19,6 +111,21 @@ type PostgreConfig struct {
 	User     string
 	DBName   string
 	Host     string
+	// запросы дольше порога пишутся в лог с уровнем warn; 0 — не отслеживать
+	SlowQueryThreshold time.Duration
+	// писать в лог каждый запрос с аргументами (без бинарных данных и секретов, с обрезанными строками)
+	QueryDebug bool
+}
+
+func (c PostgreConfig) DSN() string {
+	return fmt.Sprintf("host=%s user=%s password=%s database=%s sslmode=disable", c.Host, c.User, c.Password, c.DBName)
+}
+
+type MigrateConfig struct {
+	// применять встроенные миграции при запуске сервера
+	OnStart bool
+	// сколько ждать, пока миграции применяет другая реплика
+	LockTimeout time.Duration
 }
 
 func NewConfig() (*Config, error) {
@@ -30,16 +137,129 @@ func NewConfig() (*Config, error) {
 
 	c := viper.New()
 	c.AutomaticEnv()
+	c.SetDefault("SHUTDOWN_TIMEOUT", 15*time.Second)
+	c.SetDefault("SHUTDOWN_DRAIN_DELAY", 5*time.Second)
+	c.SetDefault("STORAGE", "postgres")
+	c.SetDefault("AUTH_ENABLED", true)
+	c.SetDefault("DB_SLOW_QUERY_THRESHOLD", 200*time.Millisecond)
+	c.SetDefault("MIGRATE_LOCK_TIMEOUT", 5*time.Minute)
+	c.SetDefault("IDEMPOTENCY_TTL", 24*time.Hour)
+	c.SetDefault("IDEMPOTENCY_LEASE", time.Minute)
+	c.SetDefault("IDEMPOTENCY_CLEANUP_INTERVAL", time.Hour)
+	c.SetDefault("RATE_LIMIT_BACKEND", "memory")
+	c.SetDefault("RATE_LIMIT_CLEANUP_INTERVAL", 10*time.Minute)
+	c.SetDefault("TRACING_EXPORTER", "none")
+	c.SetDefault("TRACING_OTLP_INSECURE", true)
+	c.SetDefault("TRACING_SERVICE_NAME", "pr-reviewer-service")
+	c.SetDefault("TRACING_SAMPLE_RATIO", 1.0)
+	c.SetDefault("EVENTS_BACKEND", "memory")
+	c.SetDefault("EVENTS_BUFFER_SIZE", 1000)
+	c.SetDefault("EVENTS_HEARTBEAT_INTERVAL", 15*time.Second)
+	c.SetDefault("SLA_CHECK_INTERVAL", 5*time.Minute)
+	c.SetDefault("SLA_BATCH_SIZE", 100)
+	c.SetDefault("SLA_WEBHOOK_TIMEOUT", 5*time.Second)
+	c.SetDefault("SLA_REASSIGN_INTERVAL", 5*time.Minute)
 
-	return &Config{
+	cfg := &Config{
 		Server: ServerConfig{
-			Port: c.GetString("PORT"),
+			Port:            c.GetString("PORT"),
+			ShutdownTimeout: c.GetDuration("SHUTDOWN_TIMEOUT"),
+			DrainDelay:      c.GetDuration("SHUTDOWN_DRAIN_DELAY"),
+			TrustedProxies:  splitList(c.GetString("TRUSTED_PROXIES")),
 		},
+		Storage: c.GetString("STORAGE"),
 		Postgre: PostgreConfig{
 			Password: c.GetString("POSTGRES_PASSWORD"),
 			User:     c.GetString("POSTGRES_USER"),
 			Host:     c.GetString("POSTGRES_HOST"),
 			DBName:   c.GetString("POSTGRES_DB"),
+
+			SlowQueryThreshold: c.GetDuration("DB_SLOW_QUERY_THRESHOLD"),
+			QueryDebug:         c.GetBool("DB_QUERY_DEBUG"),
+		},
+		Migrate: MigrateConfig{
+			OnStart:     c.GetBool("MIGRATE_ON_START"),
+			LockTimeout: c.GetDuration("MIGRATE_LOCK_TIMEOUT"),
+		},
+		Auth: AuthConfig{
+			Enabled:      c.GetBool("AUTH_ENABLED"),
+			BootstrapKey: c.GetString("AUTH_BOOTSTRAP_KEY"),
+			JWT: JWTConfig{
+				HMACSecret:   c.GetString("JWT_HS256_SECRET"),
+				RSAPublicKey: c.GetString("JWT_RS256_PUBLIC_KEY"),
+				JWKSFile:     c.GetString("JWT_JWKS_FILE"),
+				Issuer:       c.GetString("JWT_ISSUER"),
+				Audience:     c.GetString("JWT_AUDIENCE"),
+			},
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             c.GetDuration("IDEMPOTENCY_TTL"),
+			Lease:           c.GetDuration("IDEMPOTENCY_LEASE"),
+			CleanupInterval: c.GetDuration("IDEMPOTENCY_CLEANUP_INTERVAL"),
+		},
+		RateLimit: RateLimitConfig{
+			Backend:         c.GetString("RATE_LIMIT_BACKEND"),
+			Default:         c.GetString("RATE_LIMIT_DEFAULT"),
+			Routes:          c.GetString("RATE_LIMIT_ROUTES"),
+			IP:              c.GetString("RATE_LIMIT_IP"),
+			CleanupInterval: c.GetDuration("RATE_LIMIT_CLEANUP_INTERVAL"),
 		},
-	}, nil
+		Tracing: TracingConfig{
+			Exporter:    c.GetString("TRACING_EXPORTER"),
+			Endpoint:    c.GetString("TRACING_OTLP_ENDPOINT"),
+			Insecure:    c.GetBool("TRACING_OTLP_INSECURE"),
+			ServiceName: c.GetString("TRACING_SERVICE_NAME"),
+			SampleRatio: c.GetFloat64("TRACING_SAMPLE_RATIO"),
+		},
+		Events: EventsConfig{
+			Backend:           c.GetString("EVENTS_BACKEND"),
+			BufferSize:        c.GetInt("EVENTS_BUFFER_SIZE"),
+			HeartbeatInterval: c.GetDuration("EVENTS_HEARTBEAT_INTERVAL"),
+		},
+		SLA: SLAConfig{
+			CheckInterval:    c.GetDuration("SLA_CHECK_INTERVAL"),
+			BatchSize:        c.GetInt("SLA_BATCH_SIZE"),
+			WebhookTimeout:   c.GetDuration("SLA_WEBHOOK_TIMEOUT"),
+			ReassignInterval: c.GetDuration("SLA_REASSIGN_INTERVAL"),
+		},
+	}
+	if err = cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// validate проверяет длительности: тикеры фоновых задач паникуют на неположительном периоде
+func (c *Config) validate() error {
+	positive := []struct {
+		name string
+		d    time.Duration
+	}{
+		{"IDEMPOTENCY_LEASE", c.Idempotency.Lease},
+		{"IDEMPOTENCY_CLEANUP_INTERVAL", c.Idempotency.CleanupInterval},
+		{"RATE_LIMIT_CLEANUP_INTERVAL", c.RateLimit.CleanupInterval},
+		{"EVENTS_HEARTBEAT_INTERVAL", c.Events.HeartbeatInterval},
+		{"SLA_CHECK_INTERVAL", c.SLA.CheckInterval},
+		{"SLA_REASSIGN_INTERVAL", c.SLA.ReassignInterval},
+	}
+	for _, p := range positive {
+		if p.d <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", p.name, p.d)
+		}
+	}
+	if c.Server.DrainDelay < 0 {
+		return fmt.Errorf("SHUTDOWN_DRAIN_DELAY must not be negative, got %s", c.Server.DrainDelay)
+	}
+	return nil
+}
+
+// splitList разбирает список через запятую, пропуская пустые элементы
+func splitList(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
 }
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// validConfig — конфиг со значениями по умолчанию из NewConfig
+func validConfig() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ShutdownTimeout: 15 * time.Second,
+			DrainDelay:      5 * time.Second,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             24 * time.Hour,
+			Lease:           time.Minute,
+			CleanupInterval: time.Hour,
+		},
+		RateLimit: RateLimitConfig{
+			CleanupInterval: 10 * time.Minute,
+		},
+		Events: EventsConfig{
+			BufferSize:        1000,
+			HeartbeatInterval: 15 * time.Second,
+		},
+		SLA: SLAConfig{
+			CheckInterval:    5 * time.Minute,
+			BatchSize:        100,
+			WebhookTimeout:   5 * time.Second,
+			ReassignInterval: 5 * time.Minute,
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *Config)
+		wantErr string
+	}{
+		{
+			name:   "значения по умолчанию",
+			mutate: func(c *Config) {},
+		},
+		{
+			name:    "нулевая аренда ключа идемпотентности",
+			mutate:  func(c *Config) { c.Idempotency.Lease = 0 },
+			wantErr: "IDEMPOTENCY_LEASE must be positive, got 0s",
+		},
+		{
+			name:    "отрицательный интервал очистки ключей идемпотентности",
+			mutate:  func(c *Config) { c.Idempotency.CleanupInterval = -time.Second },
+			wantErr: "IDEMPOTENCY_CLEANUP_INTERVAL must be positive, got -1s",
+		},
+		{
+			name:   "без задержки перед остановкой",
+			mutate: func(c *Config) { c.Server.DrainDelay = 0 },
+		},
+		{
+			name:    "отрицательная задержка перед остановкой",
+			mutate:  func(c *Config) { c.Server.DrainDelay = -time.Second },
+			wantErr: "SHUTDOWN_DRAIN_DELAY must not be negative, got -1s",
+		},
+		{
+			name:    "нулевой интервал очистки бакетов",
+			mutate:  func(c *Config) { c.RateLimit.CleanupInterval = 0 },
+			wantErr: "RATE_LIMIT_CLEANUP_INTERVAL must be positive, got 0s",
+		},
+		{
+			name:    "нулевой интервал heartbeat",
+			mutate:  func(c *Config) { c.Events.HeartbeatInterval = 0 },
+			wantErr: "EVENTS_HEARTBEAT_INTERVAL must be positive, got 0s",
+		},
+		{
+			name:    "нулевой интервал проверки SLA",
+			mutate:  func(c *Config) { c.SLA.CheckInterval = 0 },
+			wantErr: "SLA_CHECK_INTERVAL must be positive, got 0s",
+		},
+		{
+			name:    "отрицательный интервал переназначения",
+			mutate:  func(c *Config) { c.SLA.ReassignInterval = -time.Minute },
+			wantErr: "SLA_REASSIGN_INTERVAL must be positive, got -1m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			tt.mutate(c)
+
+			err := c.validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
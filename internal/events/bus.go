@@ -0,0 +1,197 @@
+// Package events — шина событий об изменениях для потока /events/stream.
+// Сервисы публикуют события только после коммита транзакции, подписчики
+// получают их в порядке ID и могут догнать пропущенное из буфера.
+package events
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+)
+
+// subscriberBuffer — сколько событий может ждать медленный подписчик,
+// прежде чем его отключат; клиент переподключится с Last-Event-ID
+const subscriberBuffer = 64
+
+// Publisher вызывается внутри транзакции изменения, как audit.Record.
+// Реализация сама решает, как не выпустить событие при откате. Ошибку
+// нужно вернуть из транзакции: после нее транзакция может быть уже прервана
+type Publisher interface {
+	Publish(ctx context.Context, e *model.Event) error
+}
+
+// Bus — шина в памяти процесса. Последние события хранятся в буфере
+// ограниченного размера для возобновления по Last-Event-ID.
+// ID события — "<epoch>-<seq>": epoch меняется при каждом запуске и Reset,
+// поэтому ID от другой реплики или прошлого запуска не примут за свой
+type Bus struct {
+	mu     sync.Mutex
+	epoch  string
+	seq    uint64
+	size   int
+	buffer []entry
+	subs   map[*Subscription]struct{}
+	now    func() time.Time
+}
+
+type entry struct {
+	seq   uint64
+	event *model.Event
+}
+
+func NewBus(size int) *Bus {
+	return &Bus{
+		epoch:  newEpoch(),
+		size:   size,
+		buffer: make([]entry, 0, size),
+		subs:   make(map[*Subscription]struct{}),
+		now:    time.Now,
+	}
+}
+
+func newEpoch() string {
+	return strconv.FormatUint(rand.Uint64(), 36)
+}
+
+// Subscription получает события из C. Канал закрывается, если подписчик
+// не успевает их читать, вызван Close или шина сброшена
+type Subscription struct {
+	C <-chan *model.Event
+	// Position — ID последнего события на момент подписки
+	Position string
+
+	ch     chan *model.Event
+	filter *model.EventFilter
+	bus    *Bus
+}
+
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.unsubscribe(s)
+}
+
+// Epoch — префикс ID событий текущего поколения шины
+func (b *Bus) Epoch() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.epoch
+}
+
+// Publish рассылает событие после коммита транзакции из ctx; при откате
+// событие не публикуется. Вне транзакции рассылает сразу
+func (b *Bus) Publish(ctx context.Context, e *model.Event) error {
+	db.AfterCommit(ctx, func() {
+		b.publish(e)
+	})
+	return nil
+}
+
+// publish назначает событию следующий ID и рассылает его подписчикам
+func (b *Bus) publish(e *model.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e.ID = b.id(b.seq)
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = b.now().UTC()
+	}
+
+	if b.size > 0 {
+		if len(b.buffer) == b.size {
+			b.buffer = append(b.buffer[1:], entry{seq: b.seq, event: e})
+		} else {
+			b.buffer = append(b.buffer, entry{seq: b.seq, event: e})
+		}
+	}
+
+	for s := range b.subs {
+		if !s.filter.Match(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			b.unsubscribe(s)
+		}
+	}
+}
+
+// Reset начинает новое поколение: буфер очищается, подписки закрываются.
+// Нужен, когда часть событий могла быть потеряна, — клиенты переподключатся
+// со старым Last-Event-ID и получат reset
+func (b *Bus) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.epoch = newEpoch()
+	b.seq = 0
+	b.buffer = b.buffer[:0]
+	for s := range b.subs {
+		b.unsubscribe(s)
+	}
+}
+
+// Subscribe подписывает на события после lastEventID (пустой — только новые)
+// и возвращает подходящие под фильтр события из буфера после него. Если часть
+// событий после lastEventID уже вытеснена из буфера или ID из другого поколения
+// шины, complete равен false: replay пуст, и клиенту нужно заново загрузить
+// состояние на момент sub.Position
+func (b *Bus) Subscribe(filter *model.EventFilter, lastEventID string) (sub *Subscription, replay []*model.Event, complete bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan *model.Event, subscriberBuffer)
+	sub = &Subscription{C: ch, Position: b.id(b.seq), ch: ch, filter: filter, bus: b}
+	b.subs[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+	lastID, ok := b.parseID(lastEventID)
+	if !ok || lastID > b.seq {
+		return sub, nil, false
+	}
+	if lastID == b.seq {
+		return sub, nil, true
+	}
+	// в буфере должно остаться событие, следующее за lastID
+	if len(b.buffer) == 0 || b.buffer[0].seq > lastID+1 {
+		return sub, nil, false
+	}
+
+	for _, en := range b.buffer {
+		if en.seq > lastID && filter.Match(en.event) {
+			replay = append(replay, en.event)
+		}
+	}
+	return sub, replay, true
+}
+
+func (b *Bus) id(seq uint64) string {
+	return fmt.Sprintf("%s-%d", b.epoch, seq)
+}
+
+func (b *Bus) parseID(id string) (uint64, bool) {
+	epoch, raw, ok := strings.Cut(id, "-")
+	if !ok || epoch != b.epoch {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	return seq, err == nil
+}
+
+func (b *Bus) unsubscribe(s *Subscription) {
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		close(s.ch)
+	}
+}
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+)
+
+func publishN(b *Bus, n int, users ...uuid.UUID) {
+	for i := 0; i < n; i++ {
+		b.Publish(context.Background(), &model.Event{Type: model.EventPRCreated, UserIDs: users})
+	}
+}
+
+func ids(list []*model.Event) []string {
+	res := make([]string, 0, len(list))
+	for _, e := range list {
+		res = append(res, e.ID)
+	}
+	return res
+}
+
+func TestBus_Resume(t *testing.T) {
+	b := NewBus(3)
+	b.epoch = "e1"
+	publishN(b, 5)
+
+	tests := []struct {
+		name     string
+		lastID   string
+		replay   []string
+		complete bool
+	}{
+		{"new subscriber", "", nil, true},
+		{"within buffer", "e1-3", []string{"e1-4", "e1-5"}, true},
+		{"right before buffer", "e1-2", []string{"e1-3", "e1-4", "e1-5"}, true},
+		{"up to date", "e1-5", nil, true},
+		{"evicted", "e1-1", nil, false},
+		{"ahead of bus", "e1-42", nil, false},
+		{"other epoch", "e0-3", nil, false},
+		{"malformed", "abc", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, replay, complete := b.Subscribe(&model.EventFilter{}, tt.lastID)
+			defer sub.Close()
+
+			if tt.replay == nil {
+				assert.Empty(t, replay)
+			} else {
+				assert.Equal(t, tt.replay, ids(replay))
+			}
+			assert.Equal(t, tt.complete, complete)
+			assert.Equal(t, "e1-5", sub.Position)
+		})
+	}
+}
+
+func TestBus_Filter(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	b := NewBus(10)
+	b.epoch = "e1"
+
+	byUser, _, _ := b.Subscribe(&model.EventFilter{UserID: &alice}, "")
+	byTeam, _, _ := b.Subscribe(&model.EventFilter{TeamName: "backend", TeamMembers: []uuid.UUID{bob}}, "")
+	defer byUser.Close()
+	defer byTeam.Close()
+
+	b.Publish(context.Background(), &model.Event{Type: model.EventUserActivityChanged, TeamName: "backend", UserIDs: []uuid.UUID{alice}})
+	b.Publish(context.Background(), &model.Event{Type: model.EventPRMerged, UserIDs: []uuid.UUID{bob}})
+	b.Publish(context.Background(), &model.Event{Type: model.EventPRMerged, UserIDs: []uuid.UUID{uuid.New()}})
+
+	assert.Equal(t, "e1-1", (<-byUser.C).ID)
+	assert.Len(t, byUser.C, 0)
+
+	assert.Equal(t, "e1-1", (<-byTeam.C).ID)
+	assert.Equal(t, "e1-2", (<-byTeam.C).ID)
+	assert.Len(t, byTeam.C, 0)
+}
+
+func TestBus_SlowSubscriberIsDropped(t *testing.T) {
+	b := NewBus(0)
+	sub, _, _ := b.Subscribe(&model.EventFilter{}, "")
+
+	publishN(b, subscriberBuffer+1)
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+
+	// повторный Close после отключения безопасен
+	sub.Close()
+}
+
+func TestBus_Reset(t *testing.T) {
+	b := NewBus(10)
+	publishN(b, 3)
+	sub, _, _ := b.Subscribe(&model.EventFilter{}, "")
+	last := sub.Position
+
+	b.Reset()
+
+	_, ok := <-sub.C
+	assert.False(t, ok, "подписки закрываются при сбросе")
+
+	resumed, replay, complete := b.Subscribe(&model.EventFilter{}, last)
+	defer resumed.Close()
+	assert.False(t, complete)
+	assert.Empty(t, replay)
+	assert.NotEqual(t, last, resumed.Position)
+}
+
+func TestBus_PublishAfterCommit(t *testing.T) {
+	b := NewBus(10)
+	sub, _, _ := b.Subscribe(&model.EventFilter{}, "")
+	defer sub.Close()
+
+	// вне транзакции событие публикуется сразу
+	b.Publish(context.Background(), &model.Event{Type: model.EventPRCreated})
+	require.Len(t, sub.C, 1)
+
+	ctx, run := db.WithAfterCommit(context.Background())
+	b.Publish(ctx, &model.Event{Type: model.EventPRMerged})
+	require.Len(t, sub.C, 1)
+
+	run()
+	require.Len(t, sub.C, 2)
+}
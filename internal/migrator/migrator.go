@@ -0,0 +1,161 @@
+// Package migrator применяет встроенные в бинарник миграции из migrations/
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxMigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/rs/zerolog/log"
+
+	"PR/migrations"
+)
+
+// lockID — ключ pg_advisory_lock, под которым реплики применяют миграции по очереди
+const lockID int64 = 0x50525f6d6967
+
+type Migrator struct {
+	db          *sql.DB
+	m           *migrate.Migrate
+	lockTimeout time.Duration
+}
+
+// New подключается к базе по dsn; lockTimeout ограничивает ожидание блокировки,
+// пока миграции применяет другая реплика
+func New(dsn string, lockTimeout time.Duration) (*Migrator, error) {
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse dsn: %w", err)
+	}
+	db := stdlib.OpenDB(*cfg)
+
+	driver, err := pgxMigrate.WithInstance(db, &pgxMigrate.Config{})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("migrate driver: %w", err)
+	}
+
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		_ = driver.Close()
+		return nil, fmt.Errorf("migrations source: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "pgx5", driver)
+	if err != nil {
+		_ = driver.Close()
+		return nil, fmt.Errorf("migrate instance: %w", err)
+	}
+	// собственная блокировка уже взята, внутренняя golang-migrate не должна отваливаться раньше
+	m.LockTimeout = lockTimeout
+
+	return &Migrator{db: db, m: m, lockTimeout: lockTimeout}, nil
+}
+
+// Up применяет все новые миграции
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func() error {
+		err := m.m.Up()
+		if errors.Is(err, migrate.ErrNoChange) {
+			return nil
+		}
+		return err
+	})
+}
+
+// Down откатывает steps последних миграций
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+	return m.withLock(ctx, func() error {
+		return m.m.Steps(-steps)
+	})
+}
+
+// Force помечает версию схемы примененной без выполнения миграций — для выхода из dirty состояния
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	return m.withLock(ctx, func() error {
+		return m.m.Force(version)
+	})
+}
+
+// Version возвращает текущую версию схемы; 0 — миграции еще не применялись
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Close закрывает соединения с базой (их закрывает драйвер golang-migrate)
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// withLock выполняет fn под сессионной advisory блокировкой на отдельном соединении
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	lockCtx, cancel := context.WithTimeout(ctx, m.lockTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if _, err = conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		log.Info().Msgf("Waited %s for migration lock", waited.Round(time.Millisecond))
+	}
+	defer func() {
+		// при ошибке блокировка снимется вместе с закрытием соединения
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			log.Error().Msgf("Release migration lock error: %v", err)
+		}
+	}()
+
+	return fn()
+}
+
+// Latest возвращает номер последней встроенной миграции
+func Latest() (uint, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	return latest(src)
+}
+
+func latest(src source.Driver) (uint, error) {
+	version, err := src.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
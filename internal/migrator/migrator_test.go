@@ -0,0 +1,42 @@
+package migrator
+
+import (
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/migrations"
+)
+
+func TestLatest(t *testing.T) {
+	files, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	var want uint
+	for _, name := range files {
+		n, err := strconv.ParseUint(strings.SplitN(name, "_", 2)[0], 10, 32)
+		require.NoError(t, err, name)
+		want = max(want, uint(n))
+	}
+
+	got, err := Latest()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestEmbeddedMigrations_HaveDown(t *testing.T) {
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+
+	for _, up := range ups {
+		prefix := strings.SplitN(up, "_", 2)[0]
+		downs, err := fs.Glob(migrations.FS, prefix+"_*.down.sql")
+		require.NoError(t, err)
+		assert.Len(t, downs, 1, "down migration for %s", up)
+	}
+}
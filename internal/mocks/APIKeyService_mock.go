@@ -0,0 +1,318 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAPIKeyService creates a new instance of MockAPIKeyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyService {
+	mock := &MockAPIKeyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKeyService is an autogenerated mock type for the APIKeyService type
+type MockAPIKeyService struct {
+	mock.Mock
+}
+
+type MockAPIKeyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyService) EXPECT() *MockAPIKeyService_Expecter {
+	return &MockAPIKeyService_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockAPIKeyService
+func (_mock *MockAPIKeyService) Authenticate(ctx context.Context, key string) (*model.APIKey, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.APIKey, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.APIKey); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAPIKeyService_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockAPIKeyService_Expecter) Authenticate(ctx interface{}, key interface{}) *MockAPIKeyService_Authenticate_Call {
+	return &MockAPIKeyService_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, key)}
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) Run(run func(ctx context.Context, key string)) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) RunAndReturn(run func(ctx context.Context, key string) (*model.APIKey, error)) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockAPIKeyService
+func (_mock *MockAPIKeyService) Create(ctx context.Context, name string, role model.Role, userID *uuid.UUID) (*model.APIKeyCreated, error) {
+	ret := _mock.Called(ctx, name, role, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *model.APIKeyCreated
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Role, *uuid.UUID) (*model.APIKeyCreated, error)); ok {
+		return returnFunc(ctx, name, role, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Role, *uuid.UUID) *model.APIKeyCreated); ok {
+		r0 = returnFunc(ctx, name, role, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKeyCreated)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.Role, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, name, role, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - role model.Role
+//   - userID *uuid.UUID
+func (_e *MockAPIKeyService_Expecter) Create(ctx interface{}, name interface{}, role interface{}, userID interface{}) *MockAPIKeyService_Create_Call {
+	return &MockAPIKeyService_Create_Call{Call: _e.mock.On("Create", ctx, name, role, userID)}
+}
+
+func (_c *MockAPIKeyService_Create_Call) Run(run func(ctx context.Context, name string, role model.Role, userID *uuid.UUID)) *MockAPIKeyService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.Role
+		if args[2] != nil {
+			arg2 = args[2].(model.Role)
+		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_Create_Call) Return(aPIKeyCreated *model.APIKeyCreated, err error) *MockAPIKeyService_Create_Call {
+	_c.Call.Return(aPIKeyCreated, err)
+	return _c
+}
+
+func (_c *MockAPIKeyService_Create_Call) RunAndReturn(run func(ctx context.Context, name string, role model.Role, userID *uuid.UUID) (*model.APIKeyCreated, error)) *MockAPIKeyService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockAPIKeyService
+func (_mock *MockAPIKeyService) List(ctx context.Context) ([]*model.APIKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*model.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.APIKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.APIKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAPIKeyService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPIKeyService_Expecter) List(ctx interface{}) *MockAPIKeyService_List_Call {
+	return &MockAPIKeyService_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockAPIKeyService_List_Call) Run(run func(ctx context.Context)) *MockAPIKeyService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_List_Call) Return(aPIKeys []*model.APIKey, err error) *MockAPIKeyService_List_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *MockAPIKeyService_List_Call) RunAndReturn(run func(ctx context.Context) ([]*model.APIKey, error)) *MockAPIKeyService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type MockAPIKeyService
+func (_mock *MockAPIKeyService) Revoke(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.APIKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyService_Expecter) Revoke(ctx interface{}, id interface{}) *MockAPIKeyService_Revoke_Call {
+	return &MockAPIKeyService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *MockAPIKeyService_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_Revoke_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyService_Revoke_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyService_Revoke_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*model.APIKey, error)) *MockAPIKeyService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
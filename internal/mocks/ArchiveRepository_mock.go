@@ -0,0 +1,220 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockArchiveRepository creates a new instance of MockArchiveRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArchiveRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArchiveRepository {
+	mock := &MockArchiveRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArchiveRepository is an autogenerated mock type for the ArchiveRepository type
+type MockArchiveRepository struct {
+	mock.Mock
+}
+
+type MockArchiveRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArchiveRepository) EXPECT() *MockArchiveRepository_Expecter {
+	return &MockArchiveRepository_Expecter{mock: &_m.Mock}
+}
+
+// Counts provides a mock function for the type MockArchiveRepository
+func (_mock *MockArchiveRepository) Counts(ctx context.Context) (*model.ArchiveCounts, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Counts")
+	}
+
+	var r0 *model.ArchiveCounts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*model.ArchiveCounts, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.ArchiveCounts); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ArchiveCounts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArchiveRepository_Counts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Counts'
+type MockArchiveRepository_Counts_Call struct {
+	*mock.Call
+}
+
+// Counts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockArchiveRepository_Expecter) Counts(ctx interface{}) *MockArchiveRepository_Counts_Call {
+	return &MockArchiveRepository_Counts_Call{Call: _e.mock.On("Counts", ctx)}
+}
+
+func (_c *MockArchiveRepository_Counts_Call) Run(run func(ctx context.Context)) *MockArchiveRepository_Counts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchiveRepository_Counts_Call) Return(archiveCounts *model.ArchiveCounts, err error) *MockArchiveRepository_Counts_Call {
+	_c.Call.Return(archiveCounts, err)
+	return _c
+}
+
+func (_c *MockArchiveRepository_Counts_Call) RunAndReturn(run func(ctx context.Context) (*model.ArchiveCounts, error)) *MockArchiveRepository_Counts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function for the type MockArchiveRepository
+func (_mock *MockArchiveRepository) Export(ctx context.Context) (*model.Archive, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *model.Archive
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*model.Archive, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.Archive); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Archive)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArchiveRepository_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockArchiveRepository_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockArchiveRepository_Expecter) Export(ctx interface{}) *MockArchiveRepository_Export_Call {
+	return &MockArchiveRepository_Export_Call{Call: _e.mock.On("Export", ctx)}
+}
+
+func (_c *MockArchiveRepository_Export_Call) Run(run func(ctx context.Context)) *MockArchiveRepository_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchiveRepository_Export_Call) Return(archive *model.Archive, err error) *MockArchiveRepository_Export_Call {
+	_c.Call.Return(archive, err)
+	return _c
+}
+
+func (_c *MockArchiveRepository_Export_Call) RunAndReturn(run func(ctx context.Context) (*model.Archive, error)) *MockArchiveRepository_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function for the type MockArchiveRepository
+func (_mock *MockArchiveRepository) Import(ctx context.Context, a *model.Archive) error {
+	ret := _mock.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Archive) error); ok {
+		r0 = returnFunc(ctx, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArchiveRepository_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockArchiveRepository_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a *model.Archive
+func (_e *MockArchiveRepository_Expecter) Import(ctx interface{}, a interface{}) *MockArchiveRepository_Import_Call {
+	return &MockArchiveRepository_Import_Call{Call: _e.mock.On("Import", ctx, a)}
+}
+
+func (_c *MockArchiveRepository_Import_Call) Run(run func(ctx context.Context, a *model.Archive)) *MockArchiveRepository_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Archive
+		if args[1] != nil {
+			arg1 = args[1].(*model.Archive)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchiveRepository_Import_Call) Return(err error) *MockArchiveRepository_Import_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArchiveRepository_Import_Call) RunAndReturn(run func(ctx context.Context, a *model.Archive) error) *MockArchiveRepository_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,169 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockArchiveService creates a new instance of MockArchiveService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArchiveService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArchiveService {
+	mock := &MockArchiveService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArchiveService is an autogenerated mock type for the ArchiveService type
+type MockArchiveService struct {
+	mock.Mock
+}
+
+type MockArchiveService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArchiveService) EXPECT() *MockArchiveService_Expecter {
+	return &MockArchiveService_Expecter{mock: &_m.Mock}
+}
+
+// Export provides a mock function for the type MockArchiveService
+func (_mock *MockArchiveService) Export(ctx context.Context) (*model.Archive, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *model.Archive
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*model.Archive, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.Archive); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Archive)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArchiveService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockArchiveService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockArchiveService_Expecter) Export(ctx interface{}) *MockArchiveService_Export_Call {
+	return &MockArchiveService_Export_Call{Call: _e.mock.On("Export", ctx)}
+}
+
+func (_c *MockArchiveService_Export_Call) Run(run func(ctx context.Context)) *MockArchiveService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchiveService_Export_Call) Return(archive *model.Archive, err error) *MockArchiveService_Export_Call {
+	_c.Call.Return(archive, err)
+	return _c
+}
+
+func (_c *MockArchiveService_Export_Call) RunAndReturn(run func(ctx context.Context) (*model.Archive, error)) *MockArchiveService_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function for the type MockArchiveService
+func (_mock *MockArchiveService) Import(ctx context.Context, a *model.Archive) (*model.ArchiveCounts, error) {
+	ret := _mock.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *model.ArchiveCounts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Archive) (*model.ArchiveCounts, error)); ok {
+		return returnFunc(ctx, a)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Archive) *model.ArchiveCounts); ok {
+		r0 = returnFunc(ctx, a)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ArchiveCounts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Archive) error); ok {
+		r1 = returnFunc(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArchiveService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockArchiveService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a *model.Archive
+func (_e *MockArchiveService_Expecter) Import(ctx interface{}, a interface{}) *MockArchiveService_Import_Call {
+	return &MockArchiveService_Import_Call{Call: _e.mock.On("Import", ctx, a)}
+}
+
+func (_c *MockArchiveService_Import_Call) Run(run func(ctx context.Context, a *model.Archive)) *MockArchiveService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Archive
+		if args[1] != nil {
+			arg1 = args[1].(*model.Archive)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchiveService_Import_Call) Return(archiveCounts *model.ArchiveCounts, err error) *MockArchiveService_Import_Call {
+	_c.Call.Return(archiveCounts, err)
+	return _c
+}
+
+func (_c *MockArchiveService_Import_Call) RunAndReturn(run func(ctx context.Context, a *model.Archive) (*model.ArchiveCounts, error)) *MockArchiveService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
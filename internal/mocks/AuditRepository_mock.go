@@ -0,0 +1,164 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAuditRepository creates a new instance of MockAuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditRepository {
+	mock := &MockAuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditRepository is an autogenerated mock type for the AuditRepository type
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+type MockAuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditRepository) EXPECT() *MockAuditRepository_Expecter {
+	return &MockAuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) Create(ctx context.Context, e *model.AuditEvent) error {
+	ret := _mock.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent) error); ok {
+		r0 = returnFunc(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAuditRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e *model.AuditEvent
+func (_e *MockAuditRepository_Expecter) Create(ctx interface{}, e interface{}) *MockAuditRepository_Create_Call {
+	return &MockAuditRepository_Create_Call{Call: _e.mock.On("Create", ctx, e)}
+}
+
+func (_c *MockAuditRepository_Create_Call) Run(run func(ctx context.Context, e *model.AuditEvent)) *MockAuditRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.AuditEvent
+		if args[1] != nil {
+			arg1 = args[1].(*model.AuditEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_Create_Call) Return(err error) *MockAuditRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditRepository_Create_Call) RunAndReturn(run func(ctx context.Context, e *model.AuditEvent) error) *MockAuditRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error) {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditFilter) ([]*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, f)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditFilter) []*model.AuditEvent); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *model.AuditFilter
+func (_e *MockAuditRepository_Expecter) List(ctx interface{}, f interface{}) *MockAuditRepository_List_Call {
+	return &MockAuditRepository_List_Call{Call: _e.mock.On("List", ctx, f)}
+}
+
+func (_c *MockAuditRepository_List_Call) Run(run func(ctx context.Context, f *model.AuditFilter)) *MockAuditRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.AuditFilter
+		if args[1] != nil {
+			arg1 = args[1].(*model.AuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_List_Call) Return(auditEvents []*model.AuditEvent, err error) *MockAuditRepository_List_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *MockAuditRepository_List_Call) RunAndReturn(run func(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error)) *MockAuditRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
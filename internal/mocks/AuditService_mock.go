@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAuditService creates a new instance of MockAuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditService {
+	mock := &MockAuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditService is an autogenerated mock type for the AuditService type
+type MockAuditService struct {
+	mock.Mock
+}
+
+type MockAuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditService) EXPECT() *MockAuditService_Expecter {
+	return &MockAuditService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function for the type MockAuditService
+func (_mock *MockAuditService) List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error) {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditFilter) ([]*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, f)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditFilter) []*model.AuditEvent); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.AuditFilter) error); ok {
+		r1 = returnFunc(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *model.AuditFilter
+func (_e *MockAuditService_Expecter) List(ctx interface{}, f interface{}) *MockAuditService_List_Call {
+	return &MockAuditService_List_Call{Call: _e.mock.On("List", ctx, f)}
+}
+
+func (_c *MockAuditService_List_Call) Run(run func(ctx context.Context, f *model.AuditFilter)) *MockAuditService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.AuditFilter
+		if args[1] != nil {
+			arg1 = args[1].(*model.AuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditService_List_Call) Return(auditEvents []*model.AuditEvent, err error) *MockAuditService_List_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *MockAuditService_List_Call) RunAndReturn(run func(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error)) *MockAuditService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
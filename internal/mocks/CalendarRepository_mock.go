@@ -0,0 +1,490 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCalendarRepository creates a new instance of MockCalendarRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarRepository {
+	mock := &MockCalendarRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarRepository is an autogenerated mock type for the CalendarRepository type
+type MockCalendarRepository struct {
+	mock.Mock
+}
+
+type MockCalendarRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarRepository) EXPECT() *MockCalendarRepository_Expecter {
+	return &MockCalendarRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddHoliday provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) AddHoliday(ctx context.Context, h *model.Holiday) error {
+	ret := _mock.Called(ctx, h)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddHoliday")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Holiday) error); ok {
+		r0 = returnFunc(ctx, h)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarRepository_AddHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHoliday'
+type MockCalendarRepository_AddHoliday_Call struct {
+	*mock.Call
+}
+
+// AddHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - h *model.Holiday
+func (_e *MockCalendarRepository_Expecter) AddHoliday(ctx interface{}, h interface{}) *MockCalendarRepository_AddHoliday_Call {
+	return &MockCalendarRepository_AddHoliday_Call{Call: _e.mock.On("AddHoliday", ctx, h)}
+}
+
+func (_c *MockCalendarRepository_AddHoliday_Call) Run(run func(ctx context.Context, h *model.Holiday)) *MockCalendarRepository_AddHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Holiday
+		if args[1] != nil {
+			arg1 = args[1].(*model.Holiday)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_AddHoliday_Call) Return(err error) *MockCalendarRepository_AddHoliday_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_AddHoliday_Call) RunAndReturn(run func(ctx context.Context, h *model.Holiday) error) *MockCalendarRepository_AddHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHolidays provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) GetHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error) {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []*model.Holiday
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.HolidayFilter) ([]*model.Holiday, error)); ok {
+		return returnFunc(ctx, f)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.HolidayFilter) []*model.Holiday); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Holiday)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.HolidayFilter) error); ok {
+		r1 = returnFunc(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarRepository_GetHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidays'
+type MockCalendarRepository_GetHolidays_Call struct {
+	*mock.Call
+}
+
+// GetHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *model.HolidayFilter
+func (_e *MockCalendarRepository_Expecter) GetHolidays(ctx interface{}, f interface{}) *MockCalendarRepository_GetHolidays_Call {
+	return &MockCalendarRepository_GetHolidays_Call{Call: _e.mock.On("GetHolidays", ctx, f)}
+}
+
+func (_c *MockCalendarRepository_GetHolidays_Call) Run(run func(ctx context.Context, f *model.HolidayFilter)) *MockCalendarRepository_GetHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.HolidayFilter
+		if args[1] != nil {
+			arg1 = args[1].(*model.HolidayFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetHolidays_Call) Return(holidays []*model.Holiday, err error) *MockCalendarRepository_GetHolidays_Call {
+	_c.Call.Return(holidays, err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetHolidays_Call) RunAndReturn(run func(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error)) *MockCalendarRepository_GetHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchedules provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) GetSchedules(ctx context.Context, userIDs []uuid.UUID) ([]*model.UserSchedule, error) {
+	ret := _mock.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchedules")
+	}
+
+	var r0 []*model.UserSchedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*model.UserSchedule, error)); ok {
+		return returnFunc(ctx, userIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*model.UserSchedule); ok {
+		r0 = returnFunc(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserSchedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarRepository_GetSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedules'
+type MockCalendarRepository_GetSchedules_Call struct {
+	*mock.Call
+}
+
+// GetSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uuid.UUID
+func (_e *MockCalendarRepository_Expecter) GetSchedules(ctx interface{}, userIDs interface{}) *MockCalendarRepository_GetSchedules_Call {
+	return &MockCalendarRepository_GetSchedules_Call{Call: _e.mock.On("GetSchedules", ctx, userIDs)}
+}
+
+func (_c *MockCalendarRepository_GetSchedules_Call) Run(run func(ctx context.Context, userIDs []uuid.UUID)) *MockCalendarRepository_GetSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetSchedules_Call) Return(userSchedules []*model.UserSchedule, err error) *MockCalendarRepository_GetSchedules_Call {
+	_c.Call.Return(userSchedules, err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetSchedules_Call) RunAndReturn(run func(ctx context.Context, userIDs []uuid.UUID) ([]*model.UserSchedule, error)) *MockCalendarRepository_GetSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamHours provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) GetTeamHours(ctx context.Context, teamName string) (*model.WorkingHours, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamHours")
+	}
+
+	var r0 *model.WorkingHours
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.WorkingHours, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.WorkingHours); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingHours)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarRepository_GetTeamHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamHours'
+type MockCalendarRepository_GetTeamHours_Call struct {
+	*mock.Call
+}
+
+// GetTeamHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockCalendarRepository_Expecter) GetTeamHours(ctx interface{}, teamName interface{}) *MockCalendarRepository_GetTeamHours_Call {
+	return &MockCalendarRepository_GetTeamHours_Call{Call: _e.mock.On("GetTeamHours", ctx, teamName)}
+}
+
+func (_c *MockCalendarRepository_GetTeamHours_Call) Run(run func(ctx context.Context, teamName string)) *MockCalendarRepository_GetTeamHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetTeamHours_Call) Return(workingHours *model.WorkingHours, err error) *MockCalendarRepository_GetTeamHours_Call {
+	_c.Call.Return(workingHours, err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_GetTeamHours_Call) RunAndReturn(run func(ctx context.Context, teamName string) (*model.WorkingHours, error)) *MockCalendarRepository_GetTeamHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveHoliday provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) RemoveHoliday(ctx context.Context, date string, teamName string) error {
+	ret := _mock.Called(ctx, date, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveHoliday")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, date, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarRepository_RemoveHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveHoliday'
+type MockCalendarRepository_RemoveHoliday_Call struct {
+	*mock.Call
+}
+
+// RemoveHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date string
+//   - teamName string
+func (_e *MockCalendarRepository_Expecter) RemoveHoliday(ctx interface{}, date interface{}, teamName interface{}) *MockCalendarRepository_RemoveHoliday_Call {
+	return &MockCalendarRepository_RemoveHoliday_Call{Call: _e.mock.On("RemoveHoliday", ctx, date, teamName)}
+}
+
+func (_c *MockCalendarRepository_RemoveHoliday_Call) Run(run func(ctx context.Context, date string, teamName string)) *MockCalendarRepository_RemoveHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_RemoveHoliday_Call) Return(err error) *MockCalendarRepository_RemoveHoliday_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_RemoveHoliday_Call) RunAndReturn(run func(ctx context.Context, date string, teamName string) error) *MockCalendarRepository_RemoveHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamHours provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) error {
+	ret := _mock.Called(ctx, teamName, wh)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamHours")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.WorkingHours) error); ok {
+		r0 = returnFunc(ctx, teamName, wh)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarRepository_SetTeamHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamHours'
+type MockCalendarRepository_SetTeamHours_Call struct {
+	*mock.Call
+}
+
+// SetTeamHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - wh *model.WorkingHours
+func (_e *MockCalendarRepository_Expecter) SetTeamHours(ctx interface{}, teamName interface{}, wh interface{}) *MockCalendarRepository_SetTeamHours_Call {
+	return &MockCalendarRepository_SetTeamHours_Call{Call: _e.mock.On("SetTeamHours", ctx, teamName, wh)}
+}
+
+func (_c *MockCalendarRepository_SetTeamHours_Call) Run(run func(ctx context.Context, teamName string, wh *model.WorkingHours)) *MockCalendarRepository_SetTeamHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.WorkingHours
+		if args[2] != nil {
+			arg2 = args[2].(*model.WorkingHours)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_SetTeamHours_Call) Return(err error) *MockCalendarRepository_SetTeamHours_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_SetTeamHours_Call) RunAndReturn(run func(ctx context.Context, teamName string, wh *model.WorkingHours) error) *MockCalendarRepository_SetTeamHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserHours provides a mock function for the type MockCalendarRepository
+func (_mock *MockCalendarRepository) SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) error {
+	ret := _mock.Called(ctx, userID, wh)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserHours")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.WorkingHours) error); ok {
+		r0 = returnFunc(ctx, userID, wh)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarRepository_SetUserHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserHours'
+type MockCalendarRepository_SetUserHours_Call struct {
+	*mock.Call
+}
+
+// SetUserHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - wh *model.WorkingHours
+func (_e *MockCalendarRepository_Expecter) SetUserHours(ctx interface{}, userID interface{}, wh interface{}) *MockCalendarRepository_SetUserHours_Call {
+	return &MockCalendarRepository_SetUserHours_Call{Call: _e.mock.On("SetUserHours", ctx, userID, wh)}
+}
+
+func (_c *MockCalendarRepository_SetUserHours_Call) Run(run func(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours)) *MockCalendarRepository_SetUserHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *model.WorkingHours
+		if args[2] != nil {
+			arg2 = args[2].(*model.WorkingHours)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarRepository_SetUserHours_Call) Return(err error) *MockCalendarRepository_SetUserHours_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarRepository_SetUserHours_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) error) *MockCalendarRepository_SetUserHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
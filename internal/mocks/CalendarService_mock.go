@@ -0,0 +1,523 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCalendarService creates a new instance of MockCalendarService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarService {
+	mock := &MockCalendarService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarService is an autogenerated mock type for the CalendarService type
+type MockCalendarService struct {
+	mock.Mock
+}
+
+type MockCalendarService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarService) EXPECT() *MockCalendarService_Expecter {
+	return &MockCalendarService_Expecter{mock: &_m.Mock}
+}
+
+// AddHoliday provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) AddHoliday(ctx context.Context, h *model.Holiday) (*model.Holiday, error) {
+	ret := _mock.Called(ctx, h)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddHoliday")
+	}
+
+	var r0 *model.Holiday
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Holiday) (*model.Holiday, error)); ok {
+		return returnFunc(ctx, h)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Holiday) *model.Holiday); ok {
+		r0 = returnFunc(ctx, h)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Holiday)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Holiday) error); ok {
+		r1 = returnFunc(ctx, h)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_AddHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHoliday'
+type MockCalendarService_AddHoliday_Call struct {
+	*mock.Call
+}
+
+// AddHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - h *model.Holiday
+func (_e *MockCalendarService_Expecter) AddHoliday(ctx interface{}, h interface{}) *MockCalendarService_AddHoliday_Call {
+	return &MockCalendarService_AddHoliday_Call{Call: _e.mock.On("AddHoliday", ctx, h)}
+}
+
+func (_c *MockCalendarService_AddHoliday_Call) Run(run func(ctx context.Context, h *model.Holiday)) *MockCalendarService_AddHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Holiday
+		if args[1] != nil {
+			arg1 = args[1].(*model.Holiday)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_AddHoliday_Call) Return(holiday *model.Holiday, err error) *MockCalendarService_AddHoliday_Call {
+	_c.Call.Return(holiday, err)
+	return _c
+}
+
+func (_c *MockCalendarService_AddHoliday_Call) RunAndReturn(run func(ctx context.Context, h *model.Holiday) (*model.Holiday, error)) *MockCalendarService_AddHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamHours provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) GetTeamHours(ctx context.Context, teamName string) (*model.TeamWorkingHours, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamHours")
+	}
+
+	var r0 *model.TeamWorkingHours
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.TeamWorkingHours, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.TeamWorkingHours); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamWorkingHours)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_GetTeamHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamHours'
+type MockCalendarService_GetTeamHours_Call struct {
+	*mock.Call
+}
+
+// GetTeamHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockCalendarService_Expecter) GetTeamHours(ctx interface{}, teamName interface{}) *MockCalendarService_GetTeamHours_Call {
+	return &MockCalendarService_GetTeamHours_Call{Call: _e.mock.On("GetTeamHours", ctx, teamName)}
+}
+
+func (_c *MockCalendarService_GetTeamHours_Call) Run(run func(ctx context.Context, teamName string)) *MockCalendarService_GetTeamHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_GetTeamHours_Call) Return(teamWorkingHours *model.TeamWorkingHours, err error) *MockCalendarService_GetTeamHours_Call {
+	_c.Call.Return(teamWorkingHours, err)
+	return _c
+}
+
+func (_c *MockCalendarService_GetTeamHours_Call) RunAndReturn(run func(ctx context.Context, teamName string) (*model.TeamWorkingHours, error)) *MockCalendarService_GetTeamHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSchedule provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) GetUserSchedule(ctx context.Context, userID uuid.UUID) (*model.UserSchedule, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSchedule")
+	}
+
+	var r0 *model.UserSchedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.UserSchedule, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.UserSchedule); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserSchedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_GetUserSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSchedule'
+type MockCalendarService_GetUserSchedule_Call struct {
+	*mock.Call
+}
+
+// GetUserSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockCalendarService_Expecter) GetUserSchedule(ctx interface{}, userID interface{}) *MockCalendarService_GetUserSchedule_Call {
+	return &MockCalendarService_GetUserSchedule_Call{Call: _e.mock.On("GetUserSchedule", ctx, userID)}
+}
+
+func (_c *MockCalendarService_GetUserSchedule_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCalendarService_GetUserSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_GetUserSchedule_Call) Return(userSchedule *model.UserSchedule, err error) *MockCalendarService_GetUserSchedule_Call {
+	_c.Call.Return(userSchedule, err)
+	return _c
+}
+
+func (_c *MockCalendarService_GetUserSchedule_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*model.UserSchedule, error)) *MockCalendarService_GetUserSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHolidays provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) ListHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error) {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHolidays")
+	}
+
+	var r0 []*model.Holiday
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.HolidayFilter) ([]*model.Holiday, error)); ok {
+		return returnFunc(ctx, f)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.HolidayFilter) []*model.Holiday); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Holiday)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.HolidayFilter) error); ok {
+		r1 = returnFunc(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_ListHolidays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHolidays'
+type MockCalendarService_ListHolidays_Call struct {
+	*mock.Call
+}
+
+// ListHolidays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *model.HolidayFilter
+func (_e *MockCalendarService_Expecter) ListHolidays(ctx interface{}, f interface{}) *MockCalendarService_ListHolidays_Call {
+	return &MockCalendarService_ListHolidays_Call{Call: _e.mock.On("ListHolidays", ctx, f)}
+}
+
+func (_c *MockCalendarService_ListHolidays_Call) Run(run func(ctx context.Context, f *model.HolidayFilter)) *MockCalendarService_ListHolidays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.HolidayFilter
+		if args[1] != nil {
+			arg1 = args[1].(*model.HolidayFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_ListHolidays_Call) Return(holidays []*model.Holiday, err error) *MockCalendarService_ListHolidays_Call {
+	_c.Call.Return(holidays, err)
+	return _c
+}
+
+func (_c *MockCalendarService_ListHolidays_Call) RunAndReturn(run func(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error)) *MockCalendarService_ListHolidays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveHoliday provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) RemoveHoliday(ctx context.Context, date string, teamName string) error {
+	ret := _mock.Called(ctx, date, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveHoliday")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, date, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarService_RemoveHoliday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveHoliday'
+type MockCalendarService_RemoveHoliday_Call struct {
+	*mock.Call
+}
+
+// RemoveHoliday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date string
+//   - teamName string
+func (_e *MockCalendarService_Expecter) RemoveHoliday(ctx interface{}, date interface{}, teamName interface{}) *MockCalendarService_RemoveHoliday_Call {
+	return &MockCalendarService_RemoveHoliday_Call{Call: _e.mock.On("RemoveHoliday", ctx, date, teamName)}
+}
+
+func (_c *MockCalendarService_RemoveHoliday_Call) Run(run func(ctx context.Context, date string, teamName string)) *MockCalendarService_RemoveHoliday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_RemoveHoliday_Call) Return(err error) *MockCalendarService_RemoveHoliday_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarService_RemoveHoliday_Call) RunAndReturn(run func(ctx context.Context, date string, teamName string) error) *MockCalendarService_RemoveHoliday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamHours provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) (*model.TeamWorkingHours, error) {
+	ret := _mock.Called(ctx, teamName, wh)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamHours")
+	}
+
+	var r0 *model.TeamWorkingHours
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.WorkingHours) (*model.TeamWorkingHours, error)); ok {
+		return returnFunc(ctx, teamName, wh)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.WorkingHours) *model.TeamWorkingHours); ok {
+		r0 = returnFunc(ctx, teamName, wh)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamWorkingHours)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *model.WorkingHours) error); ok {
+		r1 = returnFunc(ctx, teamName, wh)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_SetTeamHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamHours'
+type MockCalendarService_SetTeamHours_Call struct {
+	*mock.Call
+}
+
+// SetTeamHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - wh *model.WorkingHours
+func (_e *MockCalendarService_Expecter) SetTeamHours(ctx interface{}, teamName interface{}, wh interface{}) *MockCalendarService_SetTeamHours_Call {
+	return &MockCalendarService_SetTeamHours_Call{Call: _e.mock.On("SetTeamHours", ctx, teamName, wh)}
+}
+
+func (_c *MockCalendarService_SetTeamHours_Call) Run(run func(ctx context.Context, teamName string, wh *model.WorkingHours)) *MockCalendarService_SetTeamHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.WorkingHours
+		if args[2] != nil {
+			arg2 = args[2].(*model.WorkingHours)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_SetTeamHours_Call) Return(teamWorkingHours *model.TeamWorkingHours, err error) *MockCalendarService_SetTeamHours_Call {
+	_c.Call.Return(teamWorkingHours, err)
+	return _c
+}
+
+func (_c *MockCalendarService_SetTeamHours_Call) RunAndReturn(run func(ctx context.Context, teamName string, wh *model.WorkingHours) (*model.TeamWorkingHours, error)) *MockCalendarService_SetTeamHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserHours provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) (*model.UserSchedule, error) {
+	ret := _mock.Called(ctx, userID, wh)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserHours")
+	}
+
+	var r0 *model.UserSchedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.WorkingHours) (*model.UserSchedule, error)); ok {
+		return returnFunc(ctx, userID, wh)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.WorkingHours) *model.UserSchedule); ok {
+		r0 = returnFunc(ctx, userID, wh)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserSchedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *model.WorkingHours) error); ok {
+		r1 = returnFunc(ctx, userID, wh)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_SetUserHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserHours'
+type MockCalendarService_SetUserHours_Call struct {
+	*mock.Call
+}
+
+// SetUserHours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - wh *model.WorkingHours
+func (_e *MockCalendarService_Expecter) SetUserHours(ctx interface{}, userID interface{}, wh interface{}) *MockCalendarService_SetUserHours_Call {
+	return &MockCalendarService_SetUserHours_Call{Call: _e.mock.On("SetUserHours", ctx, userID, wh)}
+}
+
+func (_c *MockCalendarService_SetUserHours_Call) Run(run func(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours)) *MockCalendarService_SetUserHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *model.WorkingHours
+		if args[2] != nil {
+			arg2 = args[2].(*model.WorkingHours)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_SetUserHours_Call) Return(userSchedule *model.UserSchedule, err error) *MockCalendarService_SetUserHours_Call {
+	_c.Call.Return(userSchedule, err)
+	return _c
+}
+
+func (_c *MockCalendarService_SetUserHours_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) (*model.UserSchedule, error)) *MockCalendarService_SetUserHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
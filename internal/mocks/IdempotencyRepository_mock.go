@@ -0,0 +1,368 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIdempotencyRepository creates a new instance of MockIdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+type MockIdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepository_Expecter {
+	return &MockIdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Complete(ctx context.Context, rec *model.IdempotencyRecord) error {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord) error); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockIdempotencyRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *model.IdempotencyRecord
+func (_e *MockIdempotencyRepository_Expecter) Complete(ctx interface{}, rec interface{}) *MockIdempotencyRepository_Complete_Call {
+	return &MockIdempotencyRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, rec)}
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Run(run func(ctx context.Context, rec *model.IdempotencyRecord)) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*model.IdempotencyRecord)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) Return(err error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Complete_Call) RunAndReturn(run func(ctx context.Context, rec *model.IdempotencyRecord) error) *MockIdempotencyRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Delete(ctx context.Context, rec *model.IdempotencyRecord) error {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord) error); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIdempotencyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *model.IdempotencyRecord
+func (_e *MockIdempotencyRepository_Expecter) Delete(ctx interface{}, rec interface{}) *MockIdempotencyRepository_Delete_Call {
+	return &MockIdempotencyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, rec)}
+}
+
+func (_c *MockIdempotencyRepository_Delete_Call) Run(run func(ctx context.Context, rec *model.IdempotencyRecord)) *MockIdempotencyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*model.IdempotencyRecord)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Delete_Call) Return(err error) *MockIdempotencyRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, rec *model.IdempotencyRecord) error) *MockIdempotencyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpired provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepository_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockIdempotencyRepository_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockIdempotencyRepository_Expecter) DeleteExpired(ctx interface{}, now interface{}) *MockIdempotencyRepository_DeleteExpired_Call {
+	return &MockIdempotencyRepository_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, now)}
+}
+
+func (_c *MockIdempotencyRepository_DeleteExpired_Call) Run(run func(ctx context.Context, now time.Time)) *MockIdempotencyRepository_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_DeleteExpired_Call) Return(n int64, err error) *MockIdempotencyRepository_DeleteExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_DeleteExpired_Call) RunAndReturn(run func(ctx context.Context, now time.Time) (int64, error)) *MockIdempotencyRepository_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Get(ctx context.Context, scope string, key string) (*model.IdempotencyRecord, error) {
+	ret := _mock.Called(ctx, scope, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.IdempotencyRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*model.IdempotencyRecord, error)); ok {
+		return returnFunc(ctx, scope, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *model.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, scope, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdempotencyRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, scope, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIdempotencyRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope string
+//   - key string
+func (_e *MockIdempotencyRepository_Expecter) Get(ctx interface{}, scope interface{}, key interface{}) *MockIdempotencyRepository_Get_Call {
+	return &MockIdempotencyRepository_Get_Call{Call: _e.mock.On("Get", ctx, scope, key)}
+}
+
+func (_c *MockIdempotencyRepository_Get_Call) Run(run func(ctx context.Context, scope string, key string)) *MockIdempotencyRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Get_Call) Return(idempotencyRecord *model.IdempotencyRecord, err error) *MockIdempotencyRepository_Get_Call {
+	_c.Call.Return(idempotencyRecord, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Get_Call) RunAndReturn(run func(ctx context.Context, scope string, key string) (*model.IdempotencyRecord, error)) *MockIdempotencyRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockIdempotencyRepository
+func (_mock *MockIdempotencyRepository) Reserve(ctx context.Context, rec *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *model.IdempotencyRecord
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.IdempotencyRecord) *model.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdempotencyRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.IdempotencyRecord) bool); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *model.IdempotencyRecord) error); ok {
+		r2 = returnFunc(ctx, rec)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIdempotencyRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockIdempotencyRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *model.IdempotencyRecord
+func (_e *MockIdempotencyRepository_Expecter) Reserve(ctx interface{}, rec interface{}) *MockIdempotencyRepository_Reserve_Call {
+	return &MockIdempotencyRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, rec)}
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Run(run func(ctx context.Context, rec *model.IdempotencyRecord)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*model.IdempotencyRecord)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) Return(idempotencyRecord *model.IdempotencyRecord, b bool, err error) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(idempotencyRecord, b, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepository_Reserve_Call) RunAndReturn(run func(ctx context.Context, rec *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error)) *MockIdempotencyRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
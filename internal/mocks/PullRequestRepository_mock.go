@@ -7,6 +7,7 @@ package mocks
 import (
 	"PR/internal/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,189 @@ func (_m *MockPullRequestRepository) EXPECT() *MockPullRequestRepository_Expecte
 	return &MockPullRequestRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddReviewer provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) AddReviewer(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReviewer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPullRequestRepository_AddReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReviewer'
+type MockPullRequestRepository_AddReviewer_Call struct {
+	*mock.Call
+}
+
+// AddReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestRepository_Expecter) AddReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestRepository_AddReviewer_Call {
+	return &MockPullRequestRepository_AddReviewer_Call{Call: _e.mock.On("AddReviewer", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestRepository_AddReviewer_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestRepository_AddReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_AddReviewer_Call) Return(err error) *MockPullRequestRepository_AddReviewer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_AddReviewer_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error) *MockPullRequestRepository_AddReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseAssignment provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) CloseAssignment(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseAssignment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPullRequestRepository_CloseAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseAssignment'
+type MockPullRequestRepository_CloseAssignment_Call struct {
+	*mock.Call
+}
+
+// CloseAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestRepository_Expecter) CloseAssignment(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestRepository_CloseAssignment_Call {
+	return &MockPullRequestRepository_CloseAssignment_Call{Call: _e.mock.On("CloseAssignment", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestRepository_CloseAssignment_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestRepository_CloseAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_CloseAssignment_Call) Return(err error) *MockPullRequestRepository_CloseAssignment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_CloseAssignment_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error) *MockPullRequestRepository_CloseAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAssignments provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) CreateAssignments(ctx context.Context, list []*model.ReviewerAssignment) error {
+	ret := _mock.Called(ctx, list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssignments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.ReviewerAssignment) error); ok {
+		r0 = returnFunc(ctx, list)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPullRequestRepository_CreateAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssignments'
+type MockPullRequestRepository_CreateAssignments_Call struct {
+	*mock.Call
+}
+
+// CreateAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list []*model.ReviewerAssignment
+func (_e *MockPullRequestRepository_Expecter) CreateAssignments(ctx interface{}, list interface{}) *MockPullRequestRepository_CreateAssignments_Call {
+	return &MockPullRequestRepository_CreateAssignments_Call{Call: _e.mock.On("CreateAssignments", ctx, list)}
+}
+
+func (_c *MockPullRequestRepository_CreateAssignments_Call) Run(run func(ctx context.Context, list []*model.ReviewerAssignment)) *MockPullRequestRepository_CreateAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.ReviewerAssignment
+		if args[1] != nil {
+			arg1 = args[1].([]*model.ReviewerAssignment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_CreateAssignments_Call) Return(err error) *MockPullRequestRepository_CreateAssignments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_CreateAssignments_Call) RunAndReturn(run func(ctx context.Context, list []*model.ReviewerAssignment) error) *MockPullRequestRepository_CreateAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePR provides a mock function for the type MockPullRequestRepository
 func (_mock *MockPullRequestRepository) CreatePR(ctx context.Context, pr *model.PullRequest) error {
 	ret := _mock.Called(ctx, pr)
@@ -289,6 +473,234 @@ func (_c *MockPullRequestRepository_GetByReviewer_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetHistory provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error) {
+	ret := _mock.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []*model.ReviewerAssignment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.ReviewerAssignment, error)); ok {
+		return returnFunc(ctx, prID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.ReviewerAssignment); ok {
+		r0 = returnFunc(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ReviewerAssignment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestRepository_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockPullRequestRepository_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+func (_e *MockPullRequestRepository_Expecter) GetHistory(ctx interface{}, prID interface{}) *MockPullRequestRepository_GetHistory_Call {
+	return &MockPullRequestRepository_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, prID)}
+}
+
+func (_c *MockPullRequestRepository_GetHistory_Call) Run(run func(ctx context.Context, prID uuid.UUID)) *MockPullRequestRepository_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetHistory_Call) Return(reviewerAssignments []*model.ReviewerAssignment, err error) *MockPullRequestRepository_GetHistory_Call {
+	_c.Call.Return(reviewerAssignments, err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetHistory_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error)) *MockPullRequestRepository_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaleReview provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) GetStaleReview(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, now time.Time) (*model.StaleReview, error) {
+	ret := _mock.Called(ctx, prID, reviewerID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaleReview")
+	}
+
+	var r0 *model.StaleReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*model.StaleReview, error)); ok {
+		return returnFunc(ctx, prID, reviewerID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *model.StaleReview); ok {
+		r0 = returnFunc(ctx, prID, reviewerID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StaleReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, prID, reviewerID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestRepository_GetStaleReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaleReview'
+type MockPullRequestRepository_GetStaleReview_Call struct {
+	*mock.Call
+}
+
+// GetStaleReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - now time.Time
+func (_e *MockPullRequestRepository_Expecter) GetStaleReview(ctx interface{}, prID interface{}, reviewerID interface{}, now interface{}) *MockPullRequestRepository_GetStaleReview_Call {
+	return &MockPullRequestRepository_GetStaleReview_Call{Call: _e.mock.On("GetStaleReview", ctx, prID, reviewerID, now)}
+}
+
+func (_c *MockPullRequestRepository_GetStaleReview_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, now time.Time)) *MockPullRequestRepository_GetStaleReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetStaleReview_Call) Return(staleReview *model.StaleReview, err error) *MockPullRequestRepository_GetStaleReview_Call {
+	_c.Call.Return(staleReview, err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetStaleReview_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, now time.Time) (*model.StaleReview, error)) *MockPullRequestRepository_GetStaleReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaleReviews provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) GetStaleReviews(ctx context.Context, now time.Time, after *model.StaleReview, limit int) ([]*model.StaleReview, error) {
+	ret := _mock.Called(ctx, now, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaleReviews")
+	}
+
+	var r0 []*model.StaleReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *model.StaleReview, int) ([]*model.StaleReview, error)); ok {
+		return returnFunc(ctx, now, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *model.StaleReview, int) []*model.StaleReview); ok {
+		r0 = returnFunc(ctx, now, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StaleReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, *model.StaleReview, int) error); ok {
+		r1 = returnFunc(ctx, now, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestRepository_GetStaleReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaleReviews'
+type MockPullRequestRepository_GetStaleReviews_Call struct {
+	*mock.Call
+}
+
+// GetStaleReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - after *model.StaleReview
+//   - limit int
+func (_e *MockPullRequestRepository_Expecter) GetStaleReviews(ctx interface{}, now interface{}, after interface{}, limit interface{}) *MockPullRequestRepository_GetStaleReviews_Call {
+	return &MockPullRequestRepository_GetStaleReviews_Call{Call: _e.mock.On("GetStaleReviews", ctx, now, after, limit)}
+}
+
+func (_c *MockPullRequestRepository_GetStaleReviews_Call) Run(run func(ctx context.Context, now time.Time, after *model.StaleReview, limit int)) *MockPullRequestRepository_GetStaleReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 *model.StaleReview
+		if args[2] != nil {
+			arg2 = args[2].(*model.StaleReview)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetStaleReviews_Call) Return(staleReviews []*model.StaleReview, err error) *MockPullRequestRepository_GetStaleReviews_Call {
+	_c.Call.Return(staleReviews, err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_GetStaleReviews_Call) RunAndReturn(run func(ctx context.Context, now time.Time, after *model.StaleReview, limit int) ([]*model.StaleReview, error)) *MockPullRequestRepository_GetStaleReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetViewers provides a mock function for the type MockPullRequestRepository
 func (_mock *MockPullRequestRepository) GetViewers(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
 	ret := _mock.Called(ctx, id)
@@ -357,6 +769,86 @@ func (_c *MockPullRequestRepository_GetViewers_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// MarkReviewed provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) MarkReviewed(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, at time.Time) (*model.PRReview, error) {
+	ret := _mock.Called(ctx, prID, reviewerID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkReviewed")
+	}
+
+	var r0 *model.PRReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*model.PRReview, error)); ok {
+		return returnFunc(ctx, prID, reviewerID, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *model.PRReview); ok {
+		r0 = returnFunc(ctx, prID, reviewerID, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PRReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, prID, reviewerID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestRepository_MarkReviewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkReviewed'
+type MockPullRequestRepository_MarkReviewed_Call struct {
+	*mock.Call
+}
+
+// MarkReviewed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - at time.Time
+func (_e *MockPullRequestRepository_Expecter) MarkReviewed(ctx interface{}, prID interface{}, reviewerID interface{}, at interface{}) *MockPullRequestRepository_MarkReviewed_Call {
+	return &MockPullRequestRepository_MarkReviewed_Call{Call: _e.mock.On("MarkReviewed", ctx, prID, reviewerID, at)}
+}
+
+func (_c *MockPullRequestRepository_MarkReviewed_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, at time.Time)) *MockPullRequestRepository_MarkReviewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_MarkReviewed_Call) Return(pRReview *model.PRReview, err error) *MockPullRequestRepository_MarkReviewed_Call {
+	_c.Call.Return(pRReview, err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_MarkReviewed_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID, at time.Time) (*model.PRReview, error)) *MockPullRequestRepository_MarkReviewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Merge provides a mock function for the type MockPullRequestRepository
 func (_mock *MockPullRequestRepository) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
 	ret := _mock.Called(ctx, id)
@@ -493,3 +985,132 @@ func (_c *MockPullRequestRepository_ReassignReviewers_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveReviewer provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) RemoveReviewer(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReviewer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPullRequestRepository_RemoveReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReviewer'
+type MockPullRequestRepository_RemoveReviewer_Call struct {
+	*mock.Call
+}
+
+// RemoveReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestRepository_Expecter) RemoveReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestRepository_RemoveReviewer_Call {
+	return &MockPullRequestRepository_RemoveReviewer_Call{Call: _e.mock.On("RemoveReviewer", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestRepository_RemoveReviewer_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestRepository_RemoveReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_RemoveReviewer_Call) Return(err error) *MockPullRequestRepository_RemoveReviewer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_RemoveReviewer_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) error) *MockPullRequestRepository_RemoveReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryLockPR provides a mock function for the type MockPullRequestRepository
+func (_mock *MockPullRequestRepository) TryLockPR(ctx context.Context, prID uuid.UUID) (bool, error) {
+	ret := _mock.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryLockPR")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return returnFunc(ctx, prID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, prID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestRepository_TryLockPR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLockPR'
+type MockPullRequestRepository_TryLockPR_Call struct {
+	*mock.Call
+}
+
+// TryLockPR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+func (_e *MockPullRequestRepository_Expecter) TryLockPR(ctx interface{}, prID interface{}) *MockPullRequestRepository_TryLockPR_Call {
+	return &MockPullRequestRepository_TryLockPR_Call{Call: _e.mock.On("TryLockPR", ctx, prID)}
+}
+
+func (_c *MockPullRequestRepository_TryLockPR_Call) Run(run func(ctx context.Context, prID uuid.UUID)) *MockPullRequestRepository_TryLockPR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestRepository_TryLockPR_Call) Return(b bool, err error) *MockPullRequestRepository_TryLockPR_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPullRequestRepository_TryLockPR_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID) (bool, error)) *MockPullRequestRepository_TryLockPR_Call {
+	_c.Call.Return(run)
+	return _c
+}
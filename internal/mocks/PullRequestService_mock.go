@@ -7,6 +7,7 @@ package mocks
 import (
 	"PR/internal/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,80 @@ func (_m *MockPullRequestService) EXPECT() *MockPullRequestService_Expecter {
 	return &MockPullRequestService_Expecter{mock: &_m.Mock}
 }
 
+// AddReviewer provides a mock function for the type MockPullRequestService
+func (_mock *MockPullRequestService) AddReviewer(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReviewer")
+	}
+
+	var r0 *model.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.PullRequest, error)); ok {
+		return returnFunc(ctx, prID, reviewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.PullRequest); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestService_AddReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReviewer'
+type MockPullRequestService_AddReviewer_Call struct {
+	*mock.Call
+}
+
+// AddReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestService_Expecter) AddReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestService_AddReviewer_Call {
+	return &MockPullRequestService_AddReviewer_Call{Call: _e.mock.On("AddReviewer", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestService_AddReviewer_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestService_AddReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_AddReviewer_Call) Return(pullRequest *model.PullRequest, err error) *MockPullRequestService_AddReviewer_Call {
+	_c.Call.Return(pullRequest, err)
+	return _c
+}
+
+func (_c *MockPullRequestService_AddReviewer_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, error)) *MockPullRequestService_AddReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockPullRequestService
 func (_mock *MockPullRequestService) Create(ctx context.Context, p *model.PullRequestShort) (*model.PullRequest, error) {
 	ret := _mock.Called(ctx, p)
@@ -175,6 +250,74 @@ func (_c *MockPullRequestService_GetByReviewer_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetHistory provides a mock function for the type MockPullRequestService
+func (_mock *MockPullRequestService) GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error) {
+	ret := _mock.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []*model.ReviewerAssignment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.ReviewerAssignment, error)); ok {
+		return returnFunc(ctx, prID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.ReviewerAssignment); ok {
+		r0 = returnFunc(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ReviewerAssignment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestService_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockPullRequestService_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+func (_e *MockPullRequestService_Expecter) GetHistory(ctx interface{}, prID interface{}) *MockPullRequestService_GetHistory_Call {
+	return &MockPullRequestService_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, prID)}
+}
+
+func (_c *MockPullRequestService_GetHistory_Call) Run(run func(ctx context.Context, prID uuid.UUID)) *MockPullRequestService_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_GetHistory_Call) Return(reviewerAssignments []*model.ReviewerAssignment, err error) *MockPullRequestService_GetHistory_Call {
+	_c.Call.Return(reviewerAssignments, err)
+	return _c
+}
+
+func (_c *MockPullRequestService_GetHistory_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error)) *MockPullRequestService_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Merge provides a mock function for the type MockPullRequestService
 func (_mock *MockPullRequestService) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
 	ret := _mock.Called(ctx, id)
@@ -244,8 +387,8 @@ func (_c *MockPullRequestService_Merge_Call) RunAndReturn(run func(ctx context.C
 }
 
 // ReassignReviewers provides a mock function for the type MockPullRequestService
-func (_mock *MockPullRequestService) ReassignReviewers(ctx context.Context, oldID uuid.UUID, prID uuid.UUID) (*model.PullRequest, uuid.UUID, error) {
-	ret := _mock.Called(ctx, oldID, prID)
+func (_mock *MockPullRequestService) ReassignReviewers(ctx context.Context, oldID uuid.UUID, prID uuid.UUID, newID *uuid.UUID) (*model.PullRequest, uuid.UUID, error) {
+	ret := _mock.Called(ctx, oldID, prID, newID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewers")
@@ -254,25 +397,25 @@ func (_mock *MockPullRequestService) ReassignReviewers(ctx context.Context, oldI
 	var r0 *model.PullRequest
 	var r1 uuid.UUID
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.PullRequest, uuid.UUID, error)); ok {
-		return returnFunc(ctx, oldID, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) (*model.PullRequest, uuid.UUID, error)); ok {
+		return returnFunc(ctx, oldID, prID, newID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.PullRequest); ok {
-		r0 = returnFunc(ctx, oldID, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) *model.PullRequest); ok {
+		r0 = returnFunc(ctx, oldID, prID, newID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.PullRequest)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) uuid.UUID); ok {
-		r1 = returnFunc(ctx, oldID, prID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) uuid.UUID); ok {
+		r1 = returnFunc(ctx, oldID, prID, newID)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(uuid.UUID)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r2 = returnFunc(ctx, oldID, prID)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, oldID, prID, newID)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -288,11 +431,12 @@ type MockPullRequestService_ReassignReviewers_Call struct {
 //   - ctx context.Context
 //   - oldID uuid.UUID
 //   - prID uuid.UUID
-func (_e *MockPullRequestService_Expecter) ReassignReviewers(ctx interface{}, oldID interface{}, prID interface{}) *MockPullRequestService_ReassignReviewers_Call {
-	return &MockPullRequestService_ReassignReviewers_Call{Call: _e.mock.On("ReassignReviewers", ctx, oldID, prID)}
+//   - newID *uuid.UUID
+func (_e *MockPullRequestService_Expecter) ReassignReviewers(ctx interface{}, oldID interface{}, prID interface{}, newID interface{}) *MockPullRequestService_ReassignReviewers_Call {
+	return &MockPullRequestService_ReassignReviewers_Call{Call: _e.mock.On("ReassignReviewers", ctx, oldID, prID, newID)}
 }
 
-func (_c *MockPullRequestService_ReassignReviewers_Call) Run(run func(ctx context.Context, oldID uuid.UUID, prID uuid.UUID)) *MockPullRequestService_ReassignReviewers_Call {
+func (_c *MockPullRequestService_ReassignReviewers_Call) Run(run func(ctx context.Context, oldID uuid.UUID, prID uuid.UUID, newID *uuid.UUID)) *MockPullRequestService_ReassignReviewers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -306,10 +450,15 @@ func (_c *MockPullRequestService_ReassignReviewers_Call) Run(run func(ctx contex
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -320,7 +469,235 @@ func (_c *MockPullRequestService_ReassignReviewers_Call) Return(pullRequest *mod
 	return _c
 }
 
-func (_c *MockPullRequestService_ReassignReviewers_Call) RunAndReturn(run func(ctx context.Context, oldID uuid.UUID, prID uuid.UUID) (*model.PullRequest, uuid.UUID, error)) *MockPullRequestService_ReassignReviewers_Call {
+func (_c *MockPullRequestService_ReassignReviewers_Call) RunAndReturn(run func(ctx context.Context, oldID uuid.UUID, prID uuid.UUID, newID *uuid.UUID) (*model.PullRequest, uuid.UUID, error)) *MockPullRequestService_ReassignReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignStale provides a mock function for the type MockPullRequestService
+func (_mock *MockPullRequestService) ReassignStale(ctx context.Context, now time.Time, limit int) (int, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignStale")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestService_ReassignStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignStale'
+type MockPullRequestService_ReassignStale_Call struct {
+	*mock.Call
+}
+
+// ReassignStale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockPullRequestService_Expecter) ReassignStale(ctx interface{}, now interface{}, limit interface{}) *MockPullRequestService_ReassignStale_Call {
+	return &MockPullRequestService_ReassignStale_Call{Call: _e.mock.On("ReassignStale", ctx, now, limit)}
+}
+
+func (_c *MockPullRequestService_ReassignStale_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockPullRequestService_ReassignStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_ReassignStale_Call) Return(n int, err error) *MockPullRequestService_ReassignStale_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPullRequestService_ReassignStale_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) (int, error)) *MockPullRequestService_ReassignStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReviewer provides a mock function for the type MockPullRequestService
+func (_mock *MockPullRequestService) RemoveReviewer(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReviewer")
+	}
+
+	var r0 *model.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.PullRequest, error)); ok {
+		return returnFunc(ctx, prID, reviewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.PullRequest); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPullRequestService_RemoveReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReviewer'
+type MockPullRequestService_RemoveReviewer_Call struct {
+	*mock.Call
+}
+
+// RemoveReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestService_Expecter) RemoveReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestService_RemoveReviewer_Call {
+	return &MockPullRequestService_RemoveReviewer_Call{Call: _e.mock.On("RemoveReviewer", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestService_RemoveReviewer_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestService_RemoveReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_RemoveReviewer_Call) Return(pullRequest *model.PullRequest, err error) *MockPullRequestService_RemoveReviewer_Call {
+	_c.Call.Return(pullRequest, err)
+	return _c
+}
+
+func (_c *MockPullRequestService_RemoveReviewer_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, error)) *MockPullRequestService_RemoveReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function for the type MockPullRequestService
+func (_mock *MockPullRequestService) Review(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, *model.PRReview, error) {
+	ret := _mock.Called(ctx, prID, reviewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 *model.PullRequest
+	var r1 *model.PRReview
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.PullRequest, *model.PRReview, error)); ok {
+		return returnFunc(ctx, prID, reviewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.PullRequest); ok {
+		r0 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) *model.PRReview); ok {
+		r1 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.PRReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, prID, reviewerID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPullRequestService_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockPullRequestService_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID uuid.UUID
+//   - reviewerID uuid.UUID
+func (_e *MockPullRequestService_Expecter) Review(ctx interface{}, prID interface{}, reviewerID interface{}) *MockPullRequestService_Review_Call {
+	return &MockPullRequestService_Review_Call{Call: _e.mock.On("Review", ctx, prID, reviewerID)}
+}
+
+func (_c *MockPullRequestService_Review_Call) Run(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID)) *MockPullRequestService_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_Review_Call) Return(pullRequest *model.PullRequest, pRReview *model.PRReview, err error) *MockPullRequestService_Review_Call {
+	_c.Call.Return(pullRequest, pRReview, err)
+	return _c
+}
+
+func (_c *MockPullRequestService_Review_Call) RunAndReturn(run func(ctx context.Context, prID uuid.UUID, reviewerID uuid.UUID) (*model.PullRequest, *model.PRReview, error)) *MockPullRequestService_Review_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,180 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRateLimitRepository creates a new instance of MockRateLimitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimitRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimitRepository {
+	mock := &MockRateLimitRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRateLimitRepository is an autogenerated mock type for the RateLimitRepository type
+type MockRateLimitRepository struct {
+	mock.Mock
+}
+
+type MockRateLimitRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimitRepository) EXPECT() *MockRateLimitRepository_Expecter {
+	return &MockRateLimitRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteStale provides a mock function for the type MockRateLimitRepository
+func (_mock *MockRateLimitRepository) DeleteStale(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStale")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRateLimitRepository_DeleteStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStale'
+type MockRateLimitRepository_DeleteStale_Call struct {
+	*mock.Call
+}
+
+// DeleteStale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockRateLimitRepository_Expecter) DeleteStale(ctx interface{}, before interface{}) *MockRateLimitRepository_DeleteStale_Call {
+	return &MockRateLimitRepository_DeleteStale_Call{Call: _e.mock.On("DeleteStale", ctx, before)}
+}
+
+func (_c *MockRateLimitRepository_DeleteStale_Call) Run(run func(ctx context.Context, before time.Time)) *MockRateLimitRepository_DeleteStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRateLimitRepository_DeleteStale_Call) Return(n int64, err error) *MockRateLimitRepository_DeleteStale_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRateLimitRepository_DeleteStale_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *MockRateLimitRepository_DeleteStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Take provides a mock function for the type MockRateLimitRepository
+func (_mock *MockRateLimitRepository) Take(ctx context.Context, key string, l model.RateLimit) (*model.RateDecision, error) {
+	ret := _mock.Called(ctx, key, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Take")
+	}
+
+	var r0 *model.RateDecision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.RateLimit) (*model.RateDecision, error)); ok {
+		return returnFunc(ctx, key, l)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.RateLimit) *model.RateDecision); ok {
+		r0 = returnFunc(ctx, key, l)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RateDecision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.RateLimit) error); ok {
+		r1 = returnFunc(ctx, key, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRateLimitRepository_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type MockRateLimitRepository_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - l model.RateLimit
+func (_e *MockRateLimitRepository_Expecter) Take(ctx interface{}, key interface{}, l interface{}) *MockRateLimitRepository_Take_Call {
+	return &MockRateLimitRepository_Take_Call{Call: _e.mock.On("Take", ctx, key, l)}
+}
+
+func (_c *MockRateLimitRepository_Take_Call) Run(run func(ctx context.Context, key string, l model.RateLimit)) *MockRateLimitRepository_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.RateLimit
+		if args[2] != nil {
+			arg2 = args[2].(model.RateLimit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRateLimitRepository_Take_Call) Return(rateDecision *model.RateDecision, err error) *MockRateLimitRepository_Take_Call {
+	_c.Call.Return(rateDecision, err)
+	return _c
+}
+
+func (_c *MockRateLimitRepository_Take_Call) RunAndReturn(run func(ctx context.Context, key string, l model.RateLimit) (*model.RateDecision, error)) *MockRateLimitRepository_Take_Call {
+	_c.Call.Return(run)
+	return _c
+}
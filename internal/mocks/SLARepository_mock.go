@@ -0,0 +1,432 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSLARepository creates a new instance of MockSLARepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSLARepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSLARepository {
+	mock := &MockSLARepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSLARepository is an autogenerated mock type for the SLARepository type
+type MockSLARepository struct {
+	mock.Mock
+}
+
+type MockSLARepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSLARepository) EXPECT() *MockSLARepository_Expecter {
+	return &MockSLARepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAssignments provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) GetAssignments(ctx context.Context) ([]*model.SLAAssignment, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignments")
+	}
+
+	var r0 []*model.SLAAssignment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.SLAAssignment, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.SLAAssignment); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SLAAssignment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLARepository_GetAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignments'
+type MockSLARepository_GetAssignments_Call struct {
+	*mock.Call
+}
+
+// GetAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSLARepository_Expecter) GetAssignments(ctx interface{}) *MockSLARepository_GetAssignments_Call {
+	return &MockSLARepository_GetAssignments_Call{Call: _e.mock.On("GetAssignments", ctx)}
+}
+
+func (_c *MockSLARepository_GetAssignments_Call) Run(run func(ctx context.Context)) *MockSLARepository_GetAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_GetAssignments_Call) Return(sLAAssignments []*model.SLAAssignment, err error) *MockSLARepository_GetAssignments_Call {
+	_c.Call.Return(sLAAssignments, err)
+	return _c
+}
+
+func (_c *MockSLARepository_GetAssignments_Call) RunAndReturn(run func(ctx context.Context) ([]*model.SLAAssignment, error)) *MockSLARepository_GetAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDueReminders provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) GetDueReminders(ctx context.Context, now time.Time, after *model.SLAReminder, limit int) ([]*model.SLAReminder, error) {
+	ret := _mock.Called(ctx, now, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueReminders")
+	}
+
+	var r0 []*model.SLAReminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *model.SLAReminder, int) ([]*model.SLAReminder, error)); ok {
+		return returnFunc(ctx, now, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *model.SLAReminder, int) []*model.SLAReminder); ok {
+		r0 = returnFunc(ctx, now, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SLAReminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, *model.SLAReminder, int) error); ok {
+		r1 = returnFunc(ctx, now, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLARepository_GetDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueReminders'
+type MockSLARepository_GetDueReminders_Call struct {
+	*mock.Call
+}
+
+// GetDueReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - after *model.SLAReminder
+//   - limit int
+func (_e *MockSLARepository_Expecter) GetDueReminders(ctx interface{}, now interface{}, after interface{}, limit interface{}) *MockSLARepository_GetDueReminders_Call {
+	return &MockSLARepository_GetDueReminders_Call{Call: _e.mock.On("GetDueReminders", ctx, now, after, limit)}
+}
+
+func (_c *MockSLARepository_GetDueReminders_Call) Run(run func(ctx context.Context, now time.Time, after *model.SLAReminder, limit int)) *MockSLARepository_GetDueReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 *model.SLAReminder
+		if args[2] != nil {
+			arg2 = args[2].(*model.SLAReminder)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_GetDueReminders_Call) Return(sLAReminders []*model.SLAReminder, err error) *MockSLARepository_GetDueReminders_Call {
+	_c.Call.Return(sLAReminders, err)
+	return _c
+}
+
+func (_c *MockSLARepository_GetDueReminders_Call) RunAndReturn(run func(ctx context.Context, now time.Time, after *model.SLAReminder, limit int) ([]*model.SLAReminder, error)) *MockSLARepository_GetDueReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamSettings provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamSettings")
+	}
+
+	var r0 *model.TeamSLA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.TeamSLA, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.TeamSLA); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamSLA)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLARepository_GetTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamSettings'
+type MockSLARepository_GetTeamSettings_Call struct {
+	*mock.Call
+}
+
+// GetTeamSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockSLARepository_Expecter) GetTeamSettings(ctx interface{}, teamName interface{}) *MockSLARepository_GetTeamSettings_Call {
+	return &MockSLARepository_GetTeamSettings_Call{Call: _e.mock.On("GetTeamSettings", ctx, teamName)}
+}
+
+func (_c *MockSLARepository_GetTeamSettings_Call) Run(run func(ctx context.Context, teamName string)) *MockSLARepository_GetTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_GetTeamSettings_Call) Return(teamSLA *model.TeamSLA, err error) *MockSLARepository_GetTeamSettings_Call {
+	_c.Call.Return(teamSLA, err)
+	return _c
+}
+
+func (_c *MockSLARepository_GetTeamSettings_Call) RunAndReturn(run func(ctx context.Context, teamName string) (*model.TeamSLA, error)) *MockSLARepository_GetTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeamSettings provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) ListTeamSettings(ctx context.Context) ([]*model.TeamSLA, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeamSettings")
+	}
+
+	var r0 []*model.TeamSLA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.TeamSLA, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.TeamSLA); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TeamSLA)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLARepository_ListTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeamSettings'
+type MockSLARepository_ListTeamSettings_Call struct {
+	*mock.Call
+}
+
+// ListTeamSettings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSLARepository_Expecter) ListTeamSettings(ctx interface{}) *MockSLARepository_ListTeamSettings_Call {
+	return &MockSLARepository_ListTeamSettings_Call{Call: _e.mock.On("ListTeamSettings", ctx)}
+}
+
+func (_c *MockSLARepository_ListTeamSettings_Call) Run(run func(ctx context.Context)) *MockSLARepository_ListTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_ListTeamSettings_Call) Return(teamSLAs []*model.TeamSLA, err error) *MockSLARepository_ListTeamSettings_Call {
+	_c.Call.Return(teamSLAs, err)
+	return _c
+}
+
+func (_c *MockSLARepository_ListTeamSettings_Call) RunAndReturn(run func(ctx context.Context) ([]*model.TeamSLA, error)) *MockSLARepository_ListTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkReminded provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) MarkReminded(ctx context.Context, list []*model.SLAReminder, at time.Time) error {
+	ret := _mock.Called(ctx, list, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkReminded")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.SLAReminder, time.Time) error); ok {
+		r0 = returnFunc(ctx, list, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSLARepository_MarkReminded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkReminded'
+type MockSLARepository_MarkReminded_Call struct {
+	*mock.Call
+}
+
+// MarkReminded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list []*model.SLAReminder
+//   - at time.Time
+func (_e *MockSLARepository_Expecter) MarkReminded(ctx interface{}, list interface{}, at interface{}) *MockSLARepository_MarkReminded_Call {
+	return &MockSLARepository_MarkReminded_Call{Call: _e.mock.On("MarkReminded", ctx, list, at)}
+}
+
+func (_c *MockSLARepository_MarkReminded_Call) Run(run func(ctx context.Context, list []*model.SLAReminder, at time.Time)) *MockSLARepository_MarkReminded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.SLAReminder
+		if args[1] != nil {
+			arg1 = args[1].([]*model.SLAReminder)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_MarkReminded_Call) Return(err error) *MockSLARepository_MarkReminded_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSLARepository_MarkReminded_Call) RunAndReturn(run func(ctx context.Context, list []*model.SLAReminder, at time.Time) error) *MockSLARepository_MarkReminded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamSettings provides a mock function for the type MockSLARepository
+func (_mock *MockSLARepository) SetTeamSettings(ctx context.Context, s *model.TeamSLA) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamSettings")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.TeamSLA) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSLARepository_SetTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamSettings'
+type MockSLARepository_SetTeamSettings_Call struct {
+	*mock.Call
+}
+
+// SetTeamSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *model.TeamSLA
+func (_e *MockSLARepository_Expecter) SetTeamSettings(ctx interface{}, s interface{}) *MockSLARepository_SetTeamSettings_Call {
+	return &MockSLARepository_SetTeamSettings_Call{Call: _e.mock.On("SetTeamSettings", ctx, s)}
+}
+
+func (_c *MockSLARepository_SetTeamSettings_Call) Run(run func(ctx context.Context, s *model.TeamSLA)) *MockSLARepository_SetTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.TeamSLA
+		if args[1] != nil {
+			arg1 = args[1].(*model.TeamSLA)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLARepository_SetTeamSettings_Call) Return(err error) *MockSLARepository_SetTeamSettings_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSLARepository_SetTeamSettings_Call) RunAndReturn(run func(ctx context.Context, s *model.TeamSLA) error) *MockSLARepository_SetTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
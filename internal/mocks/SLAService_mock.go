@@ -0,0 +1,304 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"PR/internal/model"
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSLAService creates a new instance of MockSLAService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSLAService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSLAService {
+	mock := &MockSLAService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSLAService is an autogenerated mock type for the SLAService type
+type MockSLAService struct {
+	mock.Mock
+}
+
+type MockSLAService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSLAService) EXPECT() *MockSLAService_Expecter {
+	return &MockSLAService_Expecter{mock: &_m.Mock}
+}
+
+// GetStats provides a mock function for the type MockSLAService
+func (_mock *MockSLAService) GetStats(ctx context.Context) ([]*model.SLAStats, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStats")
+	}
+
+	var r0 []*model.SLAStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.SLAStats, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.SLAStats); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SLAStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLAService_GetStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStats'
+type MockSLAService_GetStats_Call struct {
+	*mock.Call
+}
+
+// GetStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSLAService_Expecter) GetStats(ctx interface{}) *MockSLAService_GetStats_Call {
+	return &MockSLAService_GetStats_Call{Call: _e.mock.On("GetStats", ctx)}
+}
+
+func (_c *MockSLAService_GetStats_Call) Run(run func(ctx context.Context)) *MockSLAService_GetStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLAService_GetStats_Call) Return(sLAStatss []*model.SLAStats, err error) *MockSLAService_GetStats_Call {
+	_c.Call.Return(sLAStatss, err)
+	return _c
+}
+
+func (_c *MockSLAService_GetStats_Call) RunAndReturn(run func(ctx context.Context) ([]*model.SLAStats, error)) *MockSLAService_GetStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamSettings provides a mock function for the type MockSLAService
+func (_mock *MockSLAService) GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamSettings")
+	}
+
+	var r0 *model.TeamSLA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.TeamSLA, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.TeamSLA); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamSLA)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLAService_GetTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamSettings'
+type MockSLAService_GetTeamSettings_Call struct {
+	*mock.Call
+}
+
+// GetTeamSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockSLAService_Expecter) GetTeamSettings(ctx interface{}, teamName interface{}) *MockSLAService_GetTeamSettings_Call {
+	return &MockSLAService_GetTeamSettings_Call{Call: _e.mock.On("GetTeamSettings", ctx, teamName)}
+}
+
+func (_c *MockSLAService_GetTeamSettings_Call) Run(run func(ctx context.Context, teamName string)) *MockSLAService_GetTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLAService_GetTeamSettings_Call) Return(teamSLA *model.TeamSLA, err error) *MockSLAService_GetTeamSettings_Call {
+	_c.Call.Return(teamSLA, err)
+	return _c
+}
+
+func (_c *MockSLAService_GetTeamSettings_Call) RunAndReturn(run func(ctx context.Context, teamName string) (*model.TeamSLA, error)) *MockSLAService_GetTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendReminders provides a mock function for the type MockSLAService
+func (_mock *MockSLAService) SendReminders(ctx context.Context, now time.Time) (int, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendReminders")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLAService_SendReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendReminders'
+type MockSLAService_SendReminders_Call struct {
+	*mock.Call
+}
+
+// SendReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockSLAService_Expecter) SendReminders(ctx interface{}, now interface{}) *MockSLAService_SendReminders_Call {
+	return &MockSLAService_SendReminders_Call{Call: _e.mock.On("SendReminders", ctx, now)}
+}
+
+func (_c *MockSLAService_SendReminders_Call) Run(run func(ctx context.Context, now time.Time)) *MockSLAService_SendReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLAService_SendReminders_Call) Return(n int, err error) *MockSLAService_SendReminders_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSLAService_SendReminders_Call) RunAndReturn(run func(ctx context.Context, now time.Time) (int, error)) *MockSLAService_SendReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamSettings provides a mock function for the type MockSLAService
+func (_mock *MockSLAService) SetTeamSettings(ctx context.Context, s *model.TeamSLA) (*model.TeamSLA, error) {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamSettings")
+	}
+
+	var r0 *model.TeamSLA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.TeamSLA) (*model.TeamSLA, error)); ok {
+		return returnFunc(ctx, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.TeamSLA) *model.TeamSLA); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamSLA)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.TeamSLA) error); ok {
+		r1 = returnFunc(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSLAService_SetTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamSettings'
+type MockSLAService_SetTeamSettings_Call struct {
+	*mock.Call
+}
+
+// SetTeamSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *model.TeamSLA
+func (_e *MockSLAService_Expecter) SetTeamSettings(ctx interface{}, s interface{}) *MockSLAService_SetTeamSettings_Call {
+	return &MockSLAService_SetTeamSettings_Call{Call: _e.mock.On("SetTeamSettings", ctx, s)}
+}
+
+func (_c *MockSLAService_SetTeamSettings_Call) Run(run func(ctx context.Context, s *model.TeamSLA)) *MockSLAService_SetTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.TeamSLA
+		if args[1] != nil {
+			arg1 = args[1].(*model.TeamSLA)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSLAService_SetTeamSettings_Call) Return(teamSLA *model.TeamSLA, err error) *MockSLAService_SetTeamSettings_Call {
+	_c.Call.Return(teamSLA, err)
+	return _c
+}
+
+func (_c *MockSLAService_SetTeamSettings_Call) RunAndReturn(run func(ctx context.Context, s *model.TeamSLA) (*model.TeamSLA, error)) *MockSLAService_SetTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
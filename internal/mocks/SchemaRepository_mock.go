@@ -0,0 +1,104 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSchemaRepository creates a new instance of MockSchemaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaRepository {
+	mock := &MockSchemaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaRepository is an autogenerated mock type for the SchemaRepository type
+type MockSchemaRepository struct {
+	mock.Mock
+}
+
+type MockSchemaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaRepository) EXPECT() *MockSchemaRepository_Expecter {
+	return &MockSchemaRepository_Expecter{mock: &_m.Mock}
+}
+
+// Version provides a mock function for the type MockSchemaRepository
+func (_mock *MockSchemaRepository) Version(ctx context.Context) (uint, bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 uint
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (uint, bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) uint); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSchemaRepository_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockSchemaRepository_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSchemaRepository_Expecter) Version(ctx interface{}) *MockSchemaRepository_Version_Call {
+	return &MockSchemaRepository_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *MockSchemaRepository_Version_Call) Run(run func(ctx context.Context)) *MockSchemaRepository_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaRepository_Version_Call) Return(version uint, dirty bool, err error) *MockSchemaRepository_Version_Call {
+	_c.Call.Return(version, dirty, err)
+	return _c
+}
+
+func (_c *MockSchemaRepository_Version_Call) RunAndReturn(run func(ctx context.Context) (uint, bool, error)) *MockSchemaRepository_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,8 +39,8 @@ func (_m *MockStatisticsRepository) EXPECT() *MockStatisticsRepository_Expecter
 }
 
 // GetPRStatistics provides a mock function for the type MockStatisticsRepository
-func (_mock *MockStatisticsRepository) GetPRStatistics(ctx context.Context) ([]*model.PRStats, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockStatisticsRepository) GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error) {
+	ret := _mock.Called(ctx, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPRStatistics")
@@ -48,18 +48,18 @@ func (_mock *MockStatisticsRepository) GetPRStatistics(ctx context.Context) ([]*
 
 	var r0 []*model.PRStats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.PRStats, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) ([]*model.PRStats, error)); ok {
+		return returnFunc(ctx, f)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.PRStats); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) []*model.PRStats); ok {
+		r0 = returnFunc(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.PRStats)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.StatisticsFilter) error); ok {
+		r1 = returnFunc(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,18 +73,24 @@ type MockStatisticsRepository_GetPRStatistics_Call struct {
 
 // GetPRStatistics is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStatisticsRepository_Expecter) GetPRStatistics(ctx interface{}) *MockStatisticsRepository_GetPRStatistics_Call {
-	return &MockStatisticsRepository_GetPRStatistics_Call{Call: _e.mock.On("GetPRStatistics", ctx)}
+//   - f model.StatisticsFilter
+func (_e *MockStatisticsRepository_Expecter) GetPRStatistics(ctx interface{}, f interface{}) *MockStatisticsRepository_GetPRStatistics_Call {
+	return &MockStatisticsRepository_GetPRStatistics_Call{Call: _e.mock.On("GetPRStatistics", ctx, f)}
 }
 
-func (_c *MockStatisticsRepository_GetPRStatistics_Call) Run(run func(ctx context.Context)) *MockStatisticsRepository_GetPRStatistics_Call {
+func (_c *MockStatisticsRepository_GetPRStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter)) *MockStatisticsRepository_GetPRStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -95,14 +101,14 @@ func (_c *MockStatisticsRepository_GetPRStatistics_Call) Return(pRStatss []*mode
 	return _c
 }
 
-func (_c *MockStatisticsRepository_GetPRStatistics_Call) RunAndReturn(run func(ctx context.Context) ([]*model.PRStats, error)) *MockStatisticsRepository_GetPRStatistics_Call {
+func (_c *MockStatisticsRepository_GetPRStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error)) *MockStatisticsRepository_GetPRStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetReviewerStatistics provides a mock function for the type MockStatisticsRepository
-func (_mock *MockStatisticsRepository) GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockStatisticsRepository) GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error) {
+	ret := _mock.Called(ctx, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReviewerStatistics")
@@ -110,18 +116,18 @@ func (_mock *MockStatisticsRepository) GetReviewerStatistics(ctx context.Context
 
 	var r0 []*model.ReviewerStats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.ReviewerStats, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) ([]*model.ReviewerStats, error)); ok {
+		return returnFunc(ctx, f)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.ReviewerStats); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) []*model.ReviewerStats); ok {
+		r0 = returnFunc(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.ReviewerStats)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.StatisticsFilter) error); ok {
+		r1 = returnFunc(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -135,18 +141,24 @@ type MockStatisticsRepository_GetReviewerStatistics_Call struct {
 
 // GetReviewerStatistics is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStatisticsRepository_Expecter) GetReviewerStatistics(ctx interface{}) *MockStatisticsRepository_GetReviewerStatistics_Call {
-	return &MockStatisticsRepository_GetReviewerStatistics_Call{Call: _e.mock.On("GetReviewerStatistics", ctx)}
+//   - f model.StatisticsFilter
+func (_e *MockStatisticsRepository_Expecter) GetReviewerStatistics(ctx interface{}, f interface{}) *MockStatisticsRepository_GetReviewerStatistics_Call {
+	return &MockStatisticsRepository_GetReviewerStatistics_Call{Call: _e.mock.On("GetReviewerStatistics", ctx, f)}
 }
 
-func (_c *MockStatisticsRepository_GetReviewerStatistics_Call) Run(run func(ctx context.Context)) *MockStatisticsRepository_GetReviewerStatistics_Call {
+func (_c *MockStatisticsRepository_GetReviewerStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter)) *MockStatisticsRepository_GetReviewerStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -157,7 +169,133 @@ func (_c *MockStatisticsRepository_GetReviewerStatistics_Call) Return(reviewerSt
 	return _c
 }
 
-func (_c *MockStatisticsRepository_GetReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context) ([]*model.ReviewerStats, error)) *MockStatisticsRepository_GetReviewerStatistics_Call {
+func (_c *MockStatisticsRepository_GetReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error)) *MockStatisticsRepository_GetReviewerStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPRStatistics provides a mock function for the type MockStatisticsRepository
+func (_mock *MockStatisticsRepository) StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error {
+	ret := _mock.Called(ctx, f, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPRStatistics")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter, func(*model.PRStats) error) error); ok {
+		r0 = returnFunc(ctx, f, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatisticsRepository_StreamPRStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPRStatistics'
+type MockStatisticsRepository_StreamPRStatistics_Call struct {
+	*mock.Call
+}
+
+// StreamPRStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f model.StatisticsFilter
+//   - fn func(*model.PRStats) error
+func (_e *MockStatisticsRepository_Expecter) StreamPRStatistics(ctx interface{}, f interface{}, fn interface{}) *MockStatisticsRepository_StreamPRStatistics_Call {
+	return &MockStatisticsRepository_StreamPRStatistics_Call{Call: _e.mock.On("StreamPRStatistics", ctx, f, fn)}
+}
+
+func (_c *MockStatisticsRepository_StreamPRStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error)) *MockStatisticsRepository_StreamPRStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
+		var arg2 func(*model.PRStats) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*model.PRStats) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatisticsRepository_StreamPRStatistics_Call) Return(err error) *MockStatisticsRepository_StreamPRStatistics_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatisticsRepository_StreamPRStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error) *MockStatisticsRepository_StreamPRStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamReviewerStatistics provides a mock function for the type MockStatisticsRepository
+func (_mock *MockStatisticsRepository) StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error {
+	ret := _mock.Called(ctx, f, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamReviewerStatistics")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter, func(*model.ReviewerStats) error) error); ok {
+		r0 = returnFunc(ctx, f, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatisticsRepository_StreamReviewerStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamReviewerStatistics'
+type MockStatisticsRepository_StreamReviewerStatistics_Call struct {
+	*mock.Call
+}
+
+// StreamReviewerStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f model.StatisticsFilter
+//   - fn func(*model.ReviewerStats) error
+func (_e *MockStatisticsRepository_Expecter) StreamReviewerStatistics(ctx interface{}, f interface{}, fn interface{}) *MockStatisticsRepository_StreamReviewerStatistics_Call {
+	return &MockStatisticsRepository_StreamReviewerStatistics_Call{Call: _e.mock.On("StreamReviewerStatistics", ctx, f, fn)}
+}
+
+func (_c *MockStatisticsRepository_StreamReviewerStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error)) *MockStatisticsRepository_StreamReviewerStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
+		var arg2 func(*model.ReviewerStats) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*model.ReviewerStats) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatisticsRepository_StreamReviewerStatistics_Call) Return(err error) *MockStatisticsRepository_StreamReviewerStatistics_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatisticsRepository_StreamReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error) *MockStatisticsRepository_StreamReviewerStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
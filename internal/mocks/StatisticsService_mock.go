@@ -39,8 +39,8 @@ func (_m *MockStatisticsService) EXPECT() *MockStatisticsService_Expecter {
 }
 
 // GetPRStatistics provides a mock function for the type MockStatisticsService
-func (_mock *MockStatisticsService) GetPRStatistics(ctx context.Context) ([]*model.PRStats, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockStatisticsService) GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error) {
+	ret := _mock.Called(ctx, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPRStatistics")
@@ -48,18 +48,18 @@ func (_mock *MockStatisticsService) GetPRStatistics(ctx context.Context) ([]*mod
 
 	var r0 []*model.PRStats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.PRStats, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) ([]*model.PRStats, error)); ok {
+		return returnFunc(ctx, f)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.PRStats); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) []*model.PRStats); ok {
+		r0 = returnFunc(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.PRStats)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.StatisticsFilter) error); ok {
+		r1 = returnFunc(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,18 +73,24 @@ type MockStatisticsService_GetPRStatistics_Call struct {
 
 // GetPRStatistics is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStatisticsService_Expecter) GetPRStatistics(ctx interface{}) *MockStatisticsService_GetPRStatistics_Call {
-	return &MockStatisticsService_GetPRStatistics_Call{Call: _e.mock.On("GetPRStatistics", ctx)}
+//   - f model.StatisticsFilter
+func (_e *MockStatisticsService_Expecter) GetPRStatistics(ctx interface{}, f interface{}) *MockStatisticsService_GetPRStatistics_Call {
+	return &MockStatisticsService_GetPRStatistics_Call{Call: _e.mock.On("GetPRStatistics", ctx, f)}
 }
 
-func (_c *MockStatisticsService_GetPRStatistics_Call) Run(run func(ctx context.Context)) *MockStatisticsService_GetPRStatistics_Call {
+func (_c *MockStatisticsService_GetPRStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter)) *MockStatisticsService_GetPRStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -95,14 +101,14 @@ func (_c *MockStatisticsService_GetPRStatistics_Call) Return(pRStatss []*model.P
 	return _c
 }
 
-func (_c *MockStatisticsService_GetPRStatistics_Call) RunAndReturn(run func(ctx context.Context) ([]*model.PRStats, error)) *MockStatisticsService_GetPRStatistics_Call {
+func (_c *MockStatisticsService_GetPRStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error)) *MockStatisticsService_GetPRStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetReviewerStatistics provides a mock function for the type MockStatisticsService
-func (_mock *MockStatisticsService) GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockStatisticsService) GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error) {
+	ret := _mock.Called(ctx, f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReviewerStatistics")
@@ -110,18 +116,18 @@ func (_mock *MockStatisticsService) GetReviewerStatistics(ctx context.Context) (
 
 	var r0 []*model.ReviewerStats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*model.ReviewerStats, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) ([]*model.ReviewerStats, error)); ok {
+		return returnFunc(ctx, f)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*model.ReviewerStats); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter) []*model.ReviewerStats); ok {
+		r0 = returnFunc(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.ReviewerStats)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.StatisticsFilter) error); ok {
+		r1 = returnFunc(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -135,18 +141,24 @@ type MockStatisticsService_GetReviewerStatistics_Call struct {
 
 // GetReviewerStatistics is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStatisticsService_Expecter) GetReviewerStatistics(ctx interface{}) *MockStatisticsService_GetReviewerStatistics_Call {
-	return &MockStatisticsService_GetReviewerStatistics_Call{Call: _e.mock.On("GetReviewerStatistics", ctx)}
+//   - f model.StatisticsFilter
+func (_e *MockStatisticsService_Expecter) GetReviewerStatistics(ctx interface{}, f interface{}) *MockStatisticsService_GetReviewerStatistics_Call {
+	return &MockStatisticsService_GetReviewerStatistics_Call{Call: _e.mock.On("GetReviewerStatistics", ctx, f)}
 }
 
-func (_c *MockStatisticsService_GetReviewerStatistics_Call) Run(run func(ctx context.Context)) *MockStatisticsService_GetReviewerStatistics_Call {
+func (_c *MockStatisticsService_GetReviewerStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter)) *MockStatisticsService_GetReviewerStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -157,7 +169,133 @@ func (_c *MockStatisticsService_GetReviewerStatistics_Call) Return(reviewerStats
 	return _c
 }
 
-func (_c *MockStatisticsService_GetReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context) ([]*model.ReviewerStats, error)) *MockStatisticsService_GetReviewerStatistics_Call {
+func (_c *MockStatisticsService_GetReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error)) *MockStatisticsService_GetReviewerStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPRStatistics provides a mock function for the type MockStatisticsService
+func (_mock *MockStatisticsService) StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error {
+	ret := _mock.Called(ctx, f, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPRStatistics")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter, func(*model.PRStats) error) error); ok {
+		r0 = returnFunc(ctx, f, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatisticsService_StreamPRStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPRStatistics'
+type MockStatisticsService_StreamPRStatistics_Call struct {
+	*mock.Call
+}
+
+// StreamPRStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f model.StatisticsFilter
+//   - fn func(*model.PRStats) error
+func (_e *MockStatisticsService_Expecter) StreamPRStatistics(ctx interface{}, f interface{}, fn interface{}) *MockStatisticsService_StreamPRStatistics_Call {
+	return &MockStatisticsService_StreamPRStatistics_Call{Call: _e.mock.On("StreamPRStatistics", ctx, f, fn)}
+}
+
+func (_c *MockStatisticsService_StreamPRStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error)) *MockStatisticsService_StreamPRStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
+		var arg2 func(*model.PRStats) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*model.PRStats) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatisticsService_StreamPRStatistics_Call) Return(err error) *MockStatisticsService_StreamPRStatistics_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatisticsService_StreamPRStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error) *MockStatisticsService_StreamPRStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamReviewerStatistics provides a mock function for the type MockStatisticsService
+func (_mock *MockStatisticsService) StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error {
+	ret := _mock.Called(ctx, f, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamReviewerStatistics")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.StatisticsFilter, func(*model.ReviewerStats) error) error); ok {
+		r0 = returnFunc(ctx, f, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatisticsService_StreamReviewerStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamReviewerStatistics'
+type MockStatisticsService_StreamReviewerStatistics_Call struct {
+	*mock.Call
+}
+
+// StreamReviewerStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f model.StatisticsFilter
+//   - fn func(*model.ReviewerStats) error
+func (_e *MockStatisticsService_Expecter) StreamReviewerStatistics(ctx interface{}, f interface{}, fn interface{}) *MockStatisticsService_StreamReviewerStatistics_Call {
+	return &MockStatisticsService_StreamReviewerStatistics_Call{Call: _e.mock.On("StreamReviewerStatistics", ctx, f, fn)}
+}
+
+func (_c *MockStatisticsService_StreamReviewerStatistics_Call) Run(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error)) *MockStatisticsService_StreamReviewerStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.StatisticsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.StatisticsFilter)
+		}
+		var arg2 func(*model.ReviewerStats) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*model.ReviewerStats) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatisticsService_StreamReviewerStatistics_Call) Return(err error) *MockStatisticsService_StreamReviewerStatistics_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatisticsService_StreamReviewerStatistics_Call) RunAndReturn(run func(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error) *MockStatisticsService_StreamReviewerStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
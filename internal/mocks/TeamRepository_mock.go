@@ -153,6 +153,131 @@ func (_c *MockTeamRepository_CreateTeam_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// CreateTeams provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) CreateTeams(ctx context.Context, names []string) error {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeams")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTeamRepository_CreateTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeams'
+type MockTeamRepository_CreateTeams_Call struct {
+	*mock.Call
+}
+
+// CreateTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockTeamRepository_Expecter) CreateTeams(ctx interface{}, names interface{}) *MockTeamRepository_CreateTeams_Call {
+	return &MockTeamRepository_CreateTeams_Call{Call: _e.mock.On("CreateTeams", ctx, names)}
+}
+
+func (_c *MockTeamRepository_CreateTeams_Call) Run(run func(ctx context.Context, names []string)) *MockTeamRepository_CreateTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_CreateTeams_Call) Return(err error) *MockTeamRepository_CreateTeams_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTeamRepository_CreateTeams_Call) RunAndReturn(run func(ctx context.Context, names []string) error) *MockTeamRepository_CreateTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExistingNames provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) GetExistingNames(ctx context.Context, names []string) ([]string, error) {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExistingNames")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(ctx, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTeamRepository_GetExistingNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExistingNames'
+type MockTeamRepository_GetExistingNames_Call struct {
+	*mock.Call
+}
+
+// GetExistingNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockTeamRepository_Expecter) GetExistingNames(ctx interface{}, names interface{}) *MockTeamRepository_GetExistingNames_Call {
+	return &MockTeamRepository_GetExistingNames_Call{Call: _e.mock.On("GetExistingNames", ctx, names)}
+}
+
+func (_c *MockTeamRepository_GetExistingNames_Call) Run(run func(ctx context.Context, names []string)) *MockTeamRepository_GetExistingNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_GetExistingNames_Call) Return(strings []string, err error) *MockTeamRepository_GetExistingNames_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockTeamRepository_GetExistingNames_Call) RunAndReturn(run func(ctx context.Context, names []string) ([]string, error)) *MockTeamRepository_GetExistingNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeamByName provides a mock function for the type MockTeamRepository
 func (_mock *MockTeamRepository) GetTeamByName(ctx context.Context, name string) (*model.Team, error) {
 	ret := _mock.Called(ctx, name)
@@ -162,3 +162,77 @@ func (_c *MockTeamService_GetTeamByName_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// Import provides a mock function for the type MockTeamService
+func (_mock *MockTeamService) Import(ctx context.Context, roster []*model.RosterEntry, dryRun bool) (*model.ImportPlan, error) {
+	ret := _mock.Called(ctx, roster, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *model.ImportPlan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.RosterEntry, bool) (*model.ImportPlan, error)); ok {
+		return returnFunc(ctx, roster, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.RosterEntry, bool) *model.ImportPlan); ok {
+		r0 = returnFunc(ctx, roster, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ImportPlan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*model.RosterEntry, bool) error); ok {
+		r1 = returnFunc(ctx, roster, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTeamService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockTeamService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roster []*model.RosterEntry
+//   - dryRun bool
+func (_e *MockTeamService_Expecter) Import(ctx interface{}, roster interface{}, dryRun interface{}) *MockTeamService_Import_Call {
+	return &MockTeamService_Import_Call{Call: _e.mock.On("Import", ctx, roster, dryRun)}
+}
+
+func (_c *MockTeamService_Import_Call) Run(run func(ctx context.Context, roster []*model.RosterEntry, dryRun bool)) *MockTeamService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.RosterEntry
+		if args[1] != nil {
+			arg1 = args[1].([]*model.RosterEntry)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamService_Import_Call) Return(importPlan *model.ImportPlan, err error) *MockTeamService_Import_Call {
+	_c.Call.Return(importPlan, err)
+	return _c
+}
+
+func (_c *MockTeamService_Import_Call) RunAndReturn(run func(ctx context.Context, roster []*model.RosterEntry, dryRun bool) (*model.ImportPlan, error)) *MockTeamService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
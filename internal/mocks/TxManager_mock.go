@@ -94,3 +94,117 @@ func (_c *MockTxManager_ReadCommited_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// RepeatableRead provides a mock function for the type MockTxManager
+func (_mock *MockTxManager) RepeatableRead(ctx context.Context, f db.Handler) error {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepeatableRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.Handler) error); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxManager_RepeatableRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepeatableRead'
+type MockTxManager_RepeatableRead_Call struct {
+	*mock.Call
+}
+
+// RepeatableRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f db.Handler
+func (_e *MockTxManager_Expecter) RepeatableRead(ctx interface{}, f interface{}) *MockTxManager_RepeatableRead_Call {
+	return &MockTxManager_RepeatableRead_Call{Call: _e.mock.On("RepeatableRead", ctx, f)}
+}
+
+func (_c *MockTxManager_RepeatableRead_Call) Run(run func(ctx context.Context, f db.Handler)) *MockTxManager_RepeatableRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.Handler
+		if args[1] != nil {
+			arg1 = args[1].(db.Handler)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxManager_RepeatableRead_Call) Return(err error) *MockTxManager_RepeatableRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxManager_RepeatableRead_Call) RunAndReturn(run func(ctx context.Context, f db.Handler) error) *MockTxManager_RepeatableRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Serializable provides a mock function for the type MockTxManager
+func (_mock *MockTxManager) Serializable(ctx context.Context, f db.Handler) error {
+	ret := _mock.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Serializable")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.Handler) error); ok {
+		r0 = returnFunc(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxManager_Serializable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Serializable'
+type MockTxManager_Serializable_Call struct {
+	*mock.Call
+}
+
+// Serializable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f db.Handler
+func (_e *MockTxManager_Expecter) Serializable(ctx interface{}, f interface{}) *MockTxManager_Serializable_Call {
+	return &MockTxManager_Serializable_Call{Call: _e.mock.On("Serializable", ctx, f)}
+}
+
+func (_c *MockTxManager_Serializable_Call) Run(run func(ctx context.Context, f db.Handler)) *MockTxManager_Serializable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.Handler
+		if args[1] != nil {
+			arg1 = args[1].(db.Handler)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxManager_Serializable_Call) Return(err error) *MockTxManager_Serializable_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxManager_Serializable_Call) RunAndReturn(run func(ctx context.Context, f db.Handler) error) *MockTxManager_Serializable_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleReader Role = "reader"
+)
+
+var roleLevel = map[Role]int{
+	RoleReader: 1,
+	RoleMember: 2,
+	RoleAdmin:  3,
+}
+
+func (r Role) Valid() bool {
+	_, ok := roleLevel[r]
+	return ok
+}
+
+// Allows проверяет, что роль не ниже требуемой: admin > member > reader
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleLevel[r] >= roleLevel[required]
+}
+
+type APIKey struct {
+	ID   uuid.UUID `json:"key_id"`
+	Name string    `json:"name"`
+	Role Role      `json:"role"`
+	// UserID — пользователь, от имени которого действует ключ; nil у сервисных ключей
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// APIKeyCreated возвращается один раз при создании: в базе хранится только хэш
+type APIKeyCreated struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type APIKeyCreateRequest struct {
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+	UserID string `json:"user_id"`
+}
+
+type APIKeyRevokeRequest struct {
+	ID string `json:"key_id"`
+}
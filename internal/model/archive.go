@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchiveVersion — версия формата архива; при несовместимых изменениях увеличивается
+const ArchiveVersion = 1
+
+// Archive — переносимая копия данных сервиса: команды, пользователи, PR,
+// текущие ревьюеры, история назначений, настройки SLA команд, рабочие графики
+// и праздники. Ключи API, аудит и ключи идемпотентности в архив не входят
+type Archive struct {
+	Version      int                   `json:"version"`
+	ExportedAt   time.Time             `json:"exported_at"`
+	Teams        []*ArchiveTeam        `json:"teams"`
+	Users        []*User               `json:"users"`
+	PullRequests []*ArchivePullRequest `json:"pull_requests"`
+	Reviewers    []*ArchiveReviewer    `json:"reviewers"`
+	Assignments  []*ReviewerAssignment `json:"assignments"`
+	// в архивах до появления SLA раздела нет
+	SLASettings []*TeamSLA `json:"sla_settings"`
+	// собственные графики пользователей, у которых они заданы
+	UserWorkingHours []*UserWorkingHours `json:"user_working_hours"`
+	Holidays         []*Holiday          `json:"holidays"`
+}
+
+// ArchiveTeam — без WorkingHours (архив до появления графиков) команда
+// восстанавливается с графиком по умолчанию
+type ArchiveTeam struct {
+	ID           uuid.UUID     `json:"id"`
+	TeamName     string        `json:"team_name"`
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// ArchivePullRequest — PR без ревьюеров; AuthorID пуст, если автор был удален
+type ArchivePullRequest struct {
+	ID        uuid.UUID  `json:"pull_request_id"`
+	Name      string     `json:"pull_request_name"`
+	AuthorID  *uuid.UUID `json:"author_id"`
+	Status    string     `json:"status"`
+	CreatedAt *time.Time `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+}
+
+type ArchiveReviewer struct {
+	PRID       uuid.UUID  `json:"pull_request_id"`
+	ReviewerID uuid.UUID  `json:"reviewer_id"`
+	AssignedAt *time.Time `json:"assigned_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// ArchiveCounts — число записей по разделам архива
+type ArchiveCounts struct {
+	Teams        int `json:"teams"`
+	Users        int `json:"users"`
+	PullRequests int `json:"pull_requests"`
+	Reviewers    int `json:"reviewers"`
+	Assignments  int `json:"assignments"`
+	SLASettings  int `json:"sla_settings"`
+	// UserWorkingHours — пользователи с собственным графиком
+	UserWorkingHours int `json:"user_working_hours"`
+	Holidays         int `json:"holidays"`
+}
+
+func (c *ArchiveCounts) Empty() bool {
+	return *c == ArchiveCounts{}
+}
+
+// ArchiveConflict — причина, по которой архив нельзя восстановить.
+// Entity — раздел архива, ID — запись в нем
+type ArchiveConflict struct {
+	Entity  string `json:"entity"`
+	ID      string `json:"id,omitempty"`
+	Message string `json:"message"`
+}
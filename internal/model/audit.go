@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditTeamCreate       = "team.create"
+	AuditTeamImport       = "team.import"
+	AuditUserSetActive    = "user.set_active"
+	AuditPRCreate         = "pr.create"
+	AuditPRMerge          = "pr.merge"
+	AuditPRReassign       = "pr.reassign"
+	AuditPRAddReviewer    = "pr.add_reviewer"
+	AuditPRRemoveReviewer = "pr.remove_reviewer"
+	AuditPRReview         = "pr.review"
+	AuditTeamSetSLA       = "team.set_sla"
+	AuditTeamSetHours     = "team.set_working_hours"
+	AuditUserSetHours     = "user.set_working_hours"
+	AuditHolidayAdd       = "holiday.add"
+	AuditHolidayRemove    = "holiday.remove"
+	AuditArchiveImport    = "archive.import"
+	AuditEntityTeam       = "team"
+	AuditEntityUser       = "user"
+	AuditEntityPR         = "pull_request"
+	AuditEntityArchive    = "archive"
+	AuditEntityHoliday    = "holiday"
+	AuditDefaultLimit     = 50
+	AuditMaxLimit         = 500
+)
+
+// AuditEvent — запись о мутирующем действии. Before/After — состояние
+// сущности до и после в JSON, nil если сущности не было
+type AuditEvent struct {
+	ID          uuid.UUID       `json:"event_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Action      string          `json:"action"`
+	EntityType  string          `json:"entity_type"`
+	EntityID    string          `json:"entity_id"`
+	ActorName   string          `json:"actor_name,omitempty"`
+	ActorRole   string          `json:"actor_role,omitempty"`
+	ActorUserID *uuid.UUID      `json:"actor_user_id,omitempty"`
+	ActorKeyID  *uuid.UUID      `json:"actor_key_id,omitempty"`
+	RequestID   string          `json:"request_id,omitempty"`
+	Before      json.RawMessage `json:"before,omitempty"`
+	After       json.RawMessage `json:"after,omitempty"`
+}
+
+type AuditFilter struct {
+	Action      string
+	EntityType  string
+	EntityID    string
+	ActorUserID *uuid.UUID
+	From        *time.Time
+	To          *time.Time
+	Limit       int
+	Offset      int
+}
+
+type AuditQuery struct {
+	Action      string `form:"action"`
+	EntityType  string `form:"entity_type"`
+	EntityID    string `form:"entity_id"`
+	ActorUserID string `form:"actor_user_id"`
+	From        string `form:"from"`
+	To          string `form:"to"`
+	Limit       int    `form:"limit"`
+	Offset      int    `form:"offset"`
+}
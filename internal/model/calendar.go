@@ -0,0 +1,87 @@
+package model
+
+import "github.com/google/uuid"
+
+// Рабочее время команд по умолчанию, как в миграции
+const (
+	DefaultTimezone  = "UTC"
+	DefaultWorkStart = "09:00"
+	DefaultWorkEnd   = "18:00"
+)
+
+// WorkingHours — рабочий день с WorkStart до WorkEnd ("HH:MM") по местному
+// времени Timezone (IANA) с понедельника по пятницу. В нем считаются сроки SLA
+// и возраст назначений
+type WorkingHours struct {
+	Timezone  string `json:"timezone"`
+	WorkStart string `json:"work_start"`
+	WorkEnd   string `json:"work_end"`
+}
+
+// Or заполняет пустые поля значениями def
+func (w WorkingHours) Or(def WorkingHours) WorkingHours {
+	if w.Timezone == "" {
+		w.Timezone = def.Timezone
+	}
+	if w.WorkStart == "" {
+		w.WorkStart = def.WorkStart
+	}
+	if w.WorkEnd == "" {
+		w.WorkEnd = def.WorkEnd
+	}
+	return w
+}
+
+func DefaultWorkingHours() WorkingHours {
+	return WorkingHours{Timezone: DefaultTimezone, WorkStart: DefaultWorkStart, WorkEnd: DefaultWorkEnd}
+}
+
+type TeamWorkingHours struct {
+	TeamName     string       `json:"team_name"`
+	WorkingHours WorkingHours `json:"working_hours"`
+}
+
+// UserWorkingHours — собственный график пользователя; пустые поля берутся у команды
+type UserWorkingHours struct {
+	UserID uuid.UUID `json:"user_id"`
+	WorkingHours
+}
+
+// UserSchedule — график пользователя: Override задан для него самого,
+// WorkingHours — итоговый с учетом команды
+type UserSchedule struct {
+	UserID       uuid.UUID    `json:"user_id"`
+	TeamName     string       `json:"team_name"`
+	Override     WorkingHours `json:"override"`
+	WorkingHours WorkingHours `json:"working_hours"`
+}
+
+// Holiday — нерабочий день "YYYY-MM-DD" по местному времени. Без TeamName
+// действует для всех команд
+type Holiday struct {
+	Date     string `json:"date"`
+	TeamName string `json:"team_name,omitempty"`
+	Name     string `json:"name"`
+}
+
+// HolidayFilter — From и To включительно, пустые не ограничивают. С TeamName
+// в выборку входят праздники этой команды и общие
+type HolidayFilter struct {
+	TeamName string
+	From, To string
+}
+
+type TeamWorkingHoursRequest struct {
+	TeamName string `json:"team_name"`
+	WorkingHours
+}
+
+type UserWorkingHoursRequest struct {
+	UserID string `json:"user_id"`
+	WorkingHours
+}
+
+type HolidayRemoveRequest struct {
+	Date     string `json:"date"`
+	TeamName string `json:"team_name"`
+}
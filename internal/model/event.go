@@ -0,0 +1,63 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventPRCreated           = "pr.created"
+	EventPRMerged            = "pr.merged"
+	EventReviewerAssigned    = "pr.reviewer_assigned"
+	EventReviewerReassigned  = "pr.reviewer_reassigned"
+	EventReviewerRemoved     = "pr.reviewer_removed"
+	EventPRReviewed          = "pr.reviewed"
+	EventReviewReminder      = "pr.review_reminder"
+	EventUserActivityChanged = "user.activity_changed"
+)
+
+// Event — изменение, о котором сообщается подписчикам потока событий.
+// ID назначает шина. TeamName и UserIDs нужны только для фильтрации
+// подписок: команда известна не для всех событий, поэтому подписка на
+// команду сверяется и со списком затронутых пользователей
+type Event struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	TeamName   string      `json:"team_name,omitempty"`
+	UserIDs    []uuid.UUID `json:"user_ids"`
+	Data       any         `json:"data"`
+}
+
+// PREventData — данные событий PR; ReviewerID — назначенный или снятый
+// ревьюер, ReplacedID — ревьюер, которого заменили при переназначении
+type PREventData struct {
+	PullRequest *PullRequest `json:"pr"`
+	ReviewerID  *uuid.UUID   `json:"reviewer_id,omitempty"`
+	ReplacedID  *uuid.UUID   `json:"replaced_id,omitempty"`
+}
+
+// EventFilter — пустые поля не ограничивают подписку. TeamMembers —
+// состав команды TeamName на момент подписки
+type EventFilter struct {
+	TeamName    string
+	TeamMembers []uuid.UUID
+	UserID      *uuid.UUID
+}
+
+func (f *EventFilter) Match(e *Event) bool {
+	if f.UserID != nil && !slices.Contains(e.UserIDs, *f.UserID) {
+		return false
+	}
+	if f.TeamName == "" || e.TeamName == f.TeamName {
+		return true
+	}
+	for _, id := range e.UserIDs {
+		if slices.Contains(f.TeamMembers, id) {
+			return true
+		}
+	}
+	return false
+}
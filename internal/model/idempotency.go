@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// IdempotencyRecord — сохраненный ответ на запрос с заголовком Idempotency-Key.
+// StatusCode == 0, пока первый запрос еще выполняется
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
@@ -9,6 +9,13 @@ import (
 type PullRequestInReassign struct {
 	PrID          string `json:"pull_request_id"`
 	OldReviewerID string `json:"old_reviewer_id"`
+	// если не задан, замена выбирается случайно из команды старого ревьюера
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type PullRequestInReviewer struct {
+	PrID       string `json:"pull_request_id"`
+	ReviewerID string `json:"reviewer_id"`
 }
 
 type PullRequestInMerge struct {
@@ -31,9 +38,35 @@ type PullRequest struct {
 	MergedAt          *time.Time  `json:"mergedAt"`
 }
 
+// PullRequestShort — PR без ревьюеров. В списке PR ревьюера AssignedAt —
+// время его назначения, а AgeHours — сколько рабочих часов ревьюера прошло
+// с назначения по открытому PR
 type PullRequestShort struct {
-	ID       uuid.UUID `json:"pull_request_id"`
-	Name     string    `json:"pull_request_name"`
-	AuthorID uuid.UUID `json:"author_id"`
-	Status   string    `json:"status"`
+	ID         uuid.UUID  `json:"pull_request_id"`
+	Name       string     `json:"pull_request_name"`
+	AuthorID   uuid.UUID  `json:"author_id"`
+	Status     string     `json:"status"`
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	AgeHours   *float64   `json:"age_hours,omitempty"`
+}
+
+const (
+	AssignmentInitial      = "initial"
+	AssignmentReassign     = "reassign"
+	AssignmentDeactivation = "deactivation"
+	AssignmentManual       = "manual"
+	// ревьюер заменен автоматически, потому что не оставил ревью вовремя
+	AssignmentTimeout = "timeout"
+)
+
+// ReviewerAssignment — запись истории назначений. Reason объясняет, почему
+// ревьюер был назначен; UnassignedAt задан, если назначение уже снято
+type ReviewerAssignment struct {
+	PRID         uuid.UUID  `json:"pull_request_id"`
+	ReviewerID   uuid.UUID  `json:"reviewer_id"`
+	AssignedAt   time.Time  `json:"assigned_at"`
+	UnassignedAt *time.Time `json:"unassigned_at,omitempty"`
+	Reason       string     `json:"reason"`
+	ActorName    string     `json:"actor_name,omitempty"`
+	ActorUserID  *uuid.UUID `json:"actor_user_id,omitempty"`
 }
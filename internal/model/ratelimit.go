@@ -0,0 +1,30 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit — токен-бакет: Burst запросов подряд, дальше Rate запросов в секунду
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RefillTime — за сколько пустой бакет наполняется полностью
+func (l RateLimit) RefillTime() time.Duration {
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// RetryAfter — через сколько в бакете появится целый токен
+func (l RateLimit) RetryAfter(tokens float64) time.Duration {
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - tokens) / l.Rate * float64(time.Second)))
+}
+
+type RateDecision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
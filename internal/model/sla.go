@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamSLA — срок первого ревью для ревьюеров команды. ReminderIntervalHours —
+// через сколько часов напоминать повторно, 0 — один раз. WebhookURL получает
+// напоминания POST запросом, если задан. Если ревью нет AutoReassignHours
+// часов, ревьюер заменяется автоматически, но не больше MaxAutoReassigns раз на PR
+type TeamSLA struct {
+	TeamName              string    `json:"team_name"`
+	FirstReviewHours      int       `json:"first_review_hours"`
+	ReminderIntervalHours int       `json:"reminder_interval_hours"`
+	WebhookURL            string    `json:"webhook_url,omitempty"`
+	AutoReassignHours     int       `json:"auto_reassign_hours"`
+	MaxAutoReassigns      int       `json:"max_auto_reassigns"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+func (s *TeamSLA) FirstReview() time.Duration {
+	return time.Duration(s.FirstReviewHours) * time.Hour
+}
+
+// StaleReview — назначение без ревью дольше, чем допускает политика команды
+// ревьюера. AutoReassigns — сколько раз PR уже переназначался по таймауту
+type StaleReview struct {
+	PRID              uuid.UUID `json:"pull_request_id"`
+	ReviewerID        uuid.UUID `json:"reviewer_id"`
+	TeamName          string    `json:"team_name"`
+	AssignedAt        time.Time `json:"assigned_at"`
+	AutoReassignHours int       `json:"auto_reassign_hours"`
+	AutoReassigns     int       `json:"auto_reassigns"`
+}
+
+// AutoReassign — через сколько рабочих часов без ревью ревьюер заменяется
+func (r *StaleReview) AutoReassign() time.Duration {
+	return time.Duration(r.AutoReassignHours) * time.Hour
+}
+
+// PRReview — отметка ревьюера о том, что ревью оставлено
+type PRReview struct {
+	PRID       uuid.UUID `json:"pull_request_id"`
+	ReviewerID uuid.UUID `json:"reviewer_id"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+}
+
+// SLAReminder — назначение без ревью, у которого истек срок SLA.
+// Уходит в поток событий и на webhook команды ревьюера. Срок DueAt
+// отсчитывается в рабочих часах ревьюера
+type SLAReminder struct {
+	PRID             uuid.UUID `json:"pull_request_id"`
+	PRName           string    `json:"pull_request_name"`
+	AuthorID         uuid.UUID `json:"author_id"`
+	ReviewerID       uuid.UUID `json:"reviewer_id"`
+	ReviewerName     string    `json:"reviewer_name"`
+	TeamName         string    `json:"team_name"`
+	FirstReviewHours int       `json:"first_review_hours"`
+	AssignedAt       time.Time `json:"assigned_at"`
+	DueAt            time.Time `json:"due_at"`
+	// RemindedAt — время предыдущего напоминания
+	RemindedAt            *time.Time `json:"reminded_at,omitempty"`
+	ReminderIntervalHours int        `json:"-"`
+	WebhookURL            string     `json:"-"`
+}
+
+func (r *SLAReminder) FirstReview() time.Duration {
+	return time.Duration(r.FirstReviewHours) * time.Hour
+}
+
+// ReminderInterval — через сколько напоминать повторно; 0 — не напоминать
+func (r *SLAReminder) ReminderInterval() time.Duration {
+	return time.Duration(r.ReminderIntervalHours) * time.Hour
+}
+
+// SLAAssignment — назначение ревьюера команды с SLA для статистики.
+// AnsweredAt — время ревью, а без него merge PR
+type SLAAssignment struct {
+	TeamName   string
+	ReviewerID uuid.UUID
+	AssignedAt time.Time
+	AnsweredAt *time.Time
+}
+
+// SLAStats — соблюдение SLA ревьюерами команды по текущим назначениям.
+// Ответом считается ревью, а без него — merge PR. Pending — ответа нет,
+// но срок еще не истек; такие назначения не входят в BreachRate
+type SLAStats struct {
+	TeamName         string  `json:"team_name"`
+	FirstReviewHours int     `json:"first_review_hours"`
+	Total            int     `json:"total"`
+	OnTime           int     `json:"on_time"`
+	Late             int     `json:"late"`
+	Overdue          int     `json:"overdue"`
+	Pending          int     `json:"pending"`
+	BreachRate       float64 `json:"breach_rate"`
+}
+
+// Breaches — ответ после срока или срок истек без ответа
+func (s *SLAStats) Breaches() int {
+	return s.Late + s.Overdue
+}
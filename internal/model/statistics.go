@@ -14,3 +14,9 @@ type ReviewerStats struct {
 	ReviewerName  string    `json:"reviewer_name" db:"reviewer_name"`
 	AssignedCount int       `json:"assigned_count" db:"assigned_count"`
 }
+
+// StatisticsFilter: IncludeHistory считает все назначения из истории,
+// включая снятые, а не только текущие
+type StatisticsFilter struct {
+	IncludeHistory bool
+}
@@ -23,3 +23,34 @@ type Team struct {
 	TeamName string        `json:"team_name"`
 	Members  []*TeamMember `json:"members"`
 }
+
+// RosterEntry — строка списка сотрудников для импорта: пользователь и его команда
+type RosterEntry struct {
+	TeamName string    `json:"team_name"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	IsActive bool      `json:"is_active"`
+}
+
+// ImportPlan — изменения, которые вносит импорт списка сотрудников. Пользователи
+// команд из списка, которых в нем нет, деактивируются
+type ImportPlan struct {
+	DryRun           bool        `json:"dry_run"`
+	TeamsCreated     []string    `json:"teams_created"`
+	UsersCreated     []*User     `json:"users_created"`
+	UsersMoved       []*UserMove `json:"users_moved"`
+	UsersUpdated     []*User     `json:"users_updated"`
+	UsersDeactivated []*User     `json:"users_deactivated"`
+	Unchanged        int         `json:"unchanged"`
+}
+
+// UserMove — пользователь, переведенный из команды FromTeam; новое состояние в User
+type UserMove struct {
+	User
+	FromTeam string `json:"from_team"`
+}
+
+func (p *ImportPlan) Empty() bool {
+	return len(p.TeamsCreated) == 0 && len(p.UsersCreated) == 0 && len(p.UsersMoved) == 0 &&
+		len(p.UsersUpdated) == 0 && len(p.UsersDeactivated) == 0
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"PR/internal/model"
+)
+
+// Config — лимиты по маршрутам вида "POST /pullRequest/reassign".
+// Default применяется к маршрутам без своего лимита, nil — без ограничений.
+// IP — общий лимит на адрес до авторизации, nil — без ограничений
+type Config struct {
+	Default *model.RateLimit
+	Routes  map[string]model.RateLimit
+	IP      *model.RateLimit
+}
+
+// For возвращает лимит для маршрута
+func (c *Config) For(route string) (model.RateLimit, bool) {
+	if l, ok := c.Routes[route]; ok {
+		return l, true
+	}
+	if c.Default != nil {
+		return *c.Default, true
+	}
+	return model.RateLimit{}, false
+}
+
+func (c *Config) Empty() bool {
+	return c.Default == nil && len(c.Routes) == 0 && c.IP == nil
+}
+
+// MaxRefillTime — после такого простоя любой бакет гарантированно полон и его можно забыть
+func (c *Config) MaxRefillTime() time.Duration {
+	var d time.Duration
+	if c.Default != nil {
+		d = c.Default.RefillTime()
+	}
+	for _, l := range c.Routes {
+		d = max(d, l.RefillTime())
+	}
+	if c.IP != nil {
+		d = max(d, c.IP.RefillTime())
+	}
+	return d
+}
+
+// ParseConfig разбирает лимит по умолчанию ("100/1m") и список маршрутов
+// через ";" ("POST /pullRequest/reassign=10/1m;POST /pullRequest/create=30/1m")
+func ParseConfig(def, routes string) (*Config, error) {
+	c := &Config{Routes: make(map[string]model.RateLimit)}
+
+	if def = strings.TrimSpace(def); def != "" {
+		l, err := ParseLimit(def)
+		if err != nil {
+			return nil, fmt.Errorf("default limit: %w", err)
+		}
+		c.Default = &l
+	}
+
+	for _, item := range strings.Split(routes, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		route, raw, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("route limit %q: expected \"METHOD /path=N/period\"", item)
+		}
+		method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok || method == "" || !strings.HasPrefix(strings.TrimSpace(path), "/") {
+			return nil, fmt.Errorf("route limit %q: expected \"METHOD /path\"", item)
+		}
+		l, err := ParseLimit(raw)
+		if err != nil {
+			return nil, fmt.Errorf("route limit %q: %w", item, err)
+		}
+		c.Routes[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = l
+	}
+	return c, nil
+}
+
+// ParseLimit разбирает "N/period": N запросов подряд и N запросов за period в среднем
+func ParseLimit(s string) (model.RateLimit, error) {
+	rawN, rawPeriod, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return model.RateLimit{}, fmt.Errorf("limit %q: expected \"N/period\"", s)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(rawN))
+	if err != nil || n < 1 {
+		return model.RateLimit{}, fmt.Errorf("limit %q: invalid number of requests", s)
+	}
+
+	period, err := time.ParseDuration(strings.TrimSpace(rawPeriod))
+	if err != nil || period <= 0 {
+		return model.RateLimit{}, fmt.Errorf("limit %q: invalid period", s)
+	}
+
+	return model.RateLimit{
+		Rate:  float64(n) / period.Seconds(),
+		Burst: n,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"PR/internal/model"
+)
+
+// sweepInterval — как часто из памяти удаляются полные бакеты
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   model.RateLimit
+}
+
+// Memory — лимитер в памяти процесса. Подходит для одной реплики:
+// у каждой реплики свои бакеты
+type Memory struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Take(_ context.Context, key string, l model.RateLimit) (*model.RateDecision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Burst), updated: now}
+		m.buckets[key] = b
+	}
+	b.limit = l
+	b.tokens = refill(b.tokens, now.Sub(b.updated), l)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return &model.RateDecision{RetryAfter: l.RetryAfter(b.tokens)}, nil
+	}
+	b.tokens--
+	return &model.RateDecision{Allowed: true}, nil
+}
+
+// sweep удаляет бакеты, которые успели наполниться: они не отличаются от новых
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	for key, b := range m.buckets {
+		if refill(b.tokens, now.Sub(b.updated), b.limit) >= float64(b.limit.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+func refill(tokens float64, elapsed time.Duration, l model.RateLimit) float64 {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * l.Rate
+	}
+	return min(tokens, float64(l.Burst))
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/model"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected model.RateLimit
+		wantErr  bool
+	}{
+		{name: "в минуту", input: "60/1m", expected: model.RateLimit{Rate: 1, Burst: 60}},
+		{name: "в секунду с пробелами", input: " 5 / 1s ", expected: model.RateLimit{Rate: 5, Burst: 5}},
+		{name: "без периода", input: "60", wantErr: true},
+		{name: "ноль запросов", input: "0/1m", wantErr: true},
+		{name: "неверный период", input: "10/minute", wantErr: true},
+		{name: "отрицательный период", input: "10/-1s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := ParseLimit(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, l)
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	c, err := ParseConfig("100/1m", "post /pullRequest/reassign=10/1m; GET /statistics/prs=1/1s;")
+	require.NoError(t, err)
+
+	l, ok := c.For("POST /pullRequest/reassign")
+	assert.True(t, ok)
+	assert.Equal(t, 10, l.Burst)
+
+	l, ok = c.For("GET /team/get")
+	assert.True(t, ok)
+	assert.Equal(t, 100, l.Burst)
+
+	assert.Equal(t, time.Minute, c.MaxRefillTime())
+
+	empty, err := ParseConfig("", "")
+	require.NoError(t, err)
+	assert.True(t, empty.Empty())
+	_, ok = empty.For("POST /pullRequest/reassign")
+	assert.False(t, ok)
+
+	_, err = ParseConfig("", "POST /pullRequest/reassign")
+	assert.Error(t, err)
+	_, err = ParseConfig("", "/pullRequest/reassign=10/1m")
+	assert.Error(t, err)
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	limit := model.RateLimit{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		d, err := m.Take(ctx, "client", limit)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+	}
+
+	d, err := m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, time.Second, d.RetryAfter)
+
+	// у другого клиента свой бакет
+	d, err = m.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	now = now.Add(500 * time.Millisecond)
+	d, err = m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, 500*time.Millisecond, d.RetryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	d, err = m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	// за время простоя бакеты наполнились и удаляются при очистке
+	now = now.Add(time.Hour)
+	_, err = m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.Len(t, m.buckets, 1)
+}
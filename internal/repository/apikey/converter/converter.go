@@ -0,0 +1,25 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/apikey/model"
+)
+
+func FromRepo(k *repoModel.APIKey) *serviceModel.APIKey {
+	return &serviceModel.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Role:      serviceModel.Role(k.Role),
+		UserID:    k.UserID,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+func FromRepoList(keys []*repoModel.APIKey) []*serviceModel.APIKey {
+	serviceKeys := make([]*serviceModel.APIKey, 0, len(keys))
+	for _, k := range keys {
+		serviceKeys = append(serviceKeys, FromRepo(k))
+	}
+	return serviceKeys
+}
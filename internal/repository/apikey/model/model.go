@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type APIKey struct {
+	ID        uuid.UUID  `db:"id"`
+	Name      string     `db:"name"`
+	KeyHash   string     `db:"key_hash"`
+	Role      string     `db:"role"`
+	UserID    *uuid.UUID `db:"user_id"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
@@ -0,0 +1,78 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/apikey/converter"
+	repoModel "PR/internal/repository/apikey/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.APIKeyRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, key *serviceModel.APIKey, hash string) error {
+	query := `INSERT INTO api_keys(id, name, key_hash, role, user_id, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6)`
+
+	args := []any{key.ID, key.Name, hash, string(key.Role), key.UserID, key.CreatedAt}
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "apikey.Create", QueryRaw: query, Redact: true}, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *repo) GetByHash(ctx context.Context, hash string) (*serviceModel.APIKey, error) {
+	query := `SELECT id, name, key_hash, role, user_id, created_at, revoked_at
+				FROM api_keys
+				WHERE key_hash = $1`
+
+	var k repoModel.APIKey
+	err := r.db.DB().ScanOneContext(ctx, &k, db.Query{Name: "apikey.GetByHash", QueryRaw: query, Redact: true}, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepo(&k), nil
+}
+
+func (r *repo) List(ctx context.Context) ([]*serviceModel.APIKey, error) {
+	query := `SELECT id, name, key_hash, role, user_id, created_at, revoked_at
+				FROM api_keys
+				ORDER BY created_at`
+
+	var keys []*repoModel.APIKey
+	err := r.db.DB().ScanAllContext(ctx, &keys, db.Query{Name: "apikey.List", QueryRaw: query})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoList(keys), nil
+}
+
+func (r *repo) Revoke(ctx context.Context, id uuid.UUID) (*serviceModel.APIKey, error) {
+	query := `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1
+		RETURNING id, name, key_hash, role, user_id, created_at, revoked_at
+	`
+
+	var k repoModel.APIKey
+	err := r.db.DB().ScanOneContext(ctx, &k, db.Query{Name: "apikey.Revoke", QueryRaw: query}, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepo(&k), nil
+}
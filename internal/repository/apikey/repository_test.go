@@ -0,0 +1,140 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type APIKeyRepositoryTestSuite struct {
+	suite.Suite
+	backend *testingpkg.Backend
+	repo    repository.APIKeyRepository
+}
+
+func TestAPIKeyRepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &APIKeyRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
+}
+
+func newTestRepo(b *testingpkg.Backend) repository.APIKeyRepository {
+	if b.Store != nil {
+		return memory.NewAPIKeyRepository(b.Store)
+	}
+	return &repo{db: b.Client}
+}
+
+func (s *APIKeyRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+}
+
+func (s *APIKeyRepositoryTestSuite) createKey(name string, role model.Role, hash string) *model.APIKey {
+	key := &model.APIKey{
+		ID:        uuid.New(),
+		Name:      name,
+		Role:      role,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	require.NoError(s.T(), s.repo.Create(context.Background(), key, hash))
+	return key
+}
+
+func (s *APIKeyRepositoryTestSuite) TestCreate_GetByHash() {
+	created := s.createKey("ci-bot", model.RoleMember, "hash-1")
+
+	key, err := s.repo.GetByHash(context.Background(), "hash-1")
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), created.ID, key.ID)
+	assert.Equal(s.T(), "ci-bot", key.Name)
+	assert.Equal(s.T(), model.RoleMember, key.Role)
+	assert.Nil(s.T(), key.RevokedAt)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestCreate_BoundToUser() {
+	s.backend.CreateTeam(s.T(), "backend")
+	userID := s.backend.CreateUser(s.T(), "alice", "backend", true)
+
+	err := s.repo.Create(context.Background(), &model.APIKey{
+		ID:        uuid.New(),
+		Name:      "alice-cli",
+		Role:      model.RoleMember,
+		UserID:    &userID,
+		CreatedAt: time.Now().UTC(),
+	}, "hash-user")
+	require.NoError(s.T(), err)
+
+	key, err := s.repo.GetByHash(context.Background(), "hash-user")
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), key.UserID)
+	assert.Equal(s.T(), userID, *key.UserID)
+
+	// ключ неизвестного пользователя нарушает внешний ключ
+	ghost := uuid.New()
+	err = s.repo.Create(context.Background(), &model.APIKey{
+		ID:        uuid.New(),
+		Name:      "ghost-cli",
+		Role:      model.RoleMember,
+		UserID:    &ghost,
+		CreatedAt: time.Now().UTC(),
+	}, "hash-ghost")
+	var pgErr *pgconn.PgError
+	require.ErrorAs(s.T(), err, &pgErr)
+	assert.Equal(s.T(), "23503", pgErr.Code)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestCreate_DuplicateHash() {
+	s.createKey("first", model.RoleReader, "same-hash")
+
+	err := s.repo.Create(context.Background(), &model.APIKey{
+		ID:        uuid.New(),
+		Name:      "second",
+		Role:      model.RoleReader,
+		CreatedAt: time.Now(),
+	}, "same-hash")
+	assert.Error(s.T(), err)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestGetByHash_NotFound() {
+	_, err := s.repo.GetByHash(context.Background(), "missing")
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestRevoke() {
+	created := s.createKey("dashboard", model.RoleReader, "hash-2")
+
+	revoked, err := s.repo.Revoke(context.Background(), created.ID)
+	require.NoError(s.T(), err)
+	assert.NotNil(s.T(), revoked.RevokedAt)
+
+	key, err := s.repo.GetByHash(context.Background(), "hash-2")
+	require.NoError(s.T(), err)
+	assert.NotNil(s.T(), key.RevokedAt)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestRevoke_NotFound() {
+	_, err := s.repo.Revoke(context.Background(), uuid.New())
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+func (s *APIKeyRepositoryTestSuite) TestList() {
+	s.createKey("a", model.RoleAdmin, "hash-a")
+	s.createKey("b", model.RoleReader, "hash-b")
+
+	keys, err := s.repo.List(context.Background())
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), keys, 2)
+}
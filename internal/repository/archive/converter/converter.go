@@ -0,0 +1,143 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/archive/model"
+)
+
+func FromRepoTeams(list []*repoModel.Team) []*serviceModel.ArchiveTeam {
+	res := make([]*serviceModel.ArchiveTeam, 0, len(list))
+	for _, t := range list {
+		res = append(res, &serviceModel.ArchiveTeam{
+			ID:       t.ID,
+			TeamName: t.TeamName,
+			WorkingHours: &serviceModel.WorkingHours{
+				Timezone:  t.Timezone,
+				WorkStart: t.WorkStart,
+				WorkEnd:   t.WorkEnd,
+			},
+		})
+	}
+	return res
+}
+
+func FromRepoUsers(list []*repoModel.User) []*serviceModel.User {
+	res := make([]*serviceModel.User, 0, len(list))
+	for _, u := range list {
+		res = append(res, &serviceModel.User{
+			ID:       u.ID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		})
+	}
+	return res
+}
+
+func FromRepoPullRequests(list []*repoModel.PullRequest) []*serviceModel.ArchivePullRequest {
+	res := make([]*serviceModel.ArchivePullRequest, 0, len(list))
+	for _, pr := range list {
+		res = append(res, &serviceModel.ArchivePullRequest{
+			ID:        pr.ID,
+			Name:      pr.Name,
+			AuthorID:  pr.AuthorID,
+			Status:    pr.Status,
+			CreatedAt: pr.CreatedAt,
+			MergedAt:  pr.MergedAt,
+		})
+	}
+	return res
+}
+
+func FromRepoReviewers(list []*repoModel.Reviewer) []*serviceModel.ArchiveReviewer {
+	res := make([]*serviceModel.ArchiveReviewer, 0, len(list))
+	for _, r := range list {
+		res = append(res, &serviceModel.ArchiveReviewer{
+			PRID:       r.PRID,
+			ReviewerID: r.ReviewerID,
+			AssignedAt: r.AssignedAt,
+			ReviewedAt: r.ReviewedAt,
+		})
+	}
+	return res
+}
+
+func FromRepoAssignments(list []*repoModel.Assignment) []*serviceModel.ReviewerAssignment {
+	res := make([]*serviceModel.ReviewerAssignment, 0, len(list))
+	for _, a := range list {
+		e := &serviceModel.ReviewerAssignment{
+			PRID:         a.PRID,
+			ReviewerID:   a.ReviewerID,
+			AssignedAt:   a.AssignedAt,
+			UnassignedAt: a.UnassignedAt,
+			Reason:       a.Reason,
+			ActorUserID:  a.ActorUserID,
+		}
+		if a.ActorName != nil {
+			e.ActorName = *a.ActorName
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func FromRepoSLASettings(list []*repoModel.TeamSLA) []*serviceModel.TeamSLA {
+	res := make([]*serviceModel.TeamSLA, 0, len(list))
+	for _, s := range list {
+		e := &serviceModel.TeamSLA{
+			TeamName:              s.TeamName,
+			FirstReviewHours:      s.FirstReviewHours,
+			ReminderIntervalHours: s.ReminderIntervalHours,
+			AutoReassignHours:     s.AutoReassignHours,
+			MaxAutoReassigns:      s.MaxAutoReassigns,
+			UpdatedAt:             s.UpdatedAt,
+		}
+		if s.WebhookURL != nil {
+			e.WebhookURL = *s.WebhookURL
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func FromRepoUserWorkingHours(list []*repoModel.UserWorkingHours) []*serviceModel.UserWorkingHours {
+	res := make([]*serviceModel.UserWorkingHours, 0, len(list))
+	for _, u := range list {
+		res = append(res, &serviceModel.UserWorkingHours{
+			UserID: u.UserID,
+			WorkingHours: serviceModel.WorkingHours{
+				Timezone:  fromNullable(u.Timezone),
+				WorkStart: fromNullable(u.WorkStart),
+				WorkEnd:   fromNullable(u.WorkEnd),
+			},
+		})
+	}
+	return res
+}
+
+func FromRepoHolidays(list []*repoModel.Holiday) []*serviceModel.Holiday {
+	res := make([]*serviceModel.Holiday, 0, len(list))
+	for _, h := range list {
+		res = append(res, &serviceModel.Holiday{
+			Date:     h.Day,
+			TeamName: fromNullable(h.TeamName),
+			Name:     h.Name,
+		})
+	}
+	return res
+}
+
+// ToRepoNullable — пустая строка хранится как NULL
+func ToRepoNullable(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fromNullable(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Team struct {
+	ID        uuid.UUID `db:"id"`
+	TeamName  string    `db:"team_name"`
+	Timezone  string    `db:"timezone"`
+	WorkStart string    `db:"work_start"`
+	WorkEnd   string    `db:"work_end"`
+}
+
+type User struct {
+	ID       uuid.UUID `db:"id"`
+	Username string    `db:"username"`
+	TeamName string    `db:"team_name"`
+	IsActive bool      `db:"is_active"`
+}
+
+type PullRequest struct {
+	ID        uuid.UUID  `db:"id"`
+	Name      string     `db:"name"`
+	AuthorID  *uuid.UUID `db:"author_id"`
+	Status    string     `db:"status"`
+	CreatedAt *time.Time `db:"created_at"`
+	MergedAt  *time.Time `db:"merged_at"`
+}
+
+type Reviewer struct {
+	PRID       uuid.UUID  `db:"pr_id"`
+	ReviewerID uuid.UUID  `db:"reviewer_id"`
+	AssignedAt *time.Time `db:"assigned_at"`
+	ReviewedAt *time.Time `db:"reviewed_at"`
+}
+
+type Assignment struct {
+	PRID         uuid.UUID  `db:"pr_id"`
+	ReviewerID   uuid.UUID  `db:"reviewer_id"`
+	AssignedAt   time.Time  `db:"assigned_at"`
+	UnassignedAt *time.Time `db:"unassigned_at"`
+	Reason       string     `db:"reason"`
+	ActorName    *string    `db:"actor_name"`
+	ActorUserID  *uuid.UUID `db:"actor_user_id"`
+}
+
+type TeamSLA struct {
+	TeamName              string    `db:"team_name"`
+	FirstReviewHours      int       `db:"first_review_hours"`
+	ReminderIntervalHours int       `db:"reminder_interval_hours"`
+	WebhookURL            *string   `db:"webhook_url"`
+	AutoReassignHours     int       `db:"auto_reassign_hours"`
+	MaxAutoReassigns      int       `db:"max_auto_reassigns"`
+	UpdatedAt             time.Time `db:"updated_at"`
+}
+
+type UserWorkingHours struct {
+	UserID    uuid.UUID `db:"user_id"`
+	Timezone  *string   `db:"timezone"`
+	WorkStart *string   `db:"work_start"`
+	WorkEnd   *string   `db:"work_end"`
+}
+
+type Holiday struct {
+	Day      string  `db:"day"`
+	TeamName *string `db:"team_name"`
+	Name     string  `db:"name"`
+}
@@ -0,0 +1,196 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/archive/converter"
+	repoModel "PR/internal/repository/archive/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.ArchiveRepository {
+	return &repo{db: db}
+}
+
+// Export читает все разделы архива; согласованный снимок дает транзакция вызывающего
+func (r *repo) Export(ctx context.Context) (*serviceModel.Archive, error) {
+	var teams []*repoModel.Team
+	query := `
+		SELECT id, team_name, timezone,
+			to_char(work_start, 'HH24:MI') AS work_start, to_char(work_end, 'HH24:MI') AS work_end
+		FROM teams
+		ORDER BY team_name
+	`
+	if err := r.db.DB().ScanAllContext(ctx, &teams, db.Query{Name: "archive.ExportTeams", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var users []*repoModel.User
+	query = `SELECT id, username, team_name, is_active FROM users ORDER BY team_name, username, id`
+	if err := r.db.DB().ScanAllContext(ctx, &users, db.Query{Name: "archive.ExportUsers", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var prs []*repoModel.PullRequest
+	query = `SELECT id, name, author_id, status, created_at, merged_at FROM prs ORDER BY created_at, id`
+	if err := r.db.DB().ScanAllContext(ctx, &prs, db.Query{Name: "archive.ExportPullRequests", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var reviewers []*repoModel.Reviewer
+	query = `SELECT pr_id, reviewer_id, assigned_at, reviewed_at FROM pr_reviewers ORDER BY pr_id, reviewer_id`
+	if err := r.db.DB().ScanAllContext(ctx, &reviewers, db.Query{Name: "archive.ExportReviewers", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var assignments []*repoModel.Assignment
+	query = `
+		SELECT pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id
+		FROM pr_reviewer_assignments
+		ORDER BY id
+	`
+	if err := r.db.DB().ScanAllContext(ctx, &assignments, db.Query{Name: "archive.ExportAssignments", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var slaSettings []*repoModel.TeamSLA
+	query = `
+		SELECT team_name, first_review_hours, reminder_interval_hours, webhook_url,
+			auto_reassign_hours, max_auto_reassigns, updated_at
+		FROM team_sla_settings
+		ORDER BY team_name
+	`
+	if err := r.db.DB().ScanAllContext(ctx, &slaSettings, db.Query{Name: "archive.ExportSLASettings", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var userHours []*repoModel.UserWorkingHours
+	query = `
+		SELECT id AS user_id, timezone,
+			to_char(work_start, 'HH24:MI') AS work_start, to_char(work_end, 'HH24:MI') AS work_end
+		FROM users
+		WHERE timezone IS NOT NULL OR work_start IS NOT NULL OR work_end IS NOT NULL
+		ORDER BY id
+	`
+	if err := r.db.DB().ScanAllContext(ctx, &userHours, db.Query{Name: "archive.ExportUserWorkingHours", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	var holidays []*repoModel.Holiday
+	query = `SELECT to_char(day, 'YYYY-MM-DD') AS day, team_name, name FROM holidays ORDER BY day, team_name NULLS FIRST`
+	if err := r.db.DB().ScanAllContext(ctx, &holidays, db.Query{Name: "archive.ExportHolidays", QueryRaw: query}); err != nil {
+		return nil, err
+	}
+
+	return &serviceModel.Archive{
+		Teams:        converter.FromRepoTeams(teams),
+		Users:        converter.FromRepoUsers(users),
+		PullRequests: converter.FromRepoPullRequests(prs),
+		Reviewers:    converter.FromRepoReviewers(reviewers),
+		Assignments:  converter.FromRepoAssignments(assignments),
+		SLASettings:  converter.FromRepoSLASettings(slaSettings),
+
+		UserWorkingHours: converter.FromRepoUserWorkingHours(userHours),
+		Holidays:         converter.FromRepoHolidays(holidays),
+	}, nil
+}
+
+func (r *repo) Counts(ctx context.Context) (*serviceModel.ArchiveCounts, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM teams),
+			(SELECT COUNT(*) FROM users),
+			(SELECT COUNT(*) FROM prs),
+			(SELECT COUNT(*) FROM pr_reviewers),
+			(SELECT COUNT(*) FROM pr_reviewer_assignments),
+			(SELECT COUNT(*) FROM team_sla_settings),
+			(SELECT COUNT(*) FROM users WHERE timezone IS NOT NULL OR work_start IS NOT NULL OR work_end IS NOT NULL),
+			(SELECT COUNT(*) FROM holidays)
+	`
+	var c serviceModel.ArchiveCounts
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "archive.Counts", QueryRaw: query}).
+		Scan(&c.Teams, &c.Users, &c.PullRequests, &c.Reviewers, &c.Assignments, &c.SLASettings,
+			&c.UserWorkingHours, &c.Holidays)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Import вставляет все разделы одним батчем в порядке внешних ключей.
+// История получает новые id, но сохраняет порядок архива
+func (r *repo) Import(ctx context.Context, a *serviceModel.Archive) error {
+	batch := &pgx.Batch{}
+
+	for _, t := range a.Teams {
+		wh := serviceModel.DefaultWorkingHours()
+		if t.WorkingHours != nil {
+			wh = *t.WorkingHours
+		}
+		batch.Queue(`INSERT INTO teams(id, team_name, timezone, work_start, work_end) VALUES ($1, $2, $3, $4::time, $5::time)`,
+			t.ID, t.TeamName, wh.Timezone, wh.WorkStart, wh.WorkEnd)
+	}
+	for _, h := range a.Holidays {
+		batch.Queue(`INSERT INTO holidays(day, team_name, name) VALUES ($1::date, $2, $3)`,
+			h.Date, converter.ToRepoNullable(h.TeamName), h.Name)
+	}
+	for _, s := range a.SLASettings {
+		var webhookURL *string
+		if s.WebhookURL != "" {
+			webhookURL = &s.WebhookURL
+		}
+		batch.Queue(`INSERT INTO team_sla_settings(team_name, first_review_hours, reminder_interval_hours, webhook_url,
+					auto_reassign_hours, max_auto_reassigns, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			s.TeamName, s.FirstReviewHours, s.ReminderIntervalHours, webhookURL,
+			s.AutoReassignHours, s.MaxAutoReassigns, s.UpdatedAt)
+	}
+	for _, u := range a.Users {
+		batch.Queue(`INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)`,
+			u.ID, u.Username, u.TeamName, u.IsActive)
+	}
+	for _, u := range a.UserWorkingHours {
+		batch.Queue(`UPDATE users SET timezone = $2, work_start = $3::time, work_end = $4::time WHERE id = $1`,
+			u.UserID, converter.ToRepoNullable(u.Timezone), converter.ToRepoNullable(u.WorkStart), converter.ToRepoNullable(u.WorkEnd))
+	}
+	for _, pr := range a.PullRequests {
+		batch.Queue(`INSERT INTO prs(id, name, author_id, status, created_at, merged_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+			pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt, pr.MergedAt)
+	}
+	for _, rev := range a.Reviewers {
+		batch.Queue(`INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at, reviewed_at) VALUES ($1, $2, $3, $4)`,
+			rev.PRID, rev.ReviewerID, rev.AssignedAt, rev.ReviewedAt)
+	}
+	for _, e := range a.Assignments {
+		var actorName *string
+		if e.ActorName != "" {
+			actorName = &e.ActorName
+		}
+		batch.Queue(`INSERT INTO pr_reviewer_assignments(pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			e.PRID, e.ReviewerID, e.AssignedAt, e.UnassignedAt, e.Reason, actorName, e.ActorUserID)
+	}
+
+	results := r.db.DB().SendBatch(ctx, db.Query{Name: "archive.Import"}, batch)
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.Error().Msgf("Close row error: %v", err)
+		}
+	}()
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			return err
+		}
+	}
+
+	return results.Close()
+}
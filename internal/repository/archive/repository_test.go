@@ -0,0 +1,144 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	calendarRepo "PR/internal/repository/calendar"
+	"PR/internal/repository/memory"
+	prRepo "PR/internal/repository/pr"
+	slaRepo "PR/internal/repository/sla"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type ArchiveRepositoryTestSuite struct {
+	suite.Suite
+	backend  *testingpkg.Backend
+	repo     repository.ArchiveRepository
+	prRepo   repository.PullRequestRepository
+	slaRepo  repository.SLARepository
+	calendar repository.CalendarRepository
+}
+
+func TestArchiveRepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, newTestSuite(b))
+	})
+}
+
+func newTestSuite(b *testingpkg.Backend) *ArchiveRepositoryTestSuite {
+	if b.Store != nil {
+		return &ArchiveRepositoryTestSuite{
+			backend:  b,
+			repo:     memory.NewArchiveRepository(b.Store),
+			prRepo:   memory.NewPullRequestRepository(b.Store),
+			slaRepo:  memory.NewSLARepository(b.Store),
+			calendar: memory.NewCalendarRepository(b.Store),
+		}
+	}
+	return &ArchiveRepositoryTestSuite{
+		backend:  b,
+		repo:     &repo{db: b.Client},
+		prRepo:   prRepo.NewRepository(b.Client),
+		slaRepo:  slaRepo.NewRepository(b.Client),
+		calendar: calendarRepo.NewRepository(b.Client),
+	}
+}
+
+func (s *ArchiveRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+}
+
+func (s *ArchiveRepositoryTestSuite) seed() {
+	t := s.T()
+	s.backend.CreateTeam(t, "backend")
+	s.backend.CreateTeam(t, "mobile")
+	author := s.backend.CreateUser(t, "alice", "backend", true)
+	bob := s.backend.CreateUser(t, "bob", "backend", true)
+	carol := s.backend.CreateUser(t, "carol", "mobile", false)
+
+	prID := uuid.New()
+	s.backend.CreatePR(t, prID, "feature", author, "OPEN")
+	s.backend.AddReviewer(t, prID, bob)
+
+	at := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	s.backend.CreateAssignment(t, &model.ReviewerAssignment{
+		PRID: prID, ReviewerID: carol, AssignedAt: at, UnassignedAt: &at, Reason: model.AssignmentInitial,
+	})
+	s.backend.CreateAssignment(t, &model.ReviewerAssignment{
+		PRID: prID, ReviewerID: bob, AssignedAt: at, Reason: model.AssignmentDeactivation,
+	})
+
+	ctx := context.Background()
+	_, err := s.prRepo.MarkReviewed(ctx, prID, bob, at.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Require().NoError(s.slaRepo.SetTeamSettings(ctx, &model.TeamSLA{
+		TeamName: "backend", FirstReviewHours: 24, ReminderIntervalHours: 4,
+		WebhookURL: "https://hooks.example.com/backend", UpdatedAt: at,
+	}))
+
+	s.Require().NoError(s.calendar.SetTeamHours(ctx, "mobile", &model.WorkingHours{Timezone: "Asia/Tokyo", WorkStart: "10:00", WorkEnd: "19:00"}))
+	s.Require().NoError(s.calendar.SetUserHours(ctx, carol, &model.WorkingHours{WorkEnd: "24:00"}))
+	s.Require().NoError(s.calendar.AddHoliday(ctx, &model.Holiday{Date: "2026-12-25", Name: "Christmas"}))
+	s.Require().NoError(s.calendar.AddHoliday(ctx, &model.Holiday{Date: "2026-12-25", TeamName: "mobile"}))
+}
+
+func (s *ArchiveRepositoryTestSuite) TestExportImport_RoundTrip() {
+	ctx := context.Background()
+	s.seed()
+
+	exported, err := s.repo.Export(ctx)
+	s.Require().NoError(err)
+	s.Len(exported.Teams, 2)
+	s.Len(exported.Users, 3)
+	s.Len(exported.PullRequests, 1)
+	s.Require().Len(exported.Reviewers, 1)
+	s.NotNil(exported.Reviewers[0].ReviewedAt)
+	s.Require().Len(exported.SLASettings, 1)
+	s.Equal("https://hooks.example.com/backend", exported.SLASettings[0].WebhookURL)
+	s.Require().Len(exported.Assignments, 2)
+	s.Equal(model.AssignmentInitial, exported.Assignments[0].Reason)
+	s.NotNil(exported.Assignments[0].UnassignedAt)
+	s.Equal(&model.WorkingHours{Timezone: "UTC", WorkStart: "09:00", WorkEnd: "18:00"}, exported.Teams[0].WorkingHours)
+	s.Equal(&model.WorkingHours{Timezone: "Asia/Tokyo", WorkStart: "10:00", WorkEnd: "19:00"}, exported.Teams[1].WorkingHours)
+	s.Require().Len(exported.UserWorkingHours, 1)
+	s.Equal(model.WorkingHours{WorkEnd: "24:00"}, exported.UserWorkingHours[0].WorkingHours)
+	s.Equal([]*model.Holiday{{Date: "2026-12-25", Name: "Christmas"}, {Date: "2026-12-25", TeamName: "mobile"}}, exported.Holidays)
+
+	s.backend.Cleanup(s.T())
+	counts, err := s.repo.Counts(ctx)
+	s.Require().NoError(err)
+	s.True(counts.Empty())
+
+	s.Require().NoError(s.repo.Import(ctx, exported))
+
+	restored, err := s.repo.Export(ctx)
+	s.Require().NoError(err)
+	s.Equal(exported, restored)
+
+	counts, err = s.repo.Counts(ctx)
+	s.Require().NoError(err)
+	s.Equal(model.ArchiveCounts{Teams: 2, Users: 3, PullRequests: 1, Reviewers: 1, Assignments: 2, SLASettings: 1, UserWorkingHours: 1, Holidays: 2}, *counts)
+}
+
+func (s *ArchiveRepositoryTestSuite) TestImport_UnknownTeamRollsBack() {
+	ctx := context.Background()
+	a := &model.Archive{
+		Teams: []*model.ArchiveTeam{{ID: uuid.New(), TeamName: "backend"}},
+		Users: []*model.User{{ID: uuid.New(), Username: "alice", TeamName: "missing", IsActive: true}},
+	}
+
+	err := s.repo.Import(ctx, a)
+	s.Require().Error(err)
+
+	counts, err := s.repo.Counts(ctx)
+	require.NoError(s.T(), err)
+	s.True(counts.Empty())
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/audit/model"
+)
+
+func FromRepo(e *repoModel.AuditEvent) *serviceModel.AuditEvent {
+	return &serviceModel.AuditEvent{
+		ID:          e.ID,
+		OccurredAt:  e.OccurredAt,
+		Action:      e.Action,
+		EntityType:  e.EntityType,
+		EntityID:    e.EntityID,
+		ActorName:   deref(e.ActorName),
+		ActorRole:   deref(e.ActorRole),
+		ActorUserID: e.ActorUserID,
+		ActorKeyID:  e.ActorKeyID,
+		RequestID:   deref(e.RequestID),
+		Before:      e.Before,
+		After:       e.After,
+	}
+}
+
+func FromRepoList(events []*repoModel.AuditEvent) []*serviceModel.AuditEvent {
+	serviceEvents := make([]*serviceModel.AuditEvent, 0, len(events))
+	for _, e := range events {
+		serviceEvents = append(serviceEvents, FromRepo(e))
+	}
+	return serviceEvents
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
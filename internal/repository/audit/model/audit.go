@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	OccurredAt  time.Time  `db:"occurred_at"`
+	Action      string     `db:"action"`
+	EntityType  string     `db:"entity_type"`
+	EntityID    string     `db:"entity_id"`
+	ActorName   *string    `db:"actor_name"`
+	ActorRole   *string    `db:"actor_role"`
+	ActorUserID *uuid.UUID `db:"actor_user_id"`
+	ActorKeyID  *uuid.UUID `db:"actor_key_id"`
+	RequestID   *string    `db:"request_id"`
+	Before      []byte     `db:"before"`
+	After       []byte     `db:"after"`
+}
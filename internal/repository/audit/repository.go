@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/audit/converter"
+	repoModel "PR/internal/repository/audit/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.AuditRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, e *serviceModel.AuditEvent) error {
+	query := `INSERT INTO audit_events(id, occurred_at, action, entity_type, entity_id,
+				actor_name, actor_role, actor_user_id, actor_key_id, request_id, before, after)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	args := []any{
+		e.ID, e.OccurredAt, e.Action, e.EntityType, e.EntityID,
+		nullString(e.ActorName), nullString(e.ActorRole), e.ActorUserID, e.ActorKeyID,
+		nullString(e.RequestID), nullJSON(e.Before), nullJSON(e.After),
+	}
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "audit.Create", QueryRaw: query}, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *repo) List(ctx context.Context, f *serviceModel.AuditFilter) ([]*serviceModel.AuditEvent, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.Action != "" {
+		where("action = $%d", f.Action)
+	}
+	if f.EntityType != "" {
+		where("entity_type = $%d", f.EntityType)
+	}
+	if f.EntityID != "" {
+		where("entity_id = $%d", f.EntityID)
+	}
+	if f.ActorUserID != nil {
+		where("actor_user_id = $%d", *f.ActorUserID)
+	}
+	if f.From != nil {
+		where("occurred_at >= $%d", *f.From)
+	}
+	if f.To != nil {
+		where("occurred_at < $%d", *f.To)
+	}
+
+	query := `SELECT id, occurred_at, action, entity_type, entity_id,
+				actor_name, actor_role, actor_user_id, actor_key_id, request_id, before, after
+				FROM audit_events`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, f.Limit, f.Offset)
+	query += fmt.Sprintf(" ORDER BY occurred_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	var events []*repoModel.AuditEvent
+	err := r.db.DB().ScanAllContext(ctx, &events, db.Query{Name: "audit.List", QueryRaw: query}, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoList(events), nil
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nullJSON — пустой RawMessage пишется как NULL, а не как невалидный jsonb
+func nullJSON(b []byte) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type AuditRepositoryTestSuite struct {
+	suite.Suite
+	backend *testingpkg.Backend
+	repo    repository.AuditRepository
+}
+
+func TestAuditRepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &AuditRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
+}
+
+func newTestRepo(b *testingpkg.Backend) repository.AuditRepository {
+	if b.Store != nil {
+		return memory.NewAuditRepository(b.Store)
+	}
+	return &repo{db: b.Client}
+}
+
+func (s *AuditRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+}
+
+func (s *AuditRepositoryTestSuite) createEvent(action, entityID string, at time.Time, actorID *uuid.UUID) *model.AuditEvent {
+	e := &model.AuditEvent{
+		ID:          uuid.New(),
+		OccurredAt:  at,
+		Action:      action,
+		EntityType:  model.AuditEntityUser,
+		EntityID:    entityID,
+		ActorName:   "alice",
+		ActorRole:   "admin",
+		ActorUserID: actorID,
+		RequestID:   "req-1",
+		Before:      json.RawMessage(`{"is_active":true}`),
+		After:       json.RawMessage(`{"is_active":false}`),
+	}
+	require.NoError(s.T(), s.repo.Create(context.Background(), e))
+	return e
+}
+
+func (s *AuditRepositoryTestSuite) TestCreate_List() {
+	actorID := uuid.New()
+	created := s.createEvent(model.AuditUserSetActive, "u1", time.Now().UTC(), &actorID)
+
+	events, err := s.repo.List(context.Background(), &model.AuditFilter{Limit: 10})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), events, 1)
+
+	e := events[0]
+	assert.Equal(s.T(), created.ID, e.ID)
+	assert.Equal(s.T(), "alice", e.ActorName)
+	assert.Equal(s.T(), &actorID, e.ActorUserID)
+	assert.Nil(s.T(), e.ActorKeyID)
+	assert.Equal(s.T(), "req-1", e.RequestID)
+	assert.JSONEq(s.T(), `{"is_active":true}`, string(e.Before))
+	assert.JSONEq(s.T(), `{"is_active":false}`, string(e.After))
+}
+
+func (s *AuditRepositoryTestSuite) TestCreate_NullBefore() {
+	e := &model.AuditEvent{
+		ID:         uuid.New(),
+		OccurredAt: time.Now().UTC(),
+		Action:     model.AuditTeamCreate,
+		EntityType: model.AuditEntityTeam,
+		EntityID:   "backend",
+		After:      json.RawMessage(`{"team_name":"backend"}`),
+	}
+	require.NoError(s.T(), s.repo.Create(context.Background(), e))
+
+	events, err := s.repo.List(context.Background(), &model.AuditFilter{EntityType: model.AuditEntityTeam, Limit: 10})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), events, 1)
+	assert.Nil(s.T(), events[0].Before)
+	assert.Empty(s.T(), events[0].ActorName)
+}
+
+func (s *AuditRepositoryTestSuite) TestList_Filters() {
+	actorID := uuid.New()
+	base := time.Now().UTC().Add(-time.Hour)
+
+	s.createEvent(model.AuditUserSetActive, "u1", base, &actorID)
+	s.createEvent(model.AuditUserSetActive, "u2", base.Add(time.Minute), nil)
+	s.createEvent(model.AuditPRMerge, "u1", base.Add(2*time.Minute), &actorID)
+
+	tests := []struct {
+		name     string
+		filter   model.AuditFilter
+		expected int
+	}{
+		{name: "по действию", filter: model.AuditFilter{Action: model.AuditUserSetActive}, expected: 2},
+		{name: "по сущности", filter: model.AuditFilter{EntityID: "u1"}, expected: 2},
+		{name: "по вызывающему", filter: model.AuditFilter{ActorUserID: &actorID}, expected: 2},
+		{name: "по времени", filter: model.AuditFilter{From: ptr(base.Add(30 * time.Second))}, expected: 2},
+		{name: "до времени", filter: model.AuditFilter{To: ptr(base.Add(30 * time.Second))}, expected: 1},
+		{name: "комбинация", filter: model.AuditFilter{Action: model.AuditPRMerge, ActorUserID: &actorID}, expected: 1},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			f := tt.filter
+			f.Limit = 10
+			events, err := s.repo.List(context.Background(), &f)
+			require.NoError(s.T(), err)
+			assert.Len(s.T(), events, tt.expected)
+		})
+	}
+}
+
+func (s *AuditRepositoryTestSuite) TestList_Pagination() {
+	base := time.Now().UTC().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		s.createEvent(model.AuditUserSetActive, "u1", base.Add(time.Duration(i)*time.Minute), nil)
+	}
+
+	first, err := s.repo.List(context.Background(), &model.AuditFilter{Limit: 2})
+	require.NoError(s.T(), err)
+	second, err := s.repo.List(context.Background(), &model.AuditFilter{Limit: 2, Offset: 2})
+	require.NoError(s.T(), err)
+
+	require.Len(s.T(), first, 2)
+	require.Len(s.T(), second, 2)
+	// новые события первыми
+	assert.True(s.T(), first[0].OccurredAt.After(first[1].OccurredAt))
+	assert.True(s.T(), first[1].OccurredAt.After(second[0].OccurredAt))
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/calendar/model"
+)
+
+// ToRepoNullable — пустая строка хранится как NULL
+func ToRepoNullable(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fromNullable(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func FromRepoWorkingHours(w *repoModel.WorkingHours) *serviceModel.WorkingHours {
+	return &serviceModel.WorkingHours{
+		Timezone:  w.Timezone,
+		WorkStart: w.WorkStart,
+		WorkEnd:   w.WorkEnd,
+	}
+}
+
+func FromRepoSchedules(list []*repoModel.Schedule) []*serviceModel.UserSchedule {
+	res := make([]*serviceModel.UserSchedule, 0, len(list))
+	for _, s := range list {
+		override := serviceModel.WorkingHours{
+			Timezone:  fromNullable(s.UserTimezone),
+			WorkStart: fromNullable(s.UserWorkStart),
+			WorkEnd:   fromNullable(s.UserWorkEnd),
+		}
+		res = append(res, &serviceModel.UserSchedule{
+			UserID:   s.UserID,
+			TeamName: s.TeamName,
+			Override: override,
+			WorkingHours: override.Or(serviceModel.WorkingHours{
+				Timezone:  s.TeamTimezone,
+				WorkStart: s.TeamWorkStart,
+				WorkEnd:   s.TeamWorkEnd,
+			}),
+		})
+	}
+	return res
+}
+
+func FromRepoHolidays(list []*repoModel.Holiday) []*serviceModel.Holiday {
+	res := make([]*serviceModel.Holiday, 0, len(list))
+	for _, h := range list {
+		res = append(res, &serviceModel.Holiday{
+			Date:     h.Day,
+			TeamName: fromNullable(h.TeamName),
+			Name:     h.Name,
+		})
+	}
+	return res
+}
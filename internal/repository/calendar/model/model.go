@@ -0,0 +1,26 @@
+package model
+
+import "github.com/google/uuid"
+
+type WorkingHours struct {
+	Timezone  string `db:"timezone"`
+	WorkStart string `db:"work_start"`
+	WorkEnd   string `db:"work_end"`
+}
+
+type Schedule struct {
+	UserID        uuid.UUID `db:"user_id"`
+	TeamName      string    `db:"team_name"`
+	UserTimezone  *string   `db:"user_timezone"`
+	UserWorkStart *string   `db:"user_work_start"`
+	UserWorkEnd   *string   `db:"user_work_end"`
+	TeamTimezone  string    `db:"team_timezone"`
+	TeamWorkStart string    `db:"team_work_start"`
+	TeamWorkEnd   string    `db:"team_work_end"`
+}
+
+type Holiday struct {
+	Day      string  `db:"day"`
+	TeamName *string `db:"team_name"`
+	Name     string  `db:"name"`
+}
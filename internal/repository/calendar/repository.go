@@ -0,0 +1,143 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/calendar/converter"
+	repoModel "PR/internal/repository/calendar/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.CalendarRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) SetTeamHours(ctx context.Context, teamName string, wh *serviceModel.WorkingHours) error {
+	query := `
+		UPDATE teams
+		SET timezone = $2, work_start = $3::time, work_end = $4::time
+		WHERE team_name = $1
+	`
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "calendar.SetTeamHours", QueryRaw: query},
+		teamName, wh.Timezone, wh.WorkStart, wh.WorkEnd)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// SetUserHours — пустые поля wh сбрасываются, и график берется у команды
+func (r *repo) SetUserHours(ctx context.Context, userID uuid.UUID, wh *serviceModel.WorkingHours) error {
+	query := `
+		UPDATE users
+		SET timezone = $2, work_start = $3::time, work_end = $4::time
+		WHERE id = $1
+	`
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "calendar.SetUserHours", QueryRaw: query}, userID,
+		converter.ToRepoNullable(wh.Timezone), converter.ToRepoNullable(wh.WorkStart), converter.ToRepoNullable(wh.WorkEnd))
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func (r *repo) AddHoliday(ctx context.Context, h *serviceModel.Holiday) error {
+	query := `INSERT INTO holidays (day, team_name, name) VALUES ($1::date, $2, $3)`
+
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "calendar.AddHoliday", QueryRaw: query},
+		h.Date, converter.ToRepoNullable(h.TeamName), h.Name)
+	return err
+}
+
+// RemoveHoliday — пустой teamName удаляет общий праздник, а не праздники всех команд
+func (r *repo) RemoveHoliday(ctx context.Context, date, teamName string) error {
+	query := `DELETE FROM holidays WHERE day = $1::date AND team_name IS NOT DISTINCT FROM $2`
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "calendar.RemoveHoliday", QueryRaw: query},
+		date, converter.ToRepoNullable(teamName))
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func (r *repo) GetTeamHours(ctx context.Context, teamName string) (*serviceModel.WorkingHours, error) {
+	query := `
+		SELECT timezone, to_char(work_start, 'HH24:MI') AS work_start, to_char(work_end, 'HH24:MI') AS work_end
+		FROM teams
+		WHERE team_name = $1
+	`
+
+	var wh repoModel.WorkingHours
+	err := r.db.DB().ScanOneContext(ctx, &wh, db.Query{Name: "calendar.GetTeamHours", QueryRaw: query}, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoWorkingHours(&wh), nil
+}
+
+// GetSchedules возвращает графики найденных пользователей; TIME читается как
+// "HH:MM", конец дня 24:00 так и остается 24:00
+func (r *repo) GetSchedules(ctx context.Context, userIDs []uuid.UUID) ([]*serviceModel.UserSchedule, error) {
+	query := `
+		SELECT
+			u.id AS user_id,
+			u.team_name,
+			u.timezone AS user_timezone,
+			to_char(u.work_start, 'HH24:MI') AS user_work_start,
+			to_char(u.work_end, 'HH24:MI') AS user_work_end,
+			t.timezone AS team_timezone,
+			to_char(t.work_start, 'HH24:MI') AS team_work_start,
+			to_char(t.work_end, 'HH24:MI') AS team_work_end
+		FROM users u
+		INNER JOIN teams t ON t.team_name = u.team_name
+		WHERE u.id = ANY($1)
+		ORDER BY u.id
+	`
+
+	var list []*repoModel.Schedule
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "calendar.GetSchedules", QueryRaw: query}, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoSchedules(list), nil
+}
+
+func (r *repo) GetHolidays(ctx context.Context, f *serviceModel.HolidayFilter) ([]*serviceModel.Holiday, error) {
+	query := `
+		SELECT to_char(day, 'YYYY-MM-DD') AS day, team_name, name
+		FROM holidays
+		WHERE ($1::text IS NULL OR team_name IS NULL OR team_name = $1)
+			AND ($2::date IS NULL OR day >= $2::date)
+			AND ($3::date IS NULL OR day <= $3::date)
+		ORDER BY day, team_name NULLS FIRST
+	`
+
+	var list []*repoModel.Holiday
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "calendar.GetHolidays", QueryRaw: query},
+		converter.ToRepoNullable(f.TeamName), converter.ToRepoNullable(f.From), converter.ToRepoNullable(f.To))
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoHolidays(list), nil
+}
@@ -0,0 +1,142 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type CalendarRepositoryTestSuite struct {
+	suite.Suite
+	backend *testingpkg.Backend
+	repo    repository.CalendarRepository
+
+	alice, bob uuid.UUID
+}
+
+func TestCalendarRepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, newTestSuite(b))
+	})
+}
+
+func newTestSuite(b *testingpkg.Backend) *CalendarRepositoryTestSuite {
+	if b.Store != nil {
+		return &CalendarRepositoryTestSuite{backend: b, repo: memory.NewCalendarRepository(b.Store)}
+	}
+	return &CalendarRepositoryTestSuite{backend: b, repo: &repo{db: b.Client}}
+}
+
+func (s *CalendarRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+
+	t := s.T()
+	s.backend.CreateTeam(t, "backend")
+	s.backend.CreateTeam(t, "mobile")
+	s.alice = s.backend.CreateUser(t, "alice", "backend", true)
+	s.bob = s.backend.CreateUser(t, "bob", "mobile", true)
+}
+
+func (s *CalendarRepositoryTestSuite) TestTeamHours() {
+	ctx := context.Background()
+
+	got, err := s.repo.GetTeamHours(ctx, "backend")
+	s.Require().NoError(err)
+	s.Equal(model.DefaultWorkingHours(), *got)
+
+	berlin := model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "08:30", WorkEnd: "24:00"}
+	s.Require().NoError(s.repo.SetTeamHours(ctx, "backend", &berlin))
+	got, err = s.repo.GetTeamHours(ctx, "backend")
+	s.Require().NoError(err)
+	s.Equal(berlin, *got)
+
+	err = s.repo.SetTeamHours(ctx, "backend", &model.WorkingHours{Timezone: "UTC", WorkStart: "18:00", WorkEnd: "09:00"})
+	var pgErr *pgconn.PgError
+	s.Require().ErrorAs(err, &pgErr)
+	s.Equal("23514", pgErr.Code)
+
+	s.ErrorIs(s.repo.SetTeamHours(ctx, "missing", &berlin), pgx.ErrNoRows)
+	_, err = s.repo.GetTeamHours(ctx, "missing")
+	s.ErrorIs(err, pgx.ErrNoRows)
+}
+
+func (s *CalendarRepositoryTestSuite) TestSchedules() {
+	ctx := context.Background()
+	s.Require().NoError(s.repo.SetTeamHours(ctx, "backend", &model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "09:00", WorkEnd: "17:00"}))
+	s.Require().NoError(s.repo.SetUserHours(ctx, s.alice, &model.WorkingHours{Timezone: "Asia/Tokyo"}))
+
+	list, err := s.repo.GetSchedules(ctx, []uuid.UUID{s.alice, s.bob, s.alice, uuid.New()})
+	s.Require().NoError(err)
+	s.Require().Len(list, 2)
+
+	byID := map[uuid.UUID]*model.UserSchedule{list[0].UserID: list[0], list[1].UserID: list[1]}
+	s.Equal(model.UserSchedule{
+		UserID:       s.alice,
+		TeamName:     "backend",
+		Override:     model.WorkingHours{Timezone: "Asia/Tokyo"},
+		WorkingHours: model.WorkingHours{Timezone: "Asia/Tokyo", WorkStart: "09:00", WorkEnd: "17:00"},
+	}, *byID[s.alice])
+	s.Equal(model.UserSchedule{
+		UserID:       s.bob,
+		TeamName:     "mobile",
+		WorkingHours: model.DefaultWorkingHours(),
+	}, *byID[s.bob])
+
+	// пустой график возвращает пользователя к графику команды
+	s.Require().NoError(s.repo.SetUserHours(ctx, s.alice, &model.WorkingHours{}))
+	list, err = s.repo.GetSchedules(ctx, []uuid.UUID{s.alice})
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Empty(list[0].Override)
+	s.Equal("Europe/Berlin", list[0].WorkingHours.Timezone)
+
+	s.ErrorIs(s.repo.SetUserHours(ctx, uuid.New(), &model.WorkingHours{}), pgx.ErrNoRows)
+}
+
+func (s *CalendarRepositoryTestSuite) TestHolidays() {
+	ctx := context.Background()
+	s.Require().NoError(s.repo.AddHoliday(ctx, &model.Holiday{Date: "2026-12-25", Name: "Christmas"}))
+	s.Require().NoError(s.repo.AddHoliday(ctx, &model.Holiday{Date: "2026-12-25", TeamName: "backend", Name: "Christmas"}))
+	s.Require().NoError(s.repo.AddHoliday(ctx, &model.Holiday{Date: "2026-12-24", TeamName: "mobile", Name: "Christmas Eve"}))
+	s.Require().NoError(s.repo.AddHoliday(ctx, &model.Holiday{Date: "2027-01-01", Name: "New Year"}))
+
+	var pgErr *pgconn.PgError
+	err := s.repo.AddHoliday(ctx, &model.Holiday{Date: "2026-12-25", Name: "again"})
+	s.Require().ErrorAs(err, &pgErr)
+	s.Equal("23505", pgErr.Code)
+	err = s.repo.AddHoliday(ctx, &model.Holiday{Date: "2026-12-26", TeamName: "missing"})
+	s.Require().ErrorAs(err, &pgErr)
+	s.Equal("23503", pgErr.Code)
+
+	all, err := s.repo.GetHolidays(ctx, &model.HolidayFilter{})
+	s.Require().NoError(err)
+	s.Equal([]*model.Holiday{
+		{Date: "2026-12-24", TeamName: "mobile", Name: "Christmas Eve"},
+		{Date: "2026-12-25", Name: "Christmas"},
+		{Date: "2026-12-25", TeamName: "backend", Name: "Christmas"},
+		{Date: "2027-01-01", Name: "New Year"},
+	}, all)
+
+	backend, err := s.repo.GetHolidays(ctx, &model.HolidayFilter{TeamName: "backend", From: "2026-12-01", To: "2026-12-31"})
+	s.Require().NoError(err)
+	s.Equal([]*model.Holiday{
+		{Date: "2026-12-25", Name: "Christmas"},
+		{Date: "2026-12-25", TeamName: "backend", Name: "Christmas"},
+	}, backend)
+
+	// общий праздник удаляется отдельно от праздника команды
+	s.Require().NoError(s.repo.RemoveHoliday(ctx, "2026-12-25", ""))
+	s.ErrorIs(s.repo.RemoveHoliday(ctx, "2026-12-25", ""), pgx.ErrNoRows)
+	backend, err = s.repo.GetHolidays(ctx, &model.HolidayFilter{TeamName: "backend", From: "2026-12-25", To: "2026-12-25"})
+	s.Require().NoError(err)
+	s.Equal([]*model.Holiday{{Date: "2026-12-25", TeamName: "backend", Name: "Christmas"}}, backend)
+}
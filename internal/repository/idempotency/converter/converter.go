@@ -0,0 +1,24 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/idempotency/model"
+)
+
+func FromRepo(k *repoModel.IdempotencyKey) *serviceModel.IdempotencyRecord {
+	rec := &serviceModel.IdempotencyRecord{
+		Scope:       k.Scope,
+		Key:         k.Key,
+		RequestHash: k.RequestHash,
+		Body:        k.ResponseBody,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+	if k.StatusCode != nil {
+		rec.StatusCode = *k.StatusCode
+	}
+	if k.ContentType != nil {
+		rec.ContentType = *k.ContentType
+	}
+	return rec
+}
@@ -0,0 +1,110 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/idempotency/converter"
+	repoModel "PR/internal/repository/idempotency/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.IdempotencyRepository {
+	return &repo{db: db}
+}
+
+// Reserve занимает ключ под новый запрос. Просроченная запись перезаписывается.
+// Если ключ уже занят, возвращается существующая запись и false
+func (r *repo) Reserve(ctx context.Context, rec *serviceModel.IdempotencyRecord) (*serviceModel.IdempotencyRecord, bool, error) {
+	query := `
+		INSERT INTO idempotency_keys(scope, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (scope, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+		RETURNING key
+	`
+
+	var key string
+	args := []any{rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt, rec.ExpiresAt}
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "idempotency.Reserve", QueryRaw: query}, args...).Scan(&key)
+	if err == nil {
+		return rec, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	existing, err := r.Get(ctx, rec.Scope, rec.Key)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *repo) Get(ctx context.Context, scope, key string) (*serviceModel.IdempotencyRecord, error) {
+	query := `SELECT scope, key, request_hash, status_code, content_type, response_body, created_at, expires_at
+				FROM idempotency_keys
+				WHERE scope = $1 AND key = $2`
+
+	var k repoModel.IdempotencyKey
+	err := r.db.DB().ScanOneContext(ctx, &k, db.Query{Name: "idempotency.Get", QueryRaw: query}, scope, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepo(&k), nil
+}
+
+// Complete сохраняет ответ и продлевает ключ с короткой аренды до полного срока хранения.
+// Если после истечения аренды ключ занял повтор, его запись не трогается и возвращается pgx.ErrNoRows
+func (r *repo) Complete(ctx context.Context, rec *serviceModel.IdempotencyRecord) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $5, content_type = $6, response_body = $7, expires_at = $8
+		WHERE scope = $1 AND key = $2 AND request_hash = $3 AND created_at = $4
+	`
+
+	args := []any{rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt, rec.StatusCode, rec.ContentType, rec.Body, rec.ExpiresAt}
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "idempotency.Complete", QueryRaw: query}, args...)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// Delete освобождает ключ, только если он все еще занят записью rec, а не перехвачен повтором
+func (r *repo) Delete(ctx context.Context, rec *serviceModel.IdempotencyRecord) error {
+	query := `DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND request_hash = $3 AND created_at = $4`
+
+	args := []any{rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt}
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "idempotency.Delete", QueryRaw: query}, args...)
+	return err
+}
+
+func (r *repo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "idempotency.DeleteExpired", QueryRaw: query}, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
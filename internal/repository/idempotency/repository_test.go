@@ -0,0 +1,153 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type IdempotencyRepositoryTestSuite struct {
+	suite.Suite
+	backend *testingpkg.Backend
+	repo    repository.IdempotencyRepository
+}
+
+func TestIdempotencyRepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &IdempotencyRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
+}
+
+func newTestRepo(b *testingpkg.Backend) repository.IdempotencyRepository {
+	if b.Store != nil {
+		return memory.NewIdempotencyRepository(b.Store)
+	}
+	return &repo{db: b.Client}
+}
+
+func (s *IdempotencyRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+}
+
+func newRecord(key, hash string, createdAt time.Time) *model.IdempotencyRecord {
+	return &model.IdempotencyRecord{
+		Scope:       "POST /pullRequest/reassign key:1",
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   createdAt,
+		ExpiresAt:   createdAt.Add(time.Hour),
+	}
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestReserve_Complete_Replay() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	rec := newRecord("retry-1", "hash-1", now)
+	_, reserved, err := s.repo.Reserve(ctx, rec)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), reserved)
+
+	existing, reserved, err := s.repo.Reserve(ctx, newRecord("retry-1", "hash-1", now))
+	require.NoError(s.T(), err)
+	assert.False(s.T(), reserved)
+	assert.False(s.T(), existing.Completed())
+
+	rec.StatusCode = http.StatusOK
+	rec.ContentType = "application/json"
+	rec.Body = []byte(`{"ok":true}`)
+	rec.ExpiresAt = now.Add(24 * time.Hour)
+	require.NoError(s.T(), s.repo.Complete(ctx, rec))
+
+	existing, reserved, err = s.repo.Reserve(ctx, newRecord("retry-1", "hash-2", now))
+	require.NoError(s.T(), err)
+	assert.False(s.T(), reserved)
+	assert.Equal(s.T(), "hash-1", existing.RequestHash)
+	assert.Equal(s.T(), http.StatusOK, existing.StatusCode)
+	assert.Equal(s.T(), "application/json", existing.ContentType)
+	assert.Equal(s.T(), `{"ok":true}`, string(existing.Body))
+	// завершенный ответ хранится дольше аренды незавершенного запроса
+	assert.True(s.T(), existing.ExpiresAt.Equal(now.Add(24*time.Hour)))
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestReserve_Expired() {
+	ctx := context.Background()
+	past := time.Now().UTC().Add(-2 * time.Hour)
+
+	_, reserved, err := s.repo.Reserve(ctx, newRecord("retry-1", "hash-1", past))
+	require.NoError(s.T(), err)
+	require.True(s.T(), reserved)
+
+	_, reserved, err = s.repo.Reserve(ctx, newRecord("retry-1", "hash-2", time.Now().UTC()))
+	require.NoError(s.T(), err)
+	assert.True(s.T(), reserved)
+
+	rec, err := s.repo.Get(ctx, "POST /pullRequest/reassign key:1", "retry-1")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "hash-2", rec.RequestHash)
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestDelete_DeleteExpired() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	_, _, err := s.repo.Reserve(ctx, newRecord("old", "hash", now.Add(-2*time.Hour)))
+	require.NoError(s.T(), err)
+	_, _, err = s.repo.Reserve(ctx, newRecord("fresh", "hash", now))
+	require.NoError(s.T(), err)
+	failed := newRecord("failed", "hash", now)
+	_, _, err = s.repo.Reserve(ctx, failed)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.repo.Delete(ctx, failed))
+
+	n, err := s.repo.DeleteExpired(ctx, now)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), n)
+
+	_, err = s.repo.Get(ctx, "POST /pullRequest/reassign key:1", "fresh")
+	assert.NoError(s.T(), err)
+	_, err = s.repo.Get(ctx, "POST /pullRequest/reassign key:1", "failed")
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestDelete_Complete_LeaseTakenOver() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	stale := newRecord("retry-1", "hash", now.Add(-2*time.Hour))
+	_, reserved, err := s.repo.Reserve(ctx, stale)
+	require.NoError(s.T(), err)
+	require.True(s.T(), reserved)
+
+	// повтор с тем же телом занимает ключ после истечения аренды
+	_, reserved, err = s.repo.Reserve(ctx, newRecord("retry-1", "hash", now))
+	require.NoError(s.T(), err)
+	require.True(s.T(), reserved)
+
+	require.NoError(s.T(), s.repo.Delete(ctx, stale))
+	stale.StatusCode = http.StatusOK
+	stale.ExpiresAt = now.Add(24 * time.Hour)
+	assert.ErrorIs(s.T(), s.repo.Complete(ctx, stale), pgx.ErrNoRows)
+
+	rec, err := s.repo.Get(ctx, "POST /pullRequest/reassign key:1", "retry-1")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), rec.CreatedAt.Equal(now))
+	assert.False(s.T(), rec.Completed())
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestComplete_NotFound() {
+	err := s.repo.Complete(context.Background(), newRecord("missing", "hash", time.Now().UTC()))
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type apiKeyRepo struct {
+	store *Store
+}
+
+func NewAPIKeyRepository(store *Store) repository.APIKeyRepository {
+	return &apiKeyRepo{store: store}
+}
+
+func (r *apiKeyRepo) Create(ctx context.Context, key *model.APIKey, hash string) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.apiKeys[key.ID]; ok {
+			return uniqueViolation("api_keys_pkey")
+		}
+		for _, k := range t.apiKeys {
+			if k.Hash == hash {
+				return uniqueViolation("api_keys_key_hash_key")
+			}
+		}
+		if key.UserID != nil {
+			if _, ok := t.users[*key.UserID]; !ok {
+				return foreignKeyViolation("api_keys_user_id_fkey")
+			}
+		}
+
+		row := apiKeyRow{APIKey: *key, Hash: hash}
+		row.RevokedAt = nil
+		row.UserID = cloneUUID(key.UserID)
+		t.apiKeys[key.ID] = row
+		return nil
+	})
+}
+
+func (r *apiKeyRepo) GetByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	var res *model.APIKey
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, k := range t.apiKeys {
+			if k.Hash == hash {
+				res = k.model()
+				return nil
+			}
+		}
+		return pgx.ErrNoRows
+	})
+	return res, err
+}
+
+func (r *apiKeyRepo) List(ctx context.Context) ([]*model.APIKey, error) {
+	res := make([]*model.APIKey, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, k := range t.apiKeys {
+			res = append(res, k.model())
+		}
+		slices.SortFunc(res, func(a, b *model.APIKey) int {
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	var res *model.APIKey
+	err := r.store.write(ctx, func(t *tables) error {
+		k, ok := t.apiKeys[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		if k.RevokedAt == nil {
+			now := r.store.timestamp()
+			k.RevokedAt = &now
+			t.apiKeys[id] = k
+		}
+		res = k.model()
+		return nil
+	})
+	return res, err
+}
+
+func (k apiKeyRow) model() *model.APIKey {
+	res := k.APIKey
+	res.RevokedAt = cloneTime(k.RevokedAt)
+	res.UserID = cloneUUID(k.UserID)
+	return &res
+}
@@ -0,0 +1,286 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type archiveRepo struct {
+	store *Store
+}
+
+func NewArchiveRepository(store *Store) repository.ArchiveRepository {
+	return &archiveRepo{store: store}
+}
+
+// Export повторяет порядок выборок Postgres реализации
+func (r *archiveRepo) Export(ctx context.Context) (*model.Archive, error) {
+	a := &model.Archive{}
+	err := r.store.read(ctx, func(t *tables) error {
+		a.Teams = make([]*model.ArchiveTeam, 0, len(t.teams))
+		for name, id := range t.teams {
+			wh := t.workingHours(name)
+			a.Teams = append(a.Teams, &model.ArchiveTeam{ID: id, TeamName: name, WorkingHours: &wh})
+		}
+		slices.SortFunc(a.Teams, func(x, y *model.ArchiveTeam) int {
+			return strings.Compare(x.TeamName, y.TeamName)
+		})
+
+		users := slices.Collect(maps.Values(t.users))
+		sortUsers(users)
+		slices.SortStableFunc(users, func(x, y model.User) int {
+			return strings.Compare(x.TeamName, y.TeamName)
+		})
+		a.Users = make([]*model.User, 0, len(users))
+		for _, u := range users {
+			a.Users = append(a.Users, &u)
+		}
+
+		a.PullRequests = make([]*model.ArchivePullRequest, 0, len(t.prs))
+		for _, row := range t.prs {
+			pr := &model.ArchivePullRequest{
+				ID:        row.ID,
+				Name:      row.Name,
+				Status:    row.Status,
+				CreatedAt: nullTime(row.CreatedAt),
+				MergedAt:  cloneTime(row.MergedAt),
+			}
+			if row.AuthorID != uuid.Nil {
+				pr.AuthorID = cloneUUID(&row.AuthorID)
+			}
+			a.PullRequests = append(a.PullRequests, pr)
+		}
+		slices.SortFunc(a.PullRequests, func(x, y *model.ArchivePullRequest) int {
+			if c := compareTime(x.CreatedAt, y.CreatedAt); c != 0 {
+				return c
+			}
+			return bytes.Compare(x.ID[:], y.ID[:])
+		})
+
+		a.Reviewers = make([]*model.ArchiveReviewer, 0, len(t.reviewers))
+		for _, rv := range t.reviewers {
+			a.Reviewers = append(a.Reviewers, &model.ArchiveReviewer{
+				PRID:       rv.PRID,
+				ReviewerID: rv.ReviewerID,
+				AssignedAt: nullTime(rv.AssignedAt),
+				ReviewedAt: cloneTime(rv.ReviewedAt),
+			})
+		}
+		slices.SortFunc(a.Reviewers, func(x, y *model.ArchiveReviewer) int {
+			if c := bytes.Compare(x.PRID[:], y.PRID[:]); c != 0 {
+				return c
+			}
+			return bytes.Compare(x.ReviewerID[:], y.ReviewerID[:])
+		})
+
+		// история хранится в порядке id
+		a.Assignments = make([]*model.ReviewerAssignment, 0, len(t.assignments))
+		for _, row := range t.assignments {
+			e := row.ReviewerAssignment
+			e.UnassignedAt = cloneTime(row.UnassignedAt)
+			e.ActorUserID = cloneUUID(row.ActorUserID)
+			a.Assignments = append(a.Assignments, &e)
+		}
+
+		a.SLASettings = make([]*model.TeamSLA, 0, len(t.slaSettings))
+		for _, s := range t.slaSettings {
+			a.SLASettings = append(a.SLASettings, &s)
+		}
+		slices.SortFunc(a.SLASettings, func(x, y *model.TeamSLA) int {
+			return strings.Compare(x.TeamName, y.TeamName)
+		})
+
+		a.UserWorkingHours = make([]*model.UserWorkingHours, 0, len(t.userHours))
+		for id, wh := range t.userHours {
+			a.UserWorkingHours = append(a.UserWorkingHours, &model.UserWorkingHours{UserID: id, WorkingHours: wh})
+		}
+		slices.SortFunc(a.UserWorkingHours, func(x, y *model.UserWorkingHours) int {
+			return bytes.Compare(x.UserID[:], y.UserID[:])
+		})
+
+		a.Holidays = make([]*model.Holiday, 0, len(t.holidays))
+		for _, h := range t.holidays {
+			a.Holidays = append(a.Holidays, &h)
+		}
+		// общие праздники без команды идут первыми, как NULLS FIRST
+		slices.SortFunc(a.Holidays, func(x, y *model.Holiday) int {
+			if c := strings.Compare(x.Date, y.Date); c != 0 {
+				return c
+			}
+			return strings.Compare(x.TeamName, y.TeamName)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *archiveRepo) Counts(ctx context.Context) (*model.ArchiveCounts, error) {
+	var c model.ArchiveCounts
+	err := r.store.read(ctx, func(t *tables) error {
+		c = model.ArchiveCounts{
+			Teams:        len(t.teams),
+			Users:        len(t.users),
+			PullRequests: len(t.prs),
+			Reviewers:    len(t.reviewers),
+			Assignments:  len(t.assignments),
+			SLASettings:  len(t.slaSettings),
+
+			UserWorkingHours: len(t.userHours),
+			Holidays:         len(t.holidays),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Import проверяет те же ограничения, что и схема Postgres
+func (r *archiveRepo) Import(ctx context.Context, a *model.Archive) error {
+	return r.store.write(ctx, func(t *tables) error {
+		teamIDs := make(map[uuid.UUID]bool, len(t.teams))
+		for _, id := range t.teams {
+			teamIDs[id] = true
+		}
+		for _, team := range a.Teams {
+			if _, ok := t.teams[team.TeamName]; ok {
+				return uniqueViolation("teams_team_name_key")
+			}
+			if teamIDs[team.ID] {
+				return uniqueViolation("teams_pkey")
+			}
+			teamIDs[team.ID] = true
+			t.teams[team.TeamName] = team.ID
+			if team.WorkingHours != nil {
+				if team.WorkingHours.WorkStart >= team.WorkingHours.WorkEnd {
+					return checkViolation("teams_work_hours_check")
+				}
+				t.teamHours[team.TeamName] = *team.WorkingHours
+			}
+		}
+
+		for _, h := range a.Holidays {
+			if _, ok := t.teams[h.TeamName]; h.TeamName != "" && !ok {
+				return foreignKeyViolation("holidays_team_name_fkey")
+			}
+			if t.holidayIndex(h.Date, h.TeamName) >= 0 {
+				return uniqueViolation("idx_holidays_day_team")
+			}
+			t.holidays = append(t.holidays, *h)
+		}
+
+		for _, s := range a.SLASettings {
+			if _, ok := t.teams[s.TeamName]; !ok {
+				return foreignKeyViolation("team_sla_settings_team_name_fkey")
+			}
+			if _, ok := t.slaSettings[s.TeamName]; ok {
+				return uniqueViolation("team_sla_settings_pkey")
+			}
+			row := *s
+			row.UpdatedAt = s.UpdatedAt.Truncate(time.Microsecond)
+			t.slaSettings[s.TeamName] = row
+		}
+
+		for _, u := range a.Users {
+			if _, ok := t.teams[u.TeamName]; !ok {
+				return foreignKeyViolation("users_team_name_fkey")
+			}
+			if _, ok := t.users[u.ID]; ok {
+				return uniqueViolation("users_pkey")
+			}
+			t.users[u.ID] = *u
+		}
+
+		// как UPDATE в Postgres: график неизвестного пользователя ничего не меняет
+		for _, u := range a.UserWorkingHours {
+			if _, ok := t.users[u.UserID]; ok && u.WorkingHours != (model.WorkingHours{}) {
+				t.userHours[u.UserID] = u.WorkingHours
+			}
+		}
+
+		for _, pr := range a.PullRequests {
+			if _, ok := t.prs[pr.ID]; ok {
+				return uniqueViolation("prs_pkey")
+			}
+			row := prRow{ID: pr.ID, Name: pr.Name, Status: pr.Status, MergedAt: cloneTime(pr.MergedAt)}
+			if pr.AuthorID != nil {
+				if _, ok := t.users[*pr.AuthorID]; !ok {
+					return foreignKeyViolation("prs_author_id_fkey")
+				}
+				row.AuthorID = *pr.AuthorID
+			}
+			if pr.CreatedAt != nil {
+				row.CreatedAt = *pr.CreatedAt
+			}
+			t.prs[pr.ID] = row
+		}
+
+		for _, rv := range a.Reviewers {
+			if err := t.checkPRAndUser(rv.PRID, rv.ReviewerID, "pr_reviewers"); err != nil {
+				return err
+			}
+			if t.reviewerIndex(rv.PRID, rv.ReviewerID) >= 0 {
+				return uniqueViolation("pr_reviewers_pkey")
+			}
+			row := reviewerRow{PRID: rv.PRID, ReviewerID: rv.ReviewerID, ReviewedAt: cloneTime(rv.ReviewedAt)}
+			if rv.AssignedAt != nil {
+				row.AssignedAt = *rv.AssignedAt
+			}
+			t.reviewers = append(t.reviewers, row)
+		}
+
+		for _, e := range a.Assignments {
+			if err := t.checkPRAndUser(e.PRID, e.ReviewerID, "pr_reviewer_assignments"); err != nil {
+				return err
+			}
+			if !validReasons[e.Reason] {
+				return checkViolation("pr_reviewer_assignments_reason_check")
+			}
+			if e.UnassignedAt == nil && t.activeAssignmentIndex(e.PRID, e.ReviewerID) >= 0 {
+				return uniqueViolation("idx_pr_reviewer_assignments_active")
+			}
+
+			t.assignmentSeq++
+			row := assignmentRow{ID: t.assignmentSeq, ReviewerAssignment: *e}
+			row.UnassignedAt = cloneTime(e.UnassignedAt)
+			row.ActorUserID = cloneUUID(e.ActorUserID)
+			t.assignments = append(t.assignments, row)
+		}
+		return nil
+	})
+}
+
+// nullTime — в строках памяти отсутствие значения хранится как нулевое время
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// compareTime сортирует NULL последними, как ORDER BY в Postgres
+func compareTime(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp.Compare(a.UnixNano(), b.UnixNano())
+}
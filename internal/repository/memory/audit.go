@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type auditRepo struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) repository.AuditRepository {
+	return &auditRepo{store: store}
+}
+
+func (r *auditRepo) Create(ctx context.Context, e *model.AuditEvent) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, existing := range t.audit {
+			if existing.ID == e.ID {
+				return uniqueViolation("audit_events_pkey")
+			}
+		}
+		t.audit = append(t.audit, cloneAuditEvent(*e))
+		return nil
+	})
+}
+
+func (r *auditRepo) List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error) {
+	res := make([]*model.AuditEvent, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		var matched []model.AuditEvent
+		for _, e := range t.audit {
+			if auditMatches(e, f) {
+				matched = append(matched, e)
+			}
+		}
+		// ORDER BY occurred_at DESC, id DESC
+		slices.SortFunc(matched, func(a, b model.AuditEvent) int {
+			if c := b.OccurredAt.Compare(a.OccurredAt); c != 0 {
+				return c
+			}
+			return strings.Compare(b.ID.String(), a.ID.String())
+		})
+
+		for i := f.Offset; i < len(matched) && i < f.Offset+f.Limit; i++ {
+			e := cloneAuditEvent(matched[i])
+			res = append(res, &e)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func auditMatches(e model.AuditEvent, f *model.AuditFilter) bool {
+	switch {
+	case f.Action != "" && e.Action != f.Action:
+		return false
+	case f.EntityType != "" && e.EntityType != f.EntityType:
+		return false
+	case f.EntityID != "" && e.EntityID != f.EntityID:
+		return false
+	case f.ActorUserID != nil && (e.ActorUserID == nil || *e.ActorUserID != *f.ActorUserID):
+		return false
+	case f.From != nil && e.OccurredAt.Before(*f.From):
+		return false
+	case f.To != nil && !e.OccurredAt.Before(*f.To):
+		return false
+	}
+	return true
+}
+
+func cloneAuditEvent(e model.AuditEvent) model.AuditEvent {
+	e.ActorUserID = cloneUUID(e.ActorUserID)
+	e.ActorKeyID = cloneUUID(e.ActorKeyID)
+	e.Before = cloneJSON(e.Before)
+	e.After = cloneJSON(e.After)
+	return e
+}
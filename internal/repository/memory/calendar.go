@@ -0,0 +1,159 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type calendarRepo struct {
+	store *Store
+}
+
+func NewCalendarRepository(store *Store) repository.CalendarRepository {
+	return &calendarRepo{store: store}
+}
+
+func (r *calendarRepo) SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.teams[teamName]; !ok {
+			return pgx.ErrNoRows
+		}
+		// "HH:MM" сравниваются как строки так же, как TIME
+		if wh.WorkStart >= wh.WorkEnd {
+			return checkViolation("teams_work_hours_check")
+		}
+		t.teamHours[teamName] = *wh
+		return nil
+	})
+}
+
+func (r *calendarRepo) SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return pgx.ErrNoRows
+		}
+		if *wh == (model.WorkingHours{}) {
+			delete(t.userHours, userID)
+			return nil
+		}
+		t.userHours[userID] = *wh
+		return nil
+	})
+}
+
+func (r *calendarRepo) AddHoliday(ctx context.Context, h *model.Holiday) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.teams[h.TeamName]; h.TeamName != "" && !ok {
+			return foreignKeyViolation("holidays_team_name_fkey")
+		}
+		if t.holidayIndex(h.Date, h.TeamName) >= 0 {
+			return uniqueViolation("idx_holidays_day_team")
+		}
+		t.holidays = append(t.holidays, *h)
+		return nil
+	})
+}
+
+func (r *calendarRepo) RemoveHoliday(ctx context.Context, date, teamName string) error {
+	return r.store.write(ctx, func(t *tables) error {
+		i := t.holidayIndex(date, teamName)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		t.holidays = slices.Delete(slices.Clone(t.holidays), i, i+1)
+		return nil
+	})
+}
+
+func (r *calendarRepo) GetTeamHours(ctx context.Context, teamName string) (*model.WorkingHours, error) {
+	var res *model.WorkingHours
+	err := r.store.read(ctx, func(t *tables) error {
+		if _, ok := t.teams[teamName]; !ok {
+			return pgx.ErrNoRows
+		}
+		wh := t.workingHours(teamName)
+		res = &wh
+		return nil
+	})
+	return res, err
+}
+
+func (r *calendarRepo) GetSchedules(ctx context.Context, userIDs []uuid.UUID) ([]*model.UserSchedule, error) {
+	res := make([]*model.UserSchedule, 0, len(userIDs))
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, id := range userIDs {
+			u, ok := t.users[id]
+			if !ok {
+				continue
+			}
+			if _, ok := t.teams[u.TeamName]; !ok {
+				continue
+			}
+			override := t.userHours[id]
+			res = append(res, &model.UserSchedule{
+				UserID:       id,
+				TeamName:     u.TeamName,
+				Override:     override,
+				WorkingHours: override.Or(t.workingHours(u.TeamName)),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, func(a, b *model.UserSchedule) int {
+		return strings.Compare(a.UserID.String(), b.UserID.String())
+	})
+	res = slices.CompactFunc(res, func(a, b *model.UserSchedule) bool { return a.UserID == b.UserID })
+	return res, nil
+}
+
+func (r *calendarRepo) GetHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error) {
+	res := make([]*model.Holiday, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, h := range t.holidays {
+			if f.TeamName != "" && h.TeamName != "" && h.TeamName != f.TeamName {
+				continue
+			}
+			// даты "YYYY-MM-DD" упорядочены так же, как строки
+			if f.From != "" && h.Date < f.From || f.To != "" && h.Date > f.To {
+				continue
+			}
+			res = append(res, &h)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, func(a, b *model.Holiday) int {
+		if c := strings.Compare(a.Date, b.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TeamName, b.TeamName)
+	})
+	return res, nil
+}
+
+func (t *tables) workingHours(teamName string) model.WorkingHours {
+	if wh, ok := t.teamHours[teamName]; ok {
+		return wh
+	}
+	return model.DefaultWorkingHours()
+}
+
+func (t *tables) holidayIndex(date, teamName string) int {
+	return slices.IndexFunc(t.holidays, func(h model.Holiday) bool {
+		return h.Date == date && h.TeamName == teamName
+	})
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type idempotencyRepo struct {
+	store *Store
+}
+
+func NewIdempotencyRepository(store *Store) repository.IdempotencyRepository {
+	return &idempotencyRepo{store: store}
+}
+
+// Reserve занимает ключ, если его нет или он просрочен, иначе возвращает существующую запись
+func (r *idempotencyRepo) Reserve(ctx context.Context, rec *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+	var existing *model.IdempotencyRecord
+	err := r.store.write(ctx, func(t *tables) error {
+		k := idempotencyKey{scope: rec.Scope, key: rec.Key}
+		if cur, ok := t.idempotency[k]; ok && cur.ExpiresAt.After(rec.CreatedAt) {
+			existing = cloneIdempotency(cur)
+			return nil
+		}
+
+		saved := *cloneIdempotency(*rec)
+		saved.StatusCode, saved.ContentType, saved.Body = 0, "", nil
+		t.idempotency[k] = saved
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return existing, false, nil
+	}
+	return rec, true, nil
+}
+
+func (r *idempotencyRepo) Get(ctx context.Context, scope, key string) (*model.IdempotencyRecord, error) {
+	var res *model.IdempotencyRecord
+	err := r.store.read(ctx, func(t *tables) error {
+		rec, ok := t.idempotency[idempotencyKey{scope: scope, key: key}]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		res = cloneIdempotency(rec)
+		return nil
+	})
+	return res, err
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, rec *model.IdempotencyRecord) error {
+	return r.store.write(ctx, func(t *tables) error {
+		k := idempotencyKey{scope: rec.Scope, key: rec.Key}
+		cur, ok := t.idempotency[k]
+		if !ok || !sameReservation(cur, rec) {
+			return pgx.ErrNoRows
+		}
+		cur.StatusCode = rec.StatusCode
+		cur.ContentType = rec.ContentType
+		cur.Body = slices.Clone(rec.Body)
+		cur.ExpiresAt = rec.ExpiresAt
+		t.idempotency[k] = cur
+		return nil
+	})
+}
+
+func (r *idempotencyRepo) Delete(ctx context.Context, rec *model.IdempotencyRecord) error {
+	return r.store.write(ctx, func(t *tables) error {
+		k := idempotencyKey{scope: rec.Scope, key: rec.Key}
+		if cur, ok := t.idempotency[k]; ok && sameReservation(cur, rec) {
+			delete(t.idempotency, k)
+		}
+		return nil
+	})
+}
+
+func (r *idempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	var deleted int64
+	err := r.store.write(ctx, func(t *tables) error {
+		for k, rec := range t.idempotency {
+			if !rec.ExpiresAt.After(now) {
+				delete(t.idempotency, k)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// sameReservation отличает запись запроса от записи повтора, занявшего ключ после истечения аренды
+func sameReservation(cur model.IdempotencyRecord, rec *model.IdempotencyRecord) bool {
+	return cur.RequestHash == rec.RequestHash && cur.CreatedAt.Equal(rec.CreatedAt)
+}
+
+func cloneIdempotency(rec model.IdempotencyRecord) *model.IdempotencyRecord {
+	rec.Body = slices.Clone(rec.Body)
+	return &rec
+}
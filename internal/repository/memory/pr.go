@@ -0,0 +1,398 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+var validReasons = map[string]bool{
+	model.AssignmentInitial:      true,
+	model.AssignmentReassign:     true,
+	model.AssignmentDeactivation: true,
+	model.AssignmentManual:       true,
+	model.AssignmentTimeout:      true,
+}
+
+type prRepo struct {
+	store *Store
+}
+
+func NewPullRequestRepository(store *Store) repository.PullRequestRepository {
+	return &prRepo{store: store}
+}
+
+func (r *prRepo) CreatePR(ctx context.Context, pr *model.PullRequest) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.prs[pr.ID]; ok {
+			return uniqueViolation("prs_pkey")
+		}
+		if _, ok := t.users[pr.AuthorID]; !ok {
+			return foreignKeyViolation("prs_author_id_fkey")
+		}
+
+		t.prs[pr.ID] = prRow{
+			ID:        pr.ID,
+			Name:      pr.Name,
+			AuthorID:  pr.AuthorID,
+			Status:    pr.Status,
+			CreatedAt: r.store.timestamp(),
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) CreatePRReviewers(ctx context.Context, pr *model.PullRequest) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, revID := range pr.AssignedReviewers {
+			if err := r.addReviewer(t, pr.ID, revID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	return r.store.write(ctx, func(t *tables) error {
+		return r.addReviewer(t, prID, reviewerID)
+	})
+}
+
+func (r *prRepo) addReviewer(t *tables, prID, reviewerID uuid.UUID) error {
+	if t.reviewerIndex(prID, reviewerID) >= 0 {
+		return uniqueViolation("pr_reviewers_pkey")
+	}
+	if err := t.checkPRAndUser(prID, reviewerID, "pr_reviewers"); err != nil {
+		return err
+	}
+
+	t.reviewers = append(t.reviewers, reviewerRow{PRID: prID, ReviewerID: reviewerID, AssignedAt: r.store.timestamp()})
+	return nil
+}
+
+func (r *prRepo) RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	return r.store.write(ctx, func(t *tables) error {
+		i := t.reviewerIndex(prID, reviewerID)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		t.reviewers = slices.Delete(t.reviewers, i, i+1)
+		return nil
+	})
+}
+
+func (r *prRepo) ReassignReviewers(ctx context.Context, prID, oldID, newID uuid.UUID) error {
+	return r.store.write(ctx, func(t *tables) error {
+		i := t.reviewerIndex(prID, oldID)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		if oldID == newID {
+			return nil
+		}
+		if t.reviewerIndex(prID, newID) >= 0 {
+			return uniqueViolation("pr_reviewers_pkey")
+		}
+		if _, ok := t.users[newID]; !ok {
+			return foreignKeyViolation("pr_reviewers_reviewer_id_fkey")
+		}
+
+		// новый ревьюер получает свой срок SLA
+		t.reviewers[i] = reviewerRow{PRID: prID, ReviewerID: newID, AssignedAt: r.store.timestamp()}
+		return nil
+	})
+}
+
+func (r *prRepo) GetByID(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
+	var res *model.PullRequest
+	err := r.store.read(ctx, func(t *tables) error {
+		row, ok := t.prs[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		res = t.pullRequest(row)
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) GetViewers(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	var res []uuid.UUID
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, rv := range t.reviewers {
+			if rv.PRID == id {
+				res = append(res, rv.ReviewerID)
+			}
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
+	var res *model.PullRequest
+	err := r.store.write(ctx, func(t *tables) error {
+		row, ok := t.prs[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+
+		if row.Status != "MERGED" {
+			mergedAt := r.store.timestamp()
+			row.Status = "MERGED"
+			row.MergedAt = &mergedAt
+			t.prs[id] = row
+		}
+
+		res = t.pullRequest(row)
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*model.PullRequestShort, error) {
+	res := make([]*model.PullRequestShort, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, rv := range t.reviewers {
+			if rv.ReviewerID != userID {
+				continue
+			}
+			row := t.prs[rv.PRID]
+			res = append(res, &model.PullRequestShort{
+				ID:         row.ID,
+				Name:       row.Name,
+				AuthorID:   row.AuthorID,
+				Status:     row.Status,
+				AssignedAt: nullTime(rv.AssignedAt),
+			})
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) CreateAssignments(ctx context.Context, list []*model.ReviewerAssignment) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, a := range list {
+			if err := t.checkPRAndUser(a.PRID, a.ReviewerID, "pr_reviewer_assignments"); err != nil {
+				return err
+			}
+			if !validReasons[a.Reason] {
+				return checkViolation("pr_reviewer_assignments_reason_check")
+			}
+			if a.UnassignedAt == nil && t.activeAssignmentIndex(a.PRID, a.ReviewerID) >= 0 {
+				return uniqueViolation("idx_pr_reviewer_assignments_active")
+			}
+
+			t.assignmentSeq++
+			row := assignmentRow{ID: t.assignmentSeq, ReviewerAssignment: *a}
+			row.AssignedAt = a.AssignedAt.Truncate(time.Microsecond)
+			if a.UnassignedAt != nil {
+				unassignedAt := a.UnassignedAt.Truncate(time.Microsecond)
+				row.UnassignedAt = &unassignedAt
+			}
+			row.ActorUserID = cloneUUID(a.ActorUserID)
+			t.assignments = append(t.assignments, row)
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) CloseAssignment(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	return r.store.write(ctx, func(t *tables) error {
+		i := t.activeAssignmentIndex(prID, reviewerID)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		now := r.store.timestamp()
+		t.assignments[i].UnassignedAt = &now
+		return nil
+	})
+}
+
+func (r *prRepo) MarkReviewed(ctx context.Context, prID, reviewerID uuid.UUID, at time.Time) (*model.PRReview, error) {
+	var res *model.PRReview
+	err := r.store.write(ctx, func(t *tables) error {
+		i := t.reviewerIndex(prID, reviewerID)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		if t.reviewers[i].ReviewedAt == nil {
+			reviewedAt := at.Truncate(time.Microsecond)
+			t.reviewers[i].ReviewedAt = &reviewedAt
+		}
+		res = &model.PRReview{PRID: prID, ReviewerID: reviewerID, ReviewedAt: *t.reviewers[i].ReviewedAt}
+		return nil
+	})
+	return res, err
+}
+
+// TryLockPR — транзакция в памяти держит хранилище целиком, PR всегда свободен
+func (r *prRepo) TryLockPR(context.Context, uuid.UUID) (bool, error) {
+	return true, nil
+}
+
+func (r *prRepo) GetStaleReviews(ctx context.Context, now time.Time, after *model.StaleReview, limit int) ([]*model.StaleReview, error) {
+	res := make([]*model.StaleReview, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, rv := range t.reviewers {
+			stale, ok := t.staleReview(rv, now)
+			if ok && (after == nil || compareStaleReviews(stale, after) > 0) {
+				res = append(res, stale)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, compareStaleReviews)
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (r *prRepo) GetStaleReview(ctx context.Context, prID, reviewerID uuid.UUID, now time.Time) (*model.StaleReview, error) {
+	var res *model.StaleReview
+	err := r.store.read(ctx, func(t *tables) error {
+		i := t.reviewerIndex(prID, reviewerID)
+		if i < 0 {
+			return pgx.ErrNoRows
+		}
+		stale, ok := t.staleReview(t.reviewers[i], now)
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		res = stale
+		return nil
+	})
+	return res, err
+}
+
+// compareStaleReviews повторяет ORDER BY assigned_at, pr_id, reviewer_id
+func compareStaleReviews(a, b *model.StaleReview) int {
+	if c := a.AssignedAt.Compare(b.AssignedAt); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.PRID[:], b.PRID[:]); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.ReviewerID[:], b.ReviewerID[:])
+}
+
+// staleReview повторяет условия staleReviewsQuery Postgres реализации
+func (t *tables) staleReview(rv reviewerRow, now time.Time) (*model.StaleReview, bool) {
+	pr := t.prs[rv.PRID]
+	user := t.users[rv.ReviewerID]
+	s, ok := t.slaSettings[user.TeamName]
+	if !ok || pr.Status != "OPEN" || rv.ReviewedAt != nil || rv.AssignedAt.IsZero() {
+		return nil, false
+	}
+	if s.AutoReassignHours <= 0 || rv.AssignedAt.Add(time.Duration(s.AutoReassignHours)*time.Hour).After(now) {
+		return nil, false
+	}
+
+	n := 0
+	for _, a := range t.assignments {
+		if a.PRID == rv.PRID && a.Reason == model.AssignmentTimeout {
+			n++
+		}
+	}
+	if n >= s.MaxAutoReassigns {
+		return nil, false
+	}
+
+	return &model.StaleReview{
+		PRID:              rv.PRID,
+		ReviewerID:        rv.ReviewerID,
+		TeamName:          user.TeamName,
+		AssignedAt:        rv.AssignedAt,
+		AutoReassignHours: s.AutoReassignHours,
+		AutoReassigns:     n,
+	}, true
+}
+
+func (r *prRepo) GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error) {
+	res := make([]*model.ReviewerAssignment, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		var rows []assignmentRow
+		for _, a := range t.assignments {
+			if a.PRID == prID {
+				rows = append(rows, a)
+			}
+		}
+		slices.SortStableFunc(rows, func(a, b assignmentRow) int {
+			if c := a.AssignedAt.Compare(b.AssignedAt); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ID, b.ID)
+		})
+
+		for _, a := range rows {
+			ra := a.ReviewerAssignment
+			ra.UnassignedAt = cloneTime(a.UnassignedAt)
+			ra.ActorUserID = cloneUUID(a.ActorUserID)
+			res = append(res, &ra)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (t *tables) pullRequest(row prRow) *model.PullRequest {
+	reviewers := make([]uuid.UUID, 0)
+	for _, rv := range t.reviewers {
+		if rv.PRID == row.ID {
+			reviewers = append(reviewers, rv.ReviewerID)
+		}
+	}
+
+	createdAt := row.CreatedAt
+	return &model.PullRequest{
+		ID:                row.ID,
+		Name:              row.Name,
+		AuthorID:          row.AuthorID,
+		Status:            row.Status,
+		AssignedReviewers: reviewers,
+		CreatedAt:         &createdAt,
+		MergedAt:          cloneTime(row.MergedAt),
+	}
+}
+
+func (t *tables) reviewerIndex(prID, reviewerID uuid.UUID) int {
+	return slices.IndexFunc(t.reviewers, func(rv reviewerRow) bool {
+		return rv.PRID == prID && rv.ReviewerID == reviewerID
+	})
+}
+
+func (t *tables) activeAssignmentIndex(prID, reviewerID uuid.UUID) int {
+	return slices.IndexFunc(t.assignments, func(a assignmentRow) bool {
+		return a.PRID == prID && a.ReviewerID == reviewerID && a.UnassignedAt == nil
+	})
+}
+
+func (t *tables) checkPRAndUser(prID, userID uuid.UUID, table string) error {
+	if _, ok := t.prs[prID]; !ok {
+		return foreignKeyViolation(table + "_pr_id_fkey")
+	}
+	if _, ok := t.users[userID]; !ok {
+		return foreignKeyViolation(table + "_reviewer_id_fkey")
+	}
+	return nil
+}
+
+// timestamp — аналог NOW(): Postgres хранит время с точностью до микросекунд
+func (s *Store) timestamp() time.Time {
+	return s.now().Truncate(time.Microsecond)
+}
@@ -0,0 +1,21 @@
+package memory
+
+import (
+	"context"
+
+	"PR/internal/repository"
+)
+
+type schemaRepo struct {
+	version uint
+}
+
+// NewSchemaRepository — схемы в памяти нет, поэтому она всегда считается
+// накатанной до переданной версии
+func NewSchemaRepository(version uint) repository.SchemaRepository {
+	return &schemaRepo{version: version}
+}
+
+func (r *schemaRepo) Version(context.Context) (uint, bool, error) {
+	return r.version, false, nil
+}
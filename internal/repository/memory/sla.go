@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type slaRepo struct {
+	store *Store
+}
+
+func NewSLARepository(store *Store) repository.SLARepository {
+	return &slaRepo{store: store}
+}
+
+func (r *slaRepo) SetTeamSettings(ctx context.Context, s *model.TeamSLA) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.teams[s.TeamName]; !ok {
+			return foreignKeyViolation("team_sla_settings_team_name_fkey")
+		}
+		if s.FirstReviewHours <= 0 {
+			return checkViolation("team_sla_settings_first_review_hours_check")
+		}
+		if s.ReminderIntervalHours < 0 {
+			return checkViolation("team_sla_settings_reminder_interval_hours_check")
+		}
+		if s.AutoReassignHours < 0 {
+			return checkViolation("team_sla_settings_auto_reassign_hours_check")
+		}
+		if s.MaxAutoReassigns < 0 {
+			return checkViolation("team_sla_settings_max_auto_reassigns_check")
+		}
+		row := *s
+		row.UpdatedAt = s.UpdatedAt.Truncate(time.Microsecond)
+		t.slaSettings[s.TeamName] = row
+		return nil
+	})
+}
+
+func (r *slaRepo) GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	var res *model.TeamSLA
+	err := r.store.read(ctx, func(t *tables) error {
+		s, ok := t.slaSettings[teamName]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		res = &s
+		return nil
+	})
+	return res, err
+}
+
+// GetDueReminders — как в Postgres реализации; блокировки не нужны,
+// транзакция и так держит хранилище целиком
+func (r *slaRepo) GetDueReminders(ctx context.Context, now time.Time, after *model.SLAReminder, limit int) ([]*model.SLAReminder, error) {
+	res := make([]*model.SLAReminder, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, rv := range t.reviewers {
+			pr := t.prs[rv.PRID]
+			user := t.users[rv.ReviewerID]
+			s, ok := t.slaSettings[user.TeamName]
+			if !ok || pr.Status != "OPEN" || rv.ReviewedAt != nil || rv.AssignedAt.IsZero() {
+				continue
+			}
+
+			if rv.AssignedAt.Add(s.FirstReview()).After(now) {
+				continue
+			}
+			if rv.RemindedAt != nil {
+				interval := time.Duration(s.ReminderIntervalHours) * time.Hour
+				if interval == 0 || rv.RemindedAt.Add(interval).After(now) {
+					continue
+				}
+			}
+
+			rem := &model.SLAReminder{
+				PRID:         rv.PRID,
+				PRName:       pr.Name,
+				AuthorID:     pr.AuthorID,
+				ReviewerID:   rv.ReviewerID,
+				ReviewerName: user.Username,
+				TeamName:     user.TeamName,
+				AssignedAt:   rv.AssignedAt,
+				RemindedAt:   cloneTime(rv.RemindedAt),
+				WebhookURL:   s.WebhookURL,
+				// срок по рабочему календарю считает сервис
+				FirstReviewHours:      s.FirstReviewHours,
+				ReminderIntervalHours: s.ReminderIntervalHours,
+			}
+			if after == nil || compareReminders(rem, after) > 0 {
+				res = append(res, rem)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, compareReminders)
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// compareReminders повторяет ORDER BY assigned_at, pr_id, reviewer_id
+func compareReminders(a, b *model.SLAReminder) int {
+	if c := a.AssignedAt.Compare(b.AssignedAt); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.PRID[:], b.PRID[:]); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.ReviewerID[:], b.ReviewerID[:])
+}
+
+func (r *slaRepo) MarkReminded(ctx context.Context, list []*model.SLAReminder, at time.Time) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, rem := range list {
+			if i := t.reviewerIndex(rem.PRID, rem.ReviewerID); i >= 0 {
+				remindedAt := at.Truncate(time.Microsecond)
+				t.reviewers[i].RemindedAt = &remindedAt
+			}
+		}
+		return nil
+	})
+}
+
+func (r *slaRepo) ListTeamSettings(ctx context.Context) ([]*model.TeamSLA, error) {
+	res := make([]*model.TeamSLA, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, s := range t.slaSettings {
+			res = append(res, &s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(res, func(a, b *model.TeamSLA) int {
+		return strings.Compare(a.TeamName, b.TeamName)
+	})
+	return res, nil
+}
+
+func (r *slaRepo) GetAssignments(ctx context.Context) ([]*model.SLAAssignment, error) {
+	res := make([]*model.SLAAssignment, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, rv := range t.reviewers {
+			team := t.users[rv.ReviewerID].TeamName
+			if _, ok := t.slaSettings[team]; !ok || rv.AssignedAt.IsZero() {
+				continue
+			}
+
+			answered := rv.ReviewedAt
+			if answered == nil {
+				answered = t.prs[rv.PRID].MergedAt
+			}
+			res = append(res, &model.SLAAssignment{
+				TeamName:   team,
+				ReviewerID: rv.ReviewerID,
+				AssignedAt: rv.AssignedAt,
+				AnsweredAt: cloneTime(answered),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(res, func(a, b *model.SLAAssignment) int {
+		if c := strings.Compare(a.TeamName, b.TeamName); c != 0 {
+			return c
+		}
+		return a.AssignedAt.Compare(b.AssignedAt)
+	})
+	return res, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type statisticsRepo struct {
+	store *Store
+}
+
+func NewStatisticsRepository(store *Store) repository.StatisticsRepository {
+	return &statisticsRepo{store: store}
+}
+
+func (r *statisticsRepo) GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error) {
+	var stats []*model.ReviewerStats
+	err := r.store.read(ctx, func(t *tables) error {
+		stats = t.reviewerStats(f)
+		return nil
+	})
+	return stats, err
+}
+
+func (r *statisticsRepo) GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error) {
+	var stats []*model.PRStats
+	err := r.store.read(ctx, func(t *tables) error {
+		stats = t.prStats(f)
+		return nil
+	})
+	return stats, err
+}
+
+// Stream считает статистику под блокировкой, а fn вызывает уже после нее,
+// чтобы медленный клиент не держал хранилище
+func (r *statisticsRepo) StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error {
+	stats, err := r.GetReviewerStatistics(ctx, f)
+	if err != nil {
+		return err
+	}
+	return stream(stats, fn)
+}
+
+func (r *statisticsRepo) StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error {
+	stats, err := r.GetPRStatistics(ctx, f)
+	if err != nil {
+		return err
+	}
+	return stream(stats, fn)
+}
+
+func stream[T any](rows []*T, fn func(*T) error) error {
+	for _, row := range rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reviewerStats: без истории — текущие назначения, с историей — PR,
+// на которые ревьюер был назначен хотя бы раз
+func (t *tables) reviewerStats(f model.StatisticsFilter) []*model.ReviewerStats {
+	prsByReviewer := make(map[uuid.UUID]map[uuid.UUID]struct{})
+	add := func(reviewerID, prID uuid.UUID) {
+		if prsByReviewer[reviewerID] == nil {
+			prsByReviewer[reviewerID] = make(map[uuid.UUID]struct{})
+		}
+		prsByReviewer[reviewerID][prID] = struct{}{}
+	}
+	if f.IncludeHistory {
+		for _, a := range t.assignments {
+			add(a.ReviewerID, a.PRID)
+		}
+	} else {
+		for _, rv := range t.reviewers {
+			add(rv.ReviewerID, rv.PRID)
+		}
+	}
+
+	var stats []*model.ReviewerStats
+	for _, u := range t.users {
+		stats = append(stats, &model.ReviewerStats{
+			ReviewerID:    u.ID,
+			ReviewerName:  u.Username,
+			AssignedCount: len(prsByReviewer[u.ID]),
+		})
+	}
+	slices.SortFunc(stats, func(a, b *model.ReviewerStats) int {
+		if c := cmp.Compare(b.AssignedCount, a.AssignedCount); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ReviewerName, b.ReviewerName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ReviewerID.String(), b.ReviewerID.String())
+	})
+	return stats
+}
+
+func (t *tables) prStats(f model.StatisticsFilter) []*model.PRStats {
+	reviewersByPR := make(map[uuid.UUID]map[uuid.UUID]struct{})
+	add := func(prID, reviewerID uuid.UUID) {
+		if reviewersByPR[prID] == nil {
+			reviewersByPR[prID] = make(map[uuid.UUID]struct{})
+		}
+		reviewersByPR[prID][reviewerID] = struct{}{}
+	}
+	if f.IncludeHistory {
+		for _, a := range t.assignments {
+			add(a.PRID, a.ReviewerID)
+		}
+	} else {
+		for _, rv := range t.reviewers {
+			add(rv.PRID, rv.ReviewerID)
+		}
+	}
+
+	var stats []*model.PRStats
+	for _, p := range t.prs {
+		stats = append(stats, &model.PRStats{
+			PRID:          p.ID,
+			PRName:        p.Name,
+			Status:        p.Status,
+			ReviewerCount: len(reviewersByPR[p.ID]),
+		})
+	}
+	slices.SortFunc(stats, func(a, b *model.PRStats) int {
+		if c := cmp.Compare(b.ReviewerCount, a.ReviewerCount); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.PRName, b.PRName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PRID.String(), b.PRID.String())
+	})
+	return stats
+}
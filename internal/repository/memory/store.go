@@ -0,0 +1,198 @@
+// Package memory — репозитории в памяти процесса для тестов и демо-режима
+// (STORAGE=memory). Повторяют поведение Postgres реализаций: те же ошибки
+// pgx.ErrNoRows и коды нарушений ограничений, на которые опираются сервисы.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"PR/internal/model"
+)
+
+type txKey struct{}
+
+// Store — общее хранилище для всех репозиториев в памяти. Все операции
+// выполняются под одним мьютексом, транзакция держит его до конца
+type Store struct {
+	mu   sync.Mutex
+	data *tables
+	now  func() time.Time
+}
+
+type tables struct {
+	teams       map[string]uuid.UUID
+	users       map[uuid.UUID]model.User
+	prs         map[uuid.UUID]prRow
+	reviewers   []reviewerRow
+	assignments []assignmentRow
+	// последовательность для id истории назначений, как BIGSERIAL
+	assignmentSeq int64
+
+	apiKeys     map[uuid.UUID]apiKeyRow
+	audit       []model.AuditEvent
+	idempotency map[idempotencyKey]model.IdempotencyRecord
+	slaSettings map[string]model.TeamSLA
+
+	// у команд без строки рабочее время по умолчанию, как DEFAULT в схеме
+	teamHours map[string]model.WorkingHours
+	userHours map[uuid.UUID]model.WorkingHours
+	holidays  []model.Holiday
+}
+
+type prRow struct {
+	ID        uuid.UUID
+	Name      string
+	AuthorID  uuid.UUID
+	Status    string
+	CreatedAt time.Time
+	MergedAt  *time.Time
+}
+
+type reviewerRow struct {
+	PRID       uuid.UUID
+	ReviewerID uuid.UUID
+	AssignedAt time.Time
+	ReviewedAt *time.Time
+	RemindedAt *time.Time
+}
+
+type assignmentRow struct {
+	ID int64
+	model.ReviewerAssignment
+}
+
+type apiKeyRow struct {
+	model.APIKey
+	Hash string
+}
+
+type idempotencyKey struct {
+	scope, key string
+}
+
+func NewStore() *Store {
+	return &Store{data: newTables(), now: time.Now}
+}
+
+func newTables() *tables {
+	return &tables{
+		teams:       make(map[string]uuid.UUID),
+		users:       make(map[uuid.UUID]model.User),
+		prs:         make(map[uuid.UUID]prRow),
+		apiKeys:     make(map[uuid.UUID]apiKeyRow),
+		idempotency: make(map[idempotencyKey]model.IdempotencyRecord),
+		slaSettings: make(map[string]model.TeamSLA),
+		teamHours:   make(map[string]model.WorkingHours),
+		userHours:   make(map[uuid.UUID]model.WorkingHours),
+	}
+}
+
+// Reset очищает все таблицы, аналог TRUNCATE в тестах
+func (s *Store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = newTables()
+}
+
+// Ping — хранилище в памяти всегда доступно
+func (s *Store) Ping(context.Context) error {
+	return nil
+}
+
+// read и write выполняют fn под мьютексом хранилища. Внутри транзакции
+// мьютекс уже захвачен менеджером, поэтому повторно не берется
+func (s *Store) read(ctx context.Context, fn func(t *tables) error) error {
+	if s.inTx(ctx) {
+		return fn(s.data)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.data)
+}
+
+// write вне транзакции атомарна, как одиночный запрос: при ошибке изменения откатываются
+func (s *Store) write(ctx context.Context, fn func(t *tables) error) error {
+	if s.inTx(ctx) {
+		return fn(s.data)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.data.clone()
+	if err := fn(s.data); err != nil {
+		s.data = snapshot
+		return err
+	}
+	return nil
+}
+
+func (s *Store) inTx(ctx context.Context) bool {
+	tx, ok := ctx.Value(txKey{}).(*Store)
+	return ok && tx == s
+}
+
+func (t *tables) clone() *tables {
+	c := &tables{
+		teams:         maps.Clone(t.teams),
+		users:         maps.Clone(t.users),
+		prs:           maps.Clone(t.prs),
+		reviewers:     slices.Clone(t.reviewers),
+		assignments:   slices.Clone(t.assignments),
+		assignmentSeq: t.assignmentSeq,
+		apiKeys:       maps.Clone(t.apiKeys),
+		audit:         slices.Clone(t.audit),
+		idempotency:   maps.Clone(t.idempotency),
+		slaSettings:   maps.Clone(t.slaSettings),
+		teamHours:     maps.Clone(t.teamHours),
+		userHours:     maps.Clone(t.userHours),
+		holidays:      slices.Clone(t.holidays),
+	}
+	// указатели и срезы внутри строк не меняются на месте, а только заменяются,
+	// поэтому копии самих таблиц достаточно для отката
+	return c
+}
+
+// uniqueViolation и foreignKeyViolation повторяют ошибки Postgres,
+// сервисы различают их по коду
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{Code: "23505", ConstraintName: constraint, Message: "duplicate key value violates unique constraint"}
+}
+
+func checkViolation(constraint string) error {
+	return &pgconn.PgError{Code: "23514", ConstraintName: constraint, Message: "new row violates check constraint"}
+}
+
+func foreignKeyViolation(constraint string) error {
+	return &pgconn.PgError{Code: "23503", ConstraintName: constraint, Message: "insert or update violates foreign key constraint"}
+}
+
+func cloneJSON(b json.RawMessage) json.RawMessage {
+	if b == nil {
+		return nil
+	}
+	return slices.Clone(b)
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+func cloneUUID(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	c := *id
+	return &c
+}
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type teamRepo struct {
+	store *Store
+}
+
+func NewTeamRepository(store *Store) repository.TeamRepository {
+	return &teamRepo{store: store}
+}
+
+func (r *teamRepo) CreateTeam(ctx context.Context, teamName string) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.teams[teamName]; ok {
+			return uniqueViolation("teams_team_name_key")
+		}
+		t.teams[teamName] = uuid.New()
+		return nil
+	})
+}
+
+// CreateMembers обновляет существующих пользователей по id, как ON CONFLICT DO UPDATE
+func (r *teamRepo) CreateMembers(ctx context.Context, team *model.Team) error {
+	return r.store.write(ctx, func(t *tables) error {
+		if _, ok := t.teams[team.TeamName]; !ok && len(team.Members) > 0 {
+			return foreignKeyViolation("users_team_name_fkey")
+		}
+		for _, m := range team.Members {
+			t.users[m.ID] = model.User{
+				ID:       m.ID,
+				Username: m.Username,
+				TeamName: team.TeamName,
+				IsActive: m.IsActive,
+			}
+		}
+		return nil
+	})
+}
+
+func (r *teamRepo) CreateTeams(ctx context.Context, names []string) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, name := range names {
+			if _, ok := t.teams[name]; ok {
+				return uniqueViolation("teams_team_name_key")
+			}
+			t.teams[name] = uuid.New()
+		}
+		return nil
+	})
+}
+
+func (r *teamRepo) GetExistingNames(ctx context.Context, names []string) ([]string, error) {
+	res := make([]string, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, name := range names {
+			if _, ok := t.teams[name]; ok && !slices.Contains(res, name) {
+				res = append(res, name)
+			}
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *teamRepo) GetTeamIDByName(ctx context.Context, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.store.read(ctx, func(t *tables) error {
+		var ok bool
+		id, ok = t.teams[name]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+	return id, err
+}
+
+func (r *teamRepo) GetTeamByName(ctx context.Context, name string) (*model.Team, error) {
+	members := make([]*model.TeamMember, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, u := range t.usersByTeam(name, false) {
+			members = append(members, &model.TeamMember{ID: u.ID, Username: u.Username, IsActive: u.IsActive})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Team{TeamName: name, Members: members}, nil
+}
+
+// usersByTeam возвращает пользователей команды в стабильном порядке:
+// в Postgres порядок не задан, а здесь он не должен зависеть от обхода map
+func (t *tables) usersByTeam(name string, activeOnly bool) []model.User {
+	var res []model.User
+	for _, u := range t.users {
+		if u.TeamName == name && (!activeOnly || u.IsActive) {
+			res = append(res, u)
+		}
+	}
+	sortUsers(res)
+	return res
+}
+
+func sortUsers(users []model.User) {
+	slices.SortFunc(users, func(a, b model.User) int {
+		if c := strings.Compare(a.Username, b.Username); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"PR/internal/client/db"
+)
+
+type txManager struct {
+	store *Store
+}
+
+// NewTxManager — транзакции над Store. Транзакция захватывает хранилище целиком,
+// поэтому все уровни изоляции фактически serializable и конфликтов не бывает;
+// при ошибке или панике в f все изменения откатываются
+func NewTxManager(store *Store) db.TxManager {
+	return &txManager{store: store}
+}
+
+func (m *txManager) transaction(ctx context.Context, fn db.Handler) error {
+	if m.store.inTx(ctx) {
+		return fn(ctx)
+	}
+
+	ctx, runAfterCommit := db.WithAfterCommit(ctx)
+	if err := m.run(ctx, fn); err != nil {
+		return err
+	}
+	// хуки выполняются уже после освобождения хранилища
+	runAfterCommit()
+	return nil
+}
+
+func (m *txManager) run(ctx context.Context, fn db.Handler) (err error) {
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	snapshot := m.store.data.clone()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic recovered: %v", r)
+		}
+		if err != nil {
+			m.store.data = snapshot
+		}
+	}()
+
+	return fn(context.WithValue(ctx, txKey{}, m.store))
+}
+
+func (m *txManager) ReadCommited(ctx context.Context, f db.Handler) error {
+	return m.transaction(ctx, f)
+}
+
+func (m *txManager) RepeatableRead(ctx context.Context, f db.Handler) error {
+	return m.transaction(ctx, f)
+}
+
+func (m *txManager) Serializable(ctx context.Context, f db.Handler) error {
+	return m.transaction(ctx, f)
+}
@@ -0,0 +1,132 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/repository/memory"
+)
+
+func TestTxManager(t *testing.T) {
+	ctx := context.Background()
+	errFail := errors.New("fail")
+
+	tests := []struct {
+		name    string
+		fn      func(ctx context.Context, create func(ctx context.Context, name string)) error
+		wantErr bool
+	}{
+		{
+			name: "commit",
+			fn: func(ctx context.Context, create func(context.Context, string)) error {
+				create(ctx, "alpha")
+				return nil
+			},
+		},
+		{
+			name: "rollback on error",
+			fn: func(ctx context.Context, create func(context.Context, string)) error {
+				create(ctx, "alpha")
+				return errFail
+			},
+			wantErr: true,
+		},
+		{
+			name: "rollback on panic",
+			fn: func(ctx context.Context, create func(context.Context, string)) error {
+				create(ctx, "alpha")
+				panic("boom")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			tx := memory.NewTxManager(store)
+			teams := memory.NewTeamRepository(store)
+
+			create := func(ctx context.Context, name string) {
+				require.NoError(t, teams.CreateTeam(ctx, name))
+			}
+			err := tx.ReadCommited(ctx, func(ctx context.Context) error {
+				return tt.fn(ctx, create)
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = teams.GetTeamIDByName(ctx, "alpha")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, pgx.ErrNoRows)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTxManager_Nested(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	tx := memory.NewTxManager(store)
+	teams := memory.NewTeamRepository(store)
+
+	err := tx.Serializable(ctx, func(ctx context.Context) error {
+		require.NoError(t, teams.CreateTeam(ctx, "outer"))
+
+		// вложенная транзакция не захватывает блокировку повторно
+		return tx.ReadCommited(ctx, func(ctx context.Context) error {
+			return teams.CreateTeam(ctx, "inner")
+		})
+	})
+	require.NoError(t, err)
+
+	for _, name := range []string{"outer", "inner"} {
+		_, err := teams.GetTeamIDByName(ctx, name)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestTxManager_AfterCommit(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	tx := memory.NewTxManager(store)
+	teams := memory.NewTeamRepository(store)
+
+	var calls []string
+	err := tx.Serializable(ctx, func(ctx context.Context) error {
+		db.AfterCommit(ctx, func() { calls = append(calls, "outer") })
+
+		return tx.ReadCommited(ctx, func(ctx context.Context) error {
+			// вложенная транзакция откладывает хук до коммита внешней
+			db.AfterCommit(ctx, func() {
+				// хранилище уже освобождено
+				_, err := teams.GetTeamIDByName(context.Background(), "alpha")
+				assert.ErrorIs(t, err, pgx.ErrNoRows)
+				calls = append(calls, "inner")
+			})
+			assert.Empty(t, calls)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+
+	calls = nil
+	err = tx.ReadCommited(ctx, func(ctx context.Context) error {
+		db.AfterCommit(ctx, func() { calls = append(calls, "rolled back") })
+		return errors.New("fail")
+	})
+	require.Error(t, err)
+	assert.Empty(t, calls)
+}
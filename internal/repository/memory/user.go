@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+type userRepo struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) repository.UserRepository {
+	return &userRepo{store: store}
+}
+
+func (r *userRepo) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	var res *model.User
+	err := r.store.read(ctx, func(t *tables) error {
+		u, ok := t.users[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		res = &u
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) GetActiveByTeam(ctx context.Context, teamName string) ([]*model.User, error) {
+	res := make([]*model.User, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, u := range t.usersByTeam(teamName, true) {
+			res = append(res, &u)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error) {
+	res := make([]*model.User, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		var found []model.User
+		for _, id := range ids {
+			if u, ok := t.users[id]; ok && !slices.ContainsFunc(found, func(f model.User) bool { return f.ID == id }) {
+				found = append(found, u)
+			}
+		}
+		sortUsers(found)
+		for _, u := range found {
+			res = append(res, &u)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) GetByTeams(ctx context.Context, teamNames []string) ([]*model.User, error) {
+	res := make([]*model.User, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		var found []model.User
+		for _, u := range t.users {
+			if slices.Contains(teamNames, u.TeamName) {
+				found = append(found, u)
+			}
+		}
+		sortUsers(found)
+		for _, u := range found {
+			res = append(res, &u)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) Upsert(ctx context.Context, users []*model.User) error {
+	return r.store.write(ctx, func(t *tables) error {
+		for _, u := range users {
+			if _, ok := t.teams[u.TeamName]; !ok {
+				return foreignKeyViolation("users_team_name_fkey")
+			}
+			t.users[u.ID] = *u
+		}
+		return nil
+	})
+}
+
+func (r *userRepo) SetActive(ctx context.Context, req *model.UserSetActive) error {
+	return r.store.write(ctx, func(t *tables) error {
+		u, ok := t.users[req.UserID]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		u.IsActive = req.IsActive
+		t.users[req.UserID] = u
+		return nil
+	})
+}
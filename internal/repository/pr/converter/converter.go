@@ -19,10 +19,11 @@ func FromRepo(pr *repoModel.PullRequest) *serviceModel.PullRequest {
 
 func FromRepoShort(pr *repoModel.PullRequestShort) *serviceModel.PullRequestShort {
 	return &serviceModel.PullRequestShort{
-		ID:       pr.ID,
-		Name:     pr.Name,
-		AuthorID: pr.AuthorID,
-		Status:   pr.Status,
+		ID:         pr.ID,
+		Name:       pr.Name,
+		AuthorID:   pr.AuthorID,
+		Status:     pr.Status,
+		AssignedAt: pr.AssignedAt,
 	}
 }
 
@@ -33,3 +34,53 @@ func FromRepoShortList(prs []*repoModel.PullRequestShort) []*serviceModel.PullRe
 	}
 	return prList
 }
+
+func FromRepoAssignment(a *repoModel.ReviewerAssignment) *serviceModel.ReviewerAssignment {
+	res := &serviceModel.ReviewerAssignment{
+		PRID:         a.PRID,
+		ReviewerID:   a.ReviewerID,
+		AssignedAt:   a.AssignedAt,
+		UnassignedAt: a.UnassignedAt,
+		Reason:       a.Reason,
+		ActorUserID:  a.ActorUserID,
+	}
+	if a.ActorName != nil {
+		res.ActorName = *a.ActorName
+	}
+	return res
+}
+
+func FromRepoAssignmentList(list []*repoModel.ReviewerAssignment) []*serviceModel.ReviewerAssignment {
+	res := make([]*serviceModel.ReviewerAssignment, 0, len(list))
+	for _, a := range list {
+		res = append(res, FromRepoAssignment(a))
+	}
+	return res
+}
+
+func FromRepoReview(r *repoModel.Review) *serviceModel.PRReview {
+	return &serviceModel.PRReview{
+		PRID:       r.PRID,
+		ReviewerID: r.ReviewerID,
+		ReviewedAt: r.ReviewedAt,
+	}
+}
+
+func FromRepoStaleReview(r *repoModel.StaleReview) *serviceModel.StaleReview {
+	return &serviceModel.StaleReview{
+		PRID:              r.PRID,
+		ReviewerID:        r.ReviewerID,
+		TeamName:          r.TeamName,
+		AssignedAt:        r.AssignedAt,
+		AutoReassignHours: r.AutoReassignHours,
+		AutoReassigns:     r.AutoReassigns,
+	}
+}
+
+func FromRepoStaleReviews(list []*repoModel.StaleReview) []*serviceModel.StaleReview {
+	res := make([]*serviceModel.StaleReview, 0, len(list))
+	for _, r := range list {
+		res = append(res, FromRepoStaleReview(r))
+	}
+	return res
+}
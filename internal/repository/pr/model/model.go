@@ -17,8 +17,34 @@ type PullRequest struct {
 }
 
 type PullRequestShort struct {
-	ID       uuid.UUID `db:"id"`
-	Name     string    `db:"name"`
-	AuthorID uuid.UUID `db:"author_id"`
-	Status   string    `db:"status"`
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	AuthorID   uuid.UUID  `db:"author_id"`
+	Status     string     `db:"status"`
+	AssignedAt *time.Time `db:"assigned_at"`
+}
+
+type ReviewerAssignment struct {
+	PRID         uuid.UUID  `db:"pr_id"`
+	ReviewerID   uuid.UUID  `db:"reviewer_id"`
+	AssignedAt   time.Time  `db:"assigned_at"`
+	UnassignedAt *time.Time `db:"unassigned_at"`
+	Reason       string     `db:"reason"`
+	ActorName    *string    `db:"actor_name"`
+	ActorUserID  *uuid.UUID `db:"actor_user_id"`
+}
+
+type StaleReview struct {
+	PRID              uuid.UUID `db:"pr_id"`
+	ReviewerID        uuid.UUID `db:"reviewer_id"`
+	TeamName          string    `db:"team_name"`
+	AssignedAt        time.Time `db:"assigned_at"`
+	AutoReassignHours int       `db:"auto_reassign_hours"`
+	AutoReassigns     int       `db:"auto_reassigns"`
+}
+
+type Review struct {
+	PRID       uuid.UUID `db:"pr_id"`
+	ReviewerID uuid.UUID `db:"reviewer_id"`
+	ReviewedAt time.Time `db:"reviewed_at"`
 }
@@ -2,6 +2,7 @@ package pr
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -26,7 +27,7 @@ func (r *repo) CreatePR(ctx context.Context, pr *serviceModel.PullRequest) error
 				VALUES ($1, $2, $3, $4, NOW())`
 
 	args := []any{pr.ID, pr.Name, pr.AuthorID, pr.Status}
-	_, err := r.db.DB().ExecContext(ctx, db.Query{QueryRaw: query}, args...)
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.CreatePR", QueryRaw: query}, args...)
 	if err != nil {
 		return err
 	}
@@ -39,7 +40,7 @@ func (r *repo) CreatePRReviewers(ctx context.Context, pr *serviceModel.PullReque
 
 	for _, revID := range pr.AssignedReviewers {
 		args := []any{pr.ID, revID}
-		_, err := r.db.DB().ExecContext(ctx, db.Query{QueryRaw: query}, args...)
+		_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.CreatePRReviewers", QueryRaw: query}, args...)
 		if err != nil {
 			return err
 		}
@@ -69,7 +70,7 @@ func (r *repo) GetByID(ctx context.Context, id uuid.UUID) (*serviceModel.PullReq
 	var pr repoModel.PullRequest
 	var reviewers []uuid.UUID
 
-	err := r.db.DB().QueryRowContext(ctx, db.Query{QueryRaw: query}, id).Scan(
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "pr.GetByID", QueryRaw: query}, id).Scan(
 		&pr.ID,
 		&pr.Name,
 		&pr.AuthorID,
@@ -94,7 +95,7 @@ func (r *repo) GetViewers(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error
 		FROM pr_reviewers
 		WHERE pr_id = $1
 	`
-	err := r.db.DB().ScanAllContext(ctx, &userIDs, db.Query{QueryRaw: query}, id)
+	err := r.db.DB().ScanAllContext(ctx, &userIDs, db.Query{Name: "pr.GetViewers", QueryRaw: query}, id)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (r *repo) Merge(ctx context.Context, id uuid.UUID) (*serviceModel.PullReque
 	checkQuery := `SELECT status FROM prs WHERE id = $1`
 
 	var currentStatus string
-	err := r.db.DB().QueryRowContext(ctx, db.Query{QueryRaw: checkQuery}, id).Scan(&currentStatus)
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "pr.MergeStatus", QueryRaw: checkQuery}, id).Scan(&currentStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +134,7 @@ func (r *repo) Merge(ctx context.Context, id uuid.UUID) (*serviceModel.PullReque
 	var pr repoModel.PullRequest
 	var reviewers []uuid.UUID
 
-	err = r.db.DB().QueryRowContext(ctx, db.Query{QueryRaw: query}, id).Scan(
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "pr.Merge", QueryRaw: query}, id).Scan(
 		&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status,
 		&pr.CreatedAt, &pr.MergedAt, &reviewers,
 	)
@@ -149,11 +150,11 @@ func (r *repo) Merge(ctx context.Context, id uuid.UUID) (*serviceModel.PullReque
 func (r *repo) ReassignReviewers(ctx context.Context, prID, oldID, newID uuid.UUID) error {
 	query := `
         UPDATE pr_reviewers
-        SET reviewer_id = $1
+        SET reviewer_id = $1, assigned_at = NOW(), reviewed_at = NULL, reminded_at = NULL
         WHERE reviewer_id = $2 AND pr_id = $3
     `
 
-	result, err := r.db.DB().ExecContext(ctx, db.Query{QueryRaw: query}, newID, oldID, prID)
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.ReassignReviewers", QueryRaw: query}, newID, oldID, prID)
 	if err != nil {
 		return err
 	}
@@ -168,15 +169,36 @@ func (r *repo) ReassignReviewers(ctx context.Context, prID, oldID, newID uuid.UU
 
 }
 
+func (r *repo) AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	query := `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+				VALUES($1, $2, NOW())`
+
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.AddReviewer", QueryRaw: query}, prID, reviewerID)
+	return err
+}
+
+func (r *repo) RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	query := `DELETE FROM pr_reviewers WHERE pr_id = $1 AND reviewer_id = $2`
+
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.RemoveReviewer", QueryRaw: query}, prID, reviewerID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
 func (r *repo) GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*serviceModel.PullRequestShort, error) {
 	query := `
-		SELECT p.id, p.name, p.author_id, p.status
+		SELECT p.id, p.name, p.author_id, p.status, pr.assigned_at
 		FROM pr_reviewers pr
 		INNER JOIN prs p ON p.id = pr.pr_id
 		WHERE pr.reviewer_id = $1
 	`
 	var prs []*repoModel.PullRequestShort
-	err := r.db.DB().ScanAllContext(ctx, &prs, db.Query{QueryRaw: query}, userID)
+	err := r.db.DB().ScanAllContext(ctx, &prs, db.Query{Name: "pr.GetByReviewer", QueryRaw: query}, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -184,3 +206,163 @@ func (r *repo) GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*serviceM
 	return converter.FromRepoShortList(prs), nil
 
 }
+
+func (r *repo) CreateAssignments(ctx context.Context, list []*serviceModel.ReviewerAssignment) error {
+	if len(list) == 0 {
+		return nil
+	}
+	// одна вставка на весь список; ORDER BY сохраняет порядок id как в списке
+	query := `
+		INSERT INTO pr_reviewer_assignments (pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id)
+		SELECT pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id
+		FROM unnest($1::uuid[], $2::uuid[], $3::timestamptz[], $4::timestamptz[], $5::text[], $6::text[], $7::uuid[])
+			WITH ORDINALITY AS t(pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id, n)
+		ORDER BY n
+	`
+
+	prIDs := make([]uuid.UUID, 0, len(list))
+	reviewerIDs := make([]uuid.UUID, 0, len(list))
+	assignedAt := make([]time.Time, 0, len(list))
+	unassignedAt := make([]*time.Time, 0, len(list))
+	reasons := make([]string, 0, len(list))
+	actorNames := make([]*string, 0, len(list))
+	actorUserIDs := make([]*uuid.UUID, 0, len(list))
+	for _, a := range list {
+		var actorName *string
+		if a.ActorName != "" {
+			actorName = &a.ActorName
+		}
+		prIDs = append(prIDs, a.PRID)
+		reviewerIDs = append(reviewerIDs, a.ReviewerID)
+		assignedAt = append(assignedAt, a.AssignedAt)
+		unassignedAt = append(unassignedAt, a.UnassignedAt)
+		reasons = append(reasons, a.Reason)
+		actorNames = append(actorNames, actorName)
+		actorUserIDs = append(actorUserIDs, a.ActorUserID)
+	}
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.CreateAssignments", QueryRaw: query},
+		prIDs, reviewerIDs, assignedAt, unassignedAt, reasons, actorNames, actorUserIDs)
+	return err
+}
+
+func (r *repo) CloseAssignment(ctx context.Context, prID, reviewerID uuid.UUID) error {
+	query := `
+		UPDATE pr_reviewer_assignments
+		SET unassigned_at = NOW()
+		WHERE pr_id = $1 AND reviewer_id = $2 AND unassigned_at IS NULL
+	`
+
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "pr.CloseAssignment", QueryRaw: query}, prID, reviewerID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// MarkReviewed сохраняет время первого ревью; повторная отметка его не меняет
+func (r *repo) MarkReviewed(ctx context.Context, prID, reviewerID uuid.UUID, at time.Time) (*serviceModel.PRReview, error) {
+	query := `
+		UPDATE pr_reviewers
+		SET reviewed_at = COALESCE(reviewed_at, $3)
+		WHERE pr_id = $1 AND reviewer_id = $2
+		RETURNING pr_id, reviewer_id, reviewed_at
+	`
+
+	var review repoModel.Review
+	err := r.db.DB().ScanOneContext(ctx, &review, db.Query{Name: "pr.MarkReviewed", QueryRaw: query}, prID, reviewerID, at)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoReview(&review), nil
+}
+
+// prLockSpace — первый ключ advisory блокировок PR, второй — хэш его id
+const prLockSpace int32 = 0x5052
+
+// TryLockPR берет advisory блокировку PR до конца транзакции, не дожидаясь ее.
+// false — PR сейчас обрабатывает другая реплика. Вне транзакции смысла не имеет
+func (r *repo) TryLockPR(ctx context.Context, prID uuid.UUID) (bool, error) {
+	query := `SELECT pg_try_advisory_xact_lock($1, hashtext($2::text))`
+
+	var locked bool
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "pr.TryLockPR", QueryRaw: query}, prLockSpace, prID).Scan(&locked)
+	return locked, err
+}
+
+// staleReviewsQuery — назначения без ревью на открытых PR дольше auto_reassign_hours
+// команды ревьюера по часам, если PR еще не исчерпал max_auto_reassigns. Рабочие
+// часы ревьюера проверяет сервис
+const staleReviewsQuery = `
+	SELECT rv.pr_id, rv.reviewer_id, u.team_name, rv.assigned_at, s.auto_reassign_hours, ar.n AS auto_reassigns
+	FROM pr_reviewers rv
+	INNER JOIN prs p ON p.id = rv.pr_id
+	INNER JOIN users u ON u.id = rv.reviewer_id
+	INNER JOIN team_sla_settings s ON s.team_name = u.team_name
+	CROSS JOIN LATERAL (
+		SELECT COUNT(*) AS n
+		FROM pr_reviewer_assignments a
+		WHERE a.pr_id = rv.pr_id AND a.reason = 'timeout'
+	) ar
+	WHERE p.status = 'OPEN'
+		AND rv.reviewed_at IS NULL
+		AND s.auto_reassign_hours > 0
+		AND ar.n < s.max_auto_reassigns
+		AND rv.assigned_at + make_interval(hours => s.auto_reassign_hours) <= $1
+`
+
+// GetStaleReviews отдает страницу просроченных назначений в порядке assigned_at.
+// after — последнее назначение предыдущей страницы, nil — с начала
+func (r *repo) GetStaleReviews(ctx context.Context, now time.Time, after *serviceModel.StaleReview, limit int) ([]*serviceModel.StaleReview, error) {
+	query := staleReviewsQuery
+	args := []any{now, limit}
+	if after != nil {
+		query += `
+		AND (rv.assigned_at, rv.pr_id, rv.reviewer_id) > ($3, $4, $5)`
+		args = append(args, after.AssignedAt, after.PRID, after.ReviewerID)
+	}
+	query += `
+		ORDER BY rv.assigned_at, rv.pr_id, rv.reviewer_id
+		LIMIT $2
+	`
+
+	var list []*repoModel.StaleReview
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "pr.GetStaleReviews", QueryRaw: query}, args...)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoStaleReviews(list), nil
+}
+
+// GetStaleReview перепроверяет одно назначение; pgx.ErrNoRows — оно уже не просрочено
+func (r *repo) GetStaleReview(ctx context.Context, prID, reviewerID uuid.UUID, now time.Time) (*serviceModel.StaleReview, error) {
+	query := staleReviewsQuery + `
+		AND rv.pr_id = $2 AND rv.reviewer_id = $3
+	`
+
+	var stale repoModel.StaleReview
+	err := r.db.DB().ScanOneContext(ctx, &stale, db.Query{Name: "pr.GetStaleReview", QueryRaw: query}, now, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoStaleReview(&stale), nil
+}
+
+func (r *repo) GetHistory(ctx context.Context, prID uuid.UUID) ([]*serviceModel.ReviewerAssignment, error) {
+	query := `
+		SELECT pr_id, reviewer_id, assigned_at, unassigned_at, reason, actor_name, actor_user_id
+		FROM pr_reviewer_assignments
+		WHERE pr_id = $1
+		ORDER BY assigned_at, id
+	`
+
+	var list []*repoModel.ReviewerAssignment
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "pr.GetHistory", QueryRaw: query}, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoAssignmentList(list), nil
+}
@@ -11,74 +11,50 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	"PR/internal/client/db"
+	"PR/internal/client/db/pg"
 	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
 	testingpkg "PR/internal/repository/testing"
 )
 
 type PullRequestRepositoryTestSuite struct {
 	suite.Suite
-	testDB *testingpkg.TestDatabase
-	repo   *repo
+	backend *testingpkg.Backend
+	repo    repository.PullRequestRepository
+	users   map[string]uuid.UUID
 }
 
 func TestPullRequestRepositorySuite(t *testing.T) {
-	suite.Run(t, new(PullRequestRepositoryTestSuite))
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &PullRequestRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
 }
 
-func (s *PullRequestRepositoryTestSuite) SetupSuite() {
-	s.testDB = testingpkg.SetupTestDatabase(s.T())
-	s.repo = &repo{db: s.testDB.Client}
-}
-
-func (s *PullRequestRepositoryTestSuite) TearDownSuite() {
-	if s.testDB.Client != nil {
-		s.testDB.Client.Close()
+func newTestRepo(b *testingpkg.Backend) repository.PullRequestRepository {
+	if b.Store != nil {
+		return memory.NewPullRequestRepository(b.Store)
 	}
+	return &repo{db: b.Client}
 }
 
 func (s *PullRequestRepositoryTestSuite) SetupTest() {
-	s.testDB.CleanupTables(s.T())
+	s.backend.Cleanup(s.T())
 	s.seedTestData()
 }
 
 func (s *PullRequestRepositoryTestSuite) seedTestData() {
-	ctx := context.Background()
-
-	teamID := uuid.New()
-	_, err := s.testDB.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO teams(id, team_name) VALUES ($1, $2)",
-	}, teamID, "backend-team")
-	require.NoError(s.T(), err)
-
-	s.createTestUser("author-1", "backend-team", true)
-	s.createTestUser("reviewer-1", "backend-team", true)
-	s.createTestUser("reviewer-2", "backend-team", true)
-}
-
-func (s *PullRequestRepositoryTestSuite) createTestUser(username, teamName string, isActive bool) uuid.UUID {
-	ctx := context.Background()
-	userID := uuid.New()
+	s.backend.CreateTeam(s.T(), "backend-team")
 
-	_, err := s.testDB.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, userID, username, teamName, isActive)
-	require.NoError(s.T(), err)
-
-	return userID
+	s.users = make(map[string]uuid.UUID)
+	for _, name := range []string{"author-1", "reviewer-1", "reviewer-2"} {
+		s.users[name] = s.backend.CreateUser(s.T(), name, "backend-team", true)
+	}
 }
 
 func (s *PullRequestRepositoryTestSuite) getUserIDByUsername(username string) uuid.UUID {
-	ctx := context.Background()
-	var userID uuid.UUID
-
-	row := s.testDB.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT id FROM users WHERE username = $1",
-	}, username)
-
-	err := row.Scan(&userID)
-	require.NoError(s.T(), err)
-
+	userID, ok := s.users[username]
+	require.True(s.T(), ok, username)
 	return userID
 }
 
@@ -98,13 +74,12 @@ func (s *PullRequestRepositoryTestSuite) TestCreatePR_Success() {
 	err := s.repo.CreatePR(ctx, pr)
 	require.NoError(s.T(), err)
 
-	var count int
-	row := s.testDB.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT COUNT(*) FROM prs WHERE id = $1",
-	}, prID)
-	err = row.Scan(&count)
+	created, err := s.repo.GetByID(ctx, prID)
 	require.NoError(s.T(), err)
-	assert.Equal(s.T(), 1, count)
+	assert.Equal(s.T(), "Feature: Add new endpoint", created.Name)
+	assert.Equal(s.T(), "OPEN", created.Status)
+	assert.NotNil(s.T(), created.CreatedAt)
+	assert.Nil(s.T(), created.MergedAt)
 }
 
 func (s *PullRequestRepositoryTestSuite) TestCreatePRReviewers_Success() {
@@ -129,13 +104,9 @@ func (s *PullRequestRepositoryTestSuite) TestCreatePRReviewers_Success() {
 	err = s.repo.CreatePRReviewers(ctx, pr)
 	require.NoError(s.T(), err)
 
-	var count int
-	row := s.testDB.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT COUNT(*) FROM pr_reviewers WHERE pr_id = $1",
-	}, prID)
-	err = row.Scan(&count)
+	reviewers, err := s.repo.GetViewers(ctx, prID)
 	require.NoError(s.T(), err)
-	assert.Equal(s.T(), 2, count)
+	assert.ElementsMatch(s.T(), []uuid.UUID{reviewer1ID, reviewer2ID}, reviewers)
 }
 
 func (s *PullRequestRepositoryTestSuite) TestGetByID_Success() {
@@ -317,3 +288,181 @@ func (s *PullRequestRepositoryTestSuite) TestGetByReviewer_Success() {
 	assert.Contains(s.T(), prIDs, pr1ID)
 	assert.Contains(s.T(), prIDs, pr2ID)
 }
+
+func (s *PullRequestRepositoryTestSuite) TestAssignmentHistory() {
+	ctx := context.Background()
+
+	authorID := s.getUserIDByUsername("author-1")
+	oldReviewerID := s.getUserIDByUsername("reviewer-1")
+	newReviewerID := s.getUserIDByUsername("reviewer-2")
+
+	prID := uuid.New()
+	pr := &model.PullRequest{
+		ID:                prID,
+		Name:              "Feature: History test",
+		AuthorID:          authorID,
+		Status:            "OPEN",
+		AssignedReviewers: []uuid.UUID{oldReviewerID},
+	}
+	require.NoError(s.T(), s.repo.CreatePR(ctx, pr))
+
+	actorID := uuid.New()
+	assignedAt := time.Now().UTC().Add(-time.Minute)
+	require.NoError(s.T(), s.repo.CreateAssignments(ctx, []*model.ReviewerAssignment{{
+		PRID:        prID,
+		ReviewerID:  oldReviewerID,
+		AssignedAt:  assignedAt,
+		Reason:      model.AssignmentInitial,
+		ActorName:   "alice",
+		ActorUserID: &actorID,
+	}}))
+
+	require.NoError(s.T(), s.repo.CloseAssignment(ctx, prID, oldReviewerID))
+	require.NoError(s.T(), s.repo.CreateAssignments(ctx, []*model.ReviewerAssignment{{
+		PRID:       prID,
+		ReviewerID: newReviewerID,
+		AssignedAt: time.Now().UTC(),
+		Reason:     model.AssignmentDeactivation,
+	}}))
+
+	history, err := s.repo.GetHistory(ctx, prID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history, 2)
+
+	assert.Equal(s.T(), oldReviewerID, history[0].ReviewerID)
+	assert.Equal(s.T(), model.AssignmentInitial, history[0].Reason)
+	assert.Equal(s.T(), "alice", history[0].ActorName)
+	assert.Equal(s.T(), &actorID, history[0].ActorUserID)
+	assert.NotNil(s.T(), history[0].UnassignedAt)
+
+	assert.Equal(s.T(), newReviewerID, history[1].ReviewerID)
+	assert.Equal(s.T(), model.AssignmentDeactivation, history[1].Reason)
+	assert.Empty(s.T(), history[1].ActorName)
+	assert.Nil(s.T(), history[1].UnassignedAt)
+}
+
+func (s *PullRequestRepositoryTestSuite) TestCreateAssignments_KeepsTimestamps() {
+	ctx := context.Background()
+
+	authorID := s.getUserIDByUsername("author-1")
+	reviewerID := s.getUserIDByUsername("reviewer-1")
+	prID := uuid.New()
+	require.NoError(s.T(), s.repo.CreatePR(ctx, &model.PullRequest{
+		ID: prID, Name: "Feature: Timestamps", AuthorID: authorID, Status: "OPEN",
+	}))
+
+	assignedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	unassignedAt := assignedAt.Add(2 * time.Hour)
+	require.NoError(s.T(), s.repo.CreateAssignments(ctx, []*model.ReviewerAssignment{
+		{PRID: prID, ReviewerID: reviewerID, AssignedAt: assignedAt, UnassignedAt: &unassignedAt, Reason: model.AssignmentInitial},
+		// снятое назначение не мешает активному для той же пары
+		{PRID: prID, ReviewerID: reviewerID, AssignedAt: unassignedAt, Reason: model.AssignmentManual},
+	}))
+
+	history, err := s.repo.GetHistory(ctx, prID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history, 2)
+	assert.True(s.T(), history[0].AssignedAt.Equal(assignedAt))
+	require.NotNil(s.T(), history[0].UnassignedAt)
+	assert.True(s.T(), history[0].UnassignedAt.Equal(unassignedAt))
+	assert.True(s.T(), history[1].AssignedAt.Equal(unassignedAt))
+	assert.Nil(s.T(), history[1].UnassignedAt)
+}
+
+func (s *PullRequestRepositoryTestSuite) TestCloseAssignment_NotFound() {
+	err := s.repo.CloseAssignment(context.Background(), uuid.New(), uuid.New())
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+func (s *PullRequestRepositoryTestSuite) TestAddRemoveReviewer() {
+	ctx := context.Background()
+
+	authorID := s.getUserIDByUsername("author-1")
+	reviewerID := s.getUserIDByUsername("reviewer-1")
+
+	prID := uuid.New()
+	require.NoError(s.T(), s.repo.CreatePR(ctx, &model.PullRequest{
+		ID:       prID,
+		Name:     "Feature: Manual reviewers",
+		AuthorID: authorID,
+		Status:   "OPEN",
+	}))
+
+	require.NoError(s.T(), s.repo.AddReviewer(ctx, prID, reviewerID))
+
+	pr, err := s.repo.GetByID(ctx, prID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []uuid.UUID{reviewerID}, pr.AssignedReviewers)
+
+	require.NoError(s.T(), s.repo.RemoveReviewer(ctx, prID, reviewerID))
+
+	pr, err = s.repo.GetByID(ctx, prID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), pr.AssignedReviewers)
+
+	err = s.repo.RemoveReviewer(ctx, prID, reviewerID)
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+func (s *PullRequestRepositoryTestSuite) TestMarkReviewed() {
+	ctx := context.Background()
+
+	authorID := s.getUserIDByUsername("author-1")
+	reviewerID := s.getUserIDByUsername("reviewer-1")
+
+	prID := uuid.New()
+	s.backend.CreatePR(s.T(), prID, "Feature: Review", authorID, "OPEN")
+	s.backend.AddReviewer(s.T(), prID, reviewerID)
+
+	first := time.Now().UTC().Truncate(time.Microsecond)
+	review, err := s.repo.MarkReviewed(ctx, prID, reviewerID, first)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), prID, review.PRID)
+	assert.Equal(s.T(), reviewerID, review.ReviewerID)
+	assert.True(s.T(), first.Equal(review.ReviewedAt))
+
+	// повторная отметка не сдвигает время первого ревью
+	review, err = s.repo.MarkReviewed(ctx, prID, reviewerID, first.Add(time.Hour))
+	require.NoError(s.T(), err)
+	assert.True(s.T(), first.Equal(review.ReviewedAt))
+
+	_, err = s.repo.MarkReviewed(ctx, prID, s.getUserIDByUsername("reviewer-2"), first)
+	assert.ErrorIs(s.T(), err, pgx.ErrNoRows)
+}
+
+// Блокировка держится до конца транзакции: пока первая не завершилась, вторая PR не получает
+func (s *PullRequestRepositoryTestSuite) TestTryLockPR_HeldUntilCommit() {
+	if s.backend.Name != testingpkg.BackendPostgres {
+		s.T().Skip("advisory блокировки есть только в postgres")
+	}
+	ctx := context.Background()
+	prID := uuid.New()
+	s.backend.CreatePR(s.T(), prID, "Locked PR", s.getUserIDByUsername("author-1"), "OPEN")
+
+	tx1, err := s.backend.Client.DB().BeginTx(ctx, pgx.TxOptions{})
+	require.NoError(s.T(), err)
+	defer func() { _ = tx1.Rollback(ctx) }()
+	tx2, err := s.backend.Client.DB().BeginTx(ctx, pgx.TxOptions{})
+	require.NoError(s.T(), err)
+	defer func() { _ = tx2.Rollback(ctx) }()
+	ctx1, ctx2 := pg.MakeContextTx(ctx, tx1), pg.MakeContextTx(ctx, tx2)
+
+	locked, err := s.repo.TryLockPR(ctx1, prID)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), locked)
+
+	locked, err = s.repo.TryLockPR(ctx2, prID)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), locked)
+
+	// блокировка одного PR не мешает другому
+	locked, err = s.repo.TryLockPR(ctx2, uuid.New())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), locked)
+
+	require.NoError(s.T(), tx1.Commit(ctx))
+
+	locked, err = s.repo.TryLockPR(ctx2, prID)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), locked)
+}
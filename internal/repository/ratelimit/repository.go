@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+)
+
+// refillExpr — токены в бакете к текущему моменту, до списания: $2 — burst, $3 — rate
+const refillExpr = `LEAST($2::float8, b.tokens + GREATEST(EXTRACT(EPOCH FROM NOW() - b.updated_at)::float8, 0) * $3::float8)`
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository — лимитер с бакетами в Postgres, общими для всех реплик
+func NewRepository(db db.Client) repository.RateLimitRepository {
+	return &repo{db: db}
+}
+
+// Take пополняет бакет и забирает токен одним UPSERT: строка блокируется
+// на время обновления, поэтому параллельные запросы не тратят один токен дважды
+func (r *repo) Take(ctx context.Context, key string, l serviceModel.RateLimit) (*serviceModel.RateDecision, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+		VALUES ($1, $2::float8 - 1, TRUE, NOW())
+		ON CONFLICT (key) DO UPDATE
+		SET tokens = CASE WHEN %[1]s >= 1 THEN %[1]s - 1 ELSE %[1]s END,
+			allowed = %[1]s >= 1,
+			updated_at = GREATEST(b.updated_at, NOW())
+		RETURNING tokens, allowed
+	`, refillExpr)
+
+	var tokens float64
+	var allowed bool
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "ratelimit.Take", QueryRaw: query}, key, l.Burst, l.Rate).Scan(&tokens, &allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return &serviceModel.RateDecision{RetryAfter: l.RetryAfter(tokens)}, nil
+	}
+	return &serviceModel.RateDecision{Allowed: true}, nil
+}
+
+func (r *repo) DeleteStale(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM rate_limit_buckets WHERE updated_at < $1`
+
+	result, err := r.db.DB().ExecContext(ctx, db.Query{Name: "ratelimit.DeleteStale", QueryRaw: query}, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
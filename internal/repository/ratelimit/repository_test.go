@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type RateLimitRepositoryTestSuite struct {
+	suite.Suite
+	db   *testingpkg.TestDatabase
+	repo *repo
+}
+
+func TestRateLimitRepositorySuite(t *testing.T) {
+	suite.Run(t, new(RateLimitRepositoryTestSuite))
+}
+
+func (s *RateLimitRepositoryTestSuite) SetupSuite() {
+	s.db = testingpkg.SetupTestDatabase(s.T())
+	s.repo = &repo{db: s.db.Client}
+}
+
+func (s *RateLimitRepositoryTestSuite) TearDownSuite() {
+	if s.db.Client != nil {
+		s.db.Client.Close()
+	}
+}
+
+func (s *RateLimitRepositoryTestSuite) SetupTest() {
+	s.db.CleanupTables(s.T())
+}
+
+func (s *RateLimitRepositoryTestSuite) TestTake_Burst() {
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 1.0 / 3600, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		d, err := s.repo.Take(ctx, "key:1 POST /pullRequest/reassign", limit)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), d.Allowed)
+	}
+
+	d, err := s.repo.Take(ctx, "key:1 POST /pullRequest/reassign", limit)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), d.Allowed)
+	assert.Greater(s.T(), d.RetryAfter, 59*time.Minute)
+
+	d, err = s.repo.Take(ctx, "key:2 POST /pullRequest/reassign", limit)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), d.Allowed)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestTake_Concurrent() {
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 1.0 / 3600, Burst: 5}
+
+	var mu sync.Mutex
+	allowed := 0
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d, err := s.repo.Take(ctx, "ip:10.0.0.1 GET /team/get", limit)
+			if !assert.NoError(s.T(), err) {
+				return
+			}
+			if d.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(s.T(), 5, allowed)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestDeleteStale() {
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 1, Burst: 1}
+
+	_, err := s.repo.Take(ctx, "key:1 GET /team/get", limit)
+	require.NoError(s.T(), err)
+
+	n, err := s.repo.DeleteStale(ctx, time.Now().UTC().Add(-time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), n)
+
+	n, err = s.repo.DeleteStale(ctx, time.Now().UTC().Add(time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), n)
+}
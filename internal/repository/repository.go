@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,16 +14,27 @@ type PullRequestRepository interface {
 	CreatePRReviewers(ctx context.Context, pr *model.PullRequest) error
 	Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error)
 	ReassignReviewers(ctx context.Context, prID, oldID, newID uuid.UUID) error
+	AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error
+	RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error
+	CreateAssignments(ctx context.Context, list []*model.ReviewerAssignment) error
+	CloseAssignment(ctx context.Context, prID, reviewerID uuid.UUID) error
+	MarkReviewed(ctx context.Context, prID, reviewerID uuid.UUID, at time.Time) (*model.PRReview, error)
+	TryLockPR(ctx context.Context, prID uuid.UUID) (bool, error)
 
 	GetByID(ctx context.Context, id uuid.UUID) (*model.PullRequest, error)
+	GetStaleReviews(ctx context.Context, now time.Time, after *model.StaleReview, limit int) ([]*model.StaleReview, error)
+	GetStaleReview(ctx context.Context, prID, reviewerID uuid.UUID, now time.Time) (*model.StaleReview, error)
 	GetViewers(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
 	GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*model.PullRequestShort, error)
+	GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error)
 }
 
 type TeamRepository interface {
 	CreateTeam(ctx context.Context, teamName string) error
 	CreateMembers(ctx context.Context, t *model.Team) error
+	CreateTeams(ctx context.Context, names []string) error
 
+	GetExistingNames(ctx context.Context, names []string) ([]string, error)
 	GetTeamIDByName(ctx context.Context, name string) (uuid.UUID, error)
 	GetTeamByName(ctx context.Context, name string) (*model.Team, error)
 }
@@ -30,11 +42,75 @@ type TeamRepository interface {
 type UserRepository interface {
 	GetActiveByTeam(ctx context.Context, teamName string) ([]*model.User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error)
+	GetByTeams(ctx context.Context, teamNames []string) ([]*model.User, error)
 
 	SetActive(ctx context.Context, req *model.UserSetActive) error
+	Upsert(ctx context.Context, users []*model.User) error
 }
 
 type StatisticsRepository interface {
-	GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error)
-	GetPRStatistics(ctx context.Context) ([]*model.PRStats, error)
+	GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error)
+	GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error)
+
+	StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error
+	StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error
+}
+
+type SLARepository interface {
+	SetTeamSettings(ctx context.Context, s *model.TeamSLA) error
+	MarkReminded(ctx context.Context, list []*model.SLAReminder, at time.Time) error
+
+	GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error)
+	GetDueReminders(ctx context.Context, now time.Time, after *model.SLAReminder, limit int) ([]*model.SLAReminder, error)
+	ListTeamSettings(ctx context.Context) ([]*model.TeamSLA, error)
+	GetAssignments(ctx context.Context) ([]*model.SLAAssignment, error)
+}
+
+type CalendarRepository interface {
+	SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) error
+	SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) error
+	AddHoliday(ctx context.Context, h *model.Holiday) error
+	RemoveHoliday(ctx context.Context, date, teamName string) error
+
+	GetTeamHours(ctx context.Context, teamName string) (*model.WorkingHours, error)
+	GetSchedules(ctx context.Context, userIDs []uuid.UUID) ([]*model.UserSchedule, error)
+	GetHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error)
+}
+
+type SchemaRepository interface {
+	Version(ctx context.Context) (version uint, dirty bool, err error)
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey, hash string) error
+	Revoke(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+
+	GetByHash(ctx context.Context, hash string) (*model.APIKey, error)
+	List(ctx context.Context) ([]*model.APIKey, error)
+}
+
+type AuditRepository interface {
+	Create(ctx context.Context, e *model.AuditEvent) error
+	List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error)
+}
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, rec *model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, rec *model.IdempotencyRecord) error
+	Delete(ctx context.Context, rec *model.IdempotencyRecord) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+
+	Get(ctx context.Context, scope, key string) (*model.IdempotencyRecord, error)
+}
+
+type RateLimitRepository interface {
+	Take(ctx context.Context, key string, l model.RateLimit) (*model.RateDecision, error)
+	DeleteStale(ctx context.Context, before time.Time) (int64, error)
+}
+
+type ArchiveRepository interface {
+	Export(ctx context.Context) (*model.Archive, error)
+	Counts(ctx context.Context) (*model.ArchiveCounts, error)
+	Import(ctx context.Context, a *model.Archive) error
 }
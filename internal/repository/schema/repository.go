@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"PR/internal/client/db"
+	"PR/internal/repository"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.SchemaRepository {
+	return &repo{db: db}
+}
+
+// Version читает состояние из таблицы schema_migrations, которую ведет golang-migrate.
+// Если миграции еще ни разу не запускались, возвращается нулевая версия.
+func (r *repo) Version(ctx context.Context) (uint, bool, error) {
+	query := `SELECT version, dirty FROM schema_migrations LIMIT 1`
+
+	var version int64
+	var dirty bool
+	err := r.db.DB().QueryRowContext(ctx, db.Query{Name: "schema.Version", QueryRaw: query}).Scan(&version, &dirty)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.Is(err, pgx.ErrNoRows) || (errors.As(err, &pgErr) && pgErr.Code == "42P01") {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return uint(version), dirty, nil
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/client/db"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type SchemaRepositoryTestSuite struct {
+	suite.Suite
+	db   *testingpkg.TestDatabase
+	repo *repo
+}
+
+func TestSchemaRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SchemaRepositoryTestSuite))
+}
+
+func (s *SchemaRepositoryTestSuite) SetupSuite() {
+	s.db = testingpkg.SetupTestDatabase(s.T())
+	s.repo = &repo{db: s.db.Client}
+}
+
+func (s *SchemaRepositoryTestSuite) TearDownSuite() {
+	if s.db.Client != nil {
+		s.db.Client.Close()
+	}
+}
+
+func (s *SchemaRepositoryTestSuite) TestVersion_AfterMigrations() {
+	ctx := context.Background()
+
+	var expected uint
+	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
+		QueryRaw: "SELECT version FROM schema_migrations",
+	})
+	require.NoError(s.T(), row.Scan(&expected))
+
+	version, dirty, err := s.repo.Version(ctx)
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), expected, version)
+	assert.NotZero(s.T(), version)
+	assert.False(s.T(), dirty)
+}
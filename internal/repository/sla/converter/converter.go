@@ -0,0 +1,72 @@
+package converter
+
+import (
+	serviceModel "PR/internal/model"
+	repoModel "PR/internal/repository/sla/model"
+)
+
+func ToRepoWebhookURL(url string) *string {
+	if url == "" {
+		return nil
+	}
+	return &url
+}
+
+func FromRepoTeamSLA(s *repoModel.TeamSLA) *serviceModel.TeamSLA {
+	res := &serviceModel.TeamSLA{
+		TeamName:              s.TeamName,
+		FirstReviewHours:      s.FirstReviewHours,
+		ReminderIntervalHours: s.ReminderIntervalHours,
+		AutoReassignHours:     s.AutoReassignHours,
+		MaxAutoReassigns:      s.MaxAutoReassigns,
+		UpdatedAt:             s.UpdatedAt,
+	}
+	if s.WebhookURL != nil {
+		res.WebhookURL = *s.WebhookURL
+	}
+	return res
+}
+
+func FromRepoReminders(list []*repoModel.Reminder) []*serviceModel.SLAReminder {
+	res := make([]*serviceModel.SLAReminder, 0, len(list))
+	for _, r := range list {
+		reminder := &serviceModel.SLAReminder{
+			PRID:                  r.PRID,
+			PRName:                r.PRName,
+			AuthorID:              r.AuthorID,
+			ReviewerID:            r.ReviewerID,
+			ReviewerName:          r.ReviewerName,
+			TeamName:              r.TeamName,
+			FirstReviewHours:      r.FirstReviewHours,
+			AssignedAt:            r.AssignedAt,
+			RemindedAt:            r.RemindedAt,
+			ReminderIntervalHours: r.ReminderIntervalHours,
+		}
+		if r.WebhookURL != nil {
+			reminder.WebhookURL = *r.WebhookURL
+		}
+		res = append(res, reminder)
+	}
+	return res
+}
+
+func FromRepoTeamSLAList(list []*repoModel.TeamSLA) []*serviceModel.TeamSLA {
+	res := make([]*serviceModel.TeamSLA, 0, len(list))
+	for _, s := range list {
+		res = append(res, FromRepoTeamSLA(s))
+	}
+	return res
+}
+
+func FromRepoAssignments(list []*repoModel.Assignment) []*serviceModel.SLAAssignment {
+	res := make([]*serviceModel.SLAAssignment, 0, len(list))
+	for _, a := range list {
+		res = append(res, &serviceModel.SLAAssignment{
+			TeamName:   a.TeamName,
+			ReviewerID: a.ReviewerID,
+			AssignedAt: a.AssignedAt,
+			AnsweredAt: a.AnsweredAt,
+		})
+	}
+	return res
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TeamSLA struct {
+	TeamName              string    `db:"team_name"`
+	FirstReviewHours      int       `db:"first_review_hours"`
+	ReminderIntervalHours int       `db:"reminder_interval_hours"`
+	WebhookURL            *string   `db:"webhook_url"`
+	AutoReassignHours     int       `db:"auto_reassign_hours"`
+	MaxAutoReassigns      int       `db:"max_auto_reassigns"`
+	UpdatedAt             time.Time `db:"updated_at"`
+}
+
+type Reminder struct {
+	PRID                  uuid.UUID  `db:"pr_id"`
+	PRName                string     `db:"pr_name"`
+	AuthorID              uuid.UUID  `db:"author_id"`
+	ReviewerID            uuid.UUID  `db:"reviewer_id"`
+	ReviewerName          string     `db:"reviewer_name"`
+	TeamName              string     `db:"team_name"`
+	FirstReviewHours      int        `db:"first_review_hours"`
+	ReminderIntervalHours int        `db:"reminder_interval_hours"`
+	AssignedAt            time.Time  `db:"assigned_at"`
+	RemindedAt            *time.Time `db:"reminded_at"`
+	WebhookURL            *string    `db:"webhook_url"`
+}
+
+type Assignment struct {
+	TeamName   string     `db:"team_name"`
+	ReviewerID uuid.UUID  `db:"reviewer_id"`
+	AssignedAt time.Time  `db:"assigned_at"`
+	AnsweredAt *time.Time `db:"answered_at"`
+}
@@ -0,0 +1,171 @@
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"PR/internal/client/db"
+	serviceModel "PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/sla/converter"
+	repoModel "PR/internal/repository/sla/model"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.SLARepository {
+	return &repo{db: db}
+}
+
+func (r *repo) SetTeamSettings(ctx context.Context, s *serviceModel.TeamSLA) error {
+	query := `
+		INSERT INTO team_sla_settings (team_name, first_review_hours, reminder_interval_hours, webhook_url,
+			auto_reassign_hours, max_auto_reassigns, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (team_name) DO UPDATE SET
+			first_review_hours = EXCLUDED.first_review_hours,
+			reminder_interval_hours = EXCLUDED.reminder_interval_hours,
+			webhook_url = EXCLUDED.webhook_url,
+			auto_reassign_hours = EXCLUDED.auto_reassign_hours,
+			max_auto_reassigns = EXCLUDED.max_auto_reassigns,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "sla.SetTeamSettings", QueryRaw: query},
+		s.TeamName, s.FirstReviewHours, s.ReminderIntervalHours, converter.ToRepoWebhookURL(s.WebhookURL),
+		s.AutoReassignHours, s.MaxAutoReassigns, s.UpdatedAt)
+	return err
+}
+
+func (r *repo) GetTeamSettings(ctx context.Context, teamName string) (*serviceModel.TeamSLA, error) {
+	query := `
+		SELECT team_name, first_review_hours, reminder_interval_hours, webhook_url,
+			auto_reassign_hours, max_auto_reassigns, updated_at
+		FROM team_sla_settings
+		WHERE team_name = $1
+	`
+
+	var s repoModel.TeamSLA
+	err := r.db.DB().ScanOneContext(ctx, &s, db.Query{Name: "sla.GetTeamSettings", QueryRaw: query}, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoTeamSLA(&s), nil
+}
+
+// GetDueReminders выбирает назначения без ревью на открытых PR, срок которых
+// истек по часам, а напоминания еще не было или пора повторить. Рабочих часов
+// прошло не больше, чем по часам, поэтому сервис отбрасывает из выборки
+// назначения, срок которых по календарю ревьюера еще не истек. Строки
+// блокируются до конца транзакции вызывающего, SKIP LOCKED не дает двум
+// репликам напомнить дважды. after — последнее назначение предыдущей страницы,
+// nil — с начала
+func (r *repo) GetDueReminders(ctx context.Context, now time.Time, after *serviceModel.SLAReminder, limit int) ([]*serviceModel.SLAReminder, error) {
+	query := `
+		SELECT
+			rv.pr_id,
+			p.name AS pr_name,
+			p.author_id,
+			rv.reviewer_id,
+			u.username AS reviewer_name,
+			u.team_name,
+			s.first_review_hours,
+			s.reminder_interval_hours,
+			rv.assigned_at,
+			rv.reminded_at,
+			s.webhook_url
+		FROM pr_reviewers rv
+		INNER JOIN prs p ON p.id = rv.pr_id
+		INNER JOIN users u ON u.id = rv.reviewer_id
+		INNER JOIN team_sla_settings s ON s.team_name = u.team_name
+		WHERE p.status = 'OPEN'
+			AND rv.reviewed_at IS NULL
+			AND rv.assigned_at + make_interval(hours => s.first_review_hours) <= $1
+			AND (rv.reminded_at IS NULL OR (s.reminder_interval_hours > 0
+				AND rv.reminded_at + make_interval(hours => s.reminder_interval_hours) <= $1))
+	`
+	args := []any{now, limit}
+	if after != nil {
+		query += `
+			AND (rv.assigned_at, rv.pr_id, rv.reviewer_id) > ($3, $4, $5)`
+		args = append(args, after.AssignedAt, after.PRID, after.ReviewerID)
+	}
+	query += `
+		ORDER BY rv.assigned_at, rv.pr_id, rv.reviewer_id
+		LIMIT $2
+		FOR UPDATE OF rv SKIP LOCKED
+	`
+
+	var list []*repoModel.Reminder
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "sla.GetDueReminders", QueryRaw: query}, args...)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoReminders(list), nil
+}
+
+func (r *repo) MarkReminded(ctx context.Context, list []*serviceModel.SLAReminder, at time.Time) error {
+	if len(list) == 0 {
+		return nil
+	}
+	query := `
+		UPDATE pr_reviewers rv
+		SET reminded_at = $3
+		FROM unnest($1::uuid[], $2::uuid[]) AS t(pr_id, reviewer_id)
+		WHERE rv.pr_id = t.pr_id AND rv.reviewer_id = t.reviewer_id
+	`
+
+	prIDs := make([]uuid.UUID, 0, len(list))
+	reviewerIDs := make([]uuid.UUID, 0, len(list))
+	for _, rem := range list {
+		prIDs = append(prIDs, rem.PRID)
+		reviewerIDs = append(reviewerIDs, rem.ReviewerID)
+	}
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "sla.MarkReminded", QueryRaw: query}, prIDs, reviewerIDs, at)
+	return err
+}
+
+func (r *repo) ListTeamSettings(ctx context.Context) ([]*serviceModel.TeamSLA, error) {
+	query := `
+		SELECT team_name, first_review_hours, reminder_interval_hours, webhook_url,
+			auto_reassign_hours, max_auto_reassigns, updated_at
+		FROM team_sla_settings
+		ORDER BY team_name
+	`
+
+	var list []*repoModel.TeamSLA
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "sla.ListTeamSettings", QueryRaw: query})
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoTeamSLAList(list), nil
+}
+
+// GetAssignments возвращает текущие назначения ревьюеров команд с настроенным
+// SLA. Ответ — ревью, а если его не было, merge PR
+func (r *repo) GetAssignments(ctx context.Context) ([]*serviceModel.SLAAssignment, error) {
+	query := `
+		SELECT
+			u.team_name,
+			rv.reviewer_id,
+			rv.assigned_at,
+			COALESCE(rv.reviewed_at, p.merged_at) AS answered_at
+		FROM pr_reviewers rv
+		INNER JOIN prs p ON p.id = rv.pr_id
+		INNER JOIN users u ON u.id = rv.reviewer_id
+		INNER JOIN team_sla_settings s ON s.team_name = u.team_name
+		WHERE rv.assigned_at IS NOT NULL
+		ORDER BY u.team_name, rv.assigned_at, rv.pr_id, rv.reviewer_id
+	`
+
+	var list []*repoModel.Assignment
+	err := r.db.DB().ScanAllContext(ctx, &list, db.Query{Name: "sla.GetAssignments", QueryRaw: query})
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoAssignments(list), nil
+}
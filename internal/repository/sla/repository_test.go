@@ -0,0 +1,245 @@
+package sla
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
+	prRepo "PR/internal/repository/pr"
+	testingpkg "PR/internal/repository/testing"
+)
+
+type SLARepositoryTestSuite struct {
+	suite.Suite
+	backend *testingpkg.Backend
+	repo    repository.SLARepository
+	prs     repository.PullRequestRepository
+
+	author, bob, carol uuid.UUID
+}
+
+func TestSLARepositorySuite(t *testing.T) {
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, newTestSuite(b))
+	})
+}
+
+func newTestSuite(b *testingpkg.Backend) *SLARepositoryTestSuite {
+	if b.Store != nil {
+		return &SLARepositoryTestSuite{backend: b, repo: memory.NewSLARepository(b.Store), prs: memory.NewPullRequestRepository(b.Store)}
+	}
+	return &SLARepositoryTestSuite{backend: b, repo: &repo{db: b.Client}, prs: prRepo.NewRepository(b.Client)}
+}
+
+func (s *SLARepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+
+	t := s.T()
+	s.backend.CreateTeam(t, "backend")
+	s.backend.CreateTeam(t, "mobile")
+	s.author = s.backend.CreateUser(t, "alice", "backend", true)
+	s.bob = s.backend.CreateUser(t, "bob", "backend", true)
+	s.carol = s.backend.CreateUser(t, "carol", "mobile", true)
+}
+
+func (s *SLARepositoryTestSuite) setSLA(team string, hours, interval int, webhook string) {
+	s.Require().NoError(s.repo.SetTeamSettings(context.Background(), &model.TeamSLA{
+		TeamName:              team,
+		FirstReviewHours:      hours,
+		ReminderIntervalHours: interval,
+		WebhookURL:            webhook,
+		UpdatedAt:             time.Now().UTC(),
+	}))
+}
+
+func (s *SLARepositoryTestSuite) openPR(name string, reviewers ...uuid.UUID) uuid.UUID {
+	id := uuid.New()
+	s.backend.CreatePR(s.T(), id, name, s.author, "OPEN")
+	for _, r := range reviewers {
+		s.backend.AddReviewer(s.T(), id, r)
+	}
+	return id
+}
+
+func (s *SLARepositoryTestSuite) TestTeamSettings() {
+	ctx := context.Background()
+
+	_, err := s.repo.GetTeamSettings(ctx, "backend")
+	s.ErrorIs(err, pgx.ErrNoRows)
+
+	s.setSLA("backend", 24, 0, "")
+	s.setSLA("backend", 8, 4, "https://hooks.example.com/backend")
+
+	got, err := s.repo.GetTeamSettings(ctx, "backend")
+	s.Require().NoError(err)
+	s.Equal(8, got.FirstReviewHours)
+	s.Equal(4, got.ReminderIntervalHours)
+	s.Equal("https://hooks.example.com/backend", got.WebhookURL)
+
+	err = s.repo.SetTeamSettings(ctx, &model.TeamSLA{TeamName: "missing", FirstReviewHours: 1, UpdatedAt: time.Now()})
+	var pgErr *pgconn.PgError
+	s.Require().ErrorAs(err, &pgErr)
+	s.Equal("23503", pgErr.Code)
+}
+
+func (s *SLARepositoryTestSuite) TestDueReminders() {
+	ctx := context.Background()
+	s.setSLA("backend", 24, 12, "https://hooks.example.com/backend")
+
+	prID := s.openPR("feature", s.bob, s.carol)
+	reviewed := s.openPR("reviewed", s.bob)
+	_, err := s.prs.MarkReviewed(ctx, reviewed, s.bob, time.Now())
+	s.Require().NoError(err)
+
+	due, err := s.repo.GetDueReminders(ctx, time.Now(), nil, 10)
+	s.Require().NoError(err)
+	s.Empty(due, "срок еще не истек")
+
+	// у mobile нет SLA, поэтому напоминание только для bob
+	at := time.Now().Add(25 * time.Hour)
+	due, err = s.repo.GetDueReminders(ctx, at, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(due, 1)
+	s.Equal(prID, due[0].PRID)
+	s.Equal(s.bob, due[0].ReviewerID)
+	s.Equal("bob", due[0].ReviewerName)
+	s.Equal("backend", due[0].TeamName)
+	s.Equal("https://hooks.example.com/backend", due[0].WebhookURL)
+	s.Equal(24, due[0].FirstReviewHours)
+	s.Equal(12, due[0].ReminderIntervalHours)
+	s.Nil(due[0].RemindedAt)
+
+	next, err := s.repo.GetDueReminders(ctx, at, due[0], 10)
+	s.Require().NoError(err)
+	s.Empty(next, "следующая страница начинается после последнего назначения")
+
+	s.Require().NoError(s.repo.MarkReminded(ctx, due, at))
+
+	due, err = s.repo.GetDueReminders(ctx, at.Add(time.Hour), nil, 10)
+	s.Require().NoError(err)
+	s.Empty(due, "повтор только через reminder_interval_hours")
+
+	due, err = s.repo.GetDueReminders(ctx, at.Add(12*time.Hour), nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(due, 1)
+	s.NotNil(due[0].RemindedAt)
+
+	_, err = s.prs.Merge(ctx, prID)
+	s.Require().NoError(err)
+	due, err = s.repo.GetDueReminders(ctx, at.Add(12*time.Hour), nil, 10)
+	s.Require().NoError(err)
+	s.Empty(due, "по смерженным PR не напоминаем")
+}
+
+func (s *SLARepositoryTestSuite) TestDueReminders_Once() {
+	ctx := context.Background()
+	s.setSLA("backend", 1, 0, "")
+	s.openPR("feature", s.bob)
+
+	at := time.Now().Add(2 * time.Hour)
+	due, err := s.repo.GetDueReminders(ctx, at, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(due, 1)
+	s.Empty(due[0].WebhookURL)
+	s.Require().NoError(s.repo.MarkReminded(ctx, due, at))
+
+	due, err = s.repo.GetDueReminders(ctx, at.Add(100*time.Hour), nil, 10)
+	s.Require().NoError(err)
+	s.Empty(due)
+}
+
+func (s *SLARepositoryTestSuite) TestAssignments() {
+	ctx := context.Background()
+	s.setSLA("mobile", 8, 0, "")
+	s.setSLA("backend", 24, 0, "")
+
+	settings, err := s.repo.ListTeamSettings(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(settings, 2)
+	s.Equal("backend", settings[0].TeamName)
+	s.Equal(24, settings[0].FirstReviewHours)
+	s.Equal("mobile", settings[1].TeamName)
+
+	now := time.Now().UTC()
+	reviewed := s.openPR("reviewed", s.bob)
+	s.openPR("waiting", s.bob)
+	merged := s.openPR("merged", s.bob)
+
+	_, err = s.prs.MarkReviewed(ctx, reviewed, s.bob, now.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = s.prs.Merge(ctx, merged)
+	s.Require().NoError(err)
+
+	list, err := s.repo.GetAssignments(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(list, 3)
+
+	answered := 0
+	for _, a := range list {
+		s.Equal("backend", a.TeamName)
+		s.Equal(s.bob, a.ReviewerID)
+		s.False(a.AssignedAt.IsZero())
+		if a.AnsweredAt != nil {
+			answered++
+		}
+	}
+	s.Equal(2, answered, "ответ — ревью или merge")
+}
+
+func (s *SLARepositoryTestSuite) TestStaleReviews() {
+	ctx := context.Background()
+	s.Require().NoError(s.repo.SetTeamSettings(ctx, &model.TeamSLA{
+		TeamName:          "backend",
+		FirstReviewHours:  2,
+		AutoReassignHours: 4,
+		MaxAutoReassigns:  1,
+		UpdatedAt:         time.Now().UTC(),
+	}))
+	s.setSLA("mobile", 2, 0, "")
+
+	pr := s.openPR("stale", s.bob, s.carol)
+	exhausted := s.openPR("exhausted", s.bob)
+	s.Require().NoError(s.prs.CreateAssignments(ctx, []*model.ReviewerAssignment{
+		{PRID: exhausted, ReviewerID: s.carol, AssignedAt: time.Now().UTC(), Reason: model.AssignmentTimeout},
+	}))
+
+	list, err := s.prs.GetStaleReviews(ctx, time.Now().Add(3*time.Hour), nil, 10)
+	s.Require().NoError(err)
+	s.Empty(list)
+
+	// у mobile нет политики переназначения, а у exhausted лимит уже исчерпан
+	later := time.Now().Add(5 * time.Hour)
+	list, err = s.prs.GetStaleReviews(ctx, later, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(pr, list[0].PRID)
+	s.Equal(s.bob, list[0].ReviewerID)
+	s.Equal("backend", list[0].TeamName)
+	s.Equal(4, list[0].AutoReassignHours)
+	s.Zero(list[0].AutoReassigns)
+
+	// следующая страница начинается после последнего назначения предыдущей
+	list, err = s.prs.GetStaleReviews(ctx, later, list[0], 10)
+	s.Require().NoError(err)
+	s.Empty(list)
+
+	got, err := s.prs.GetStaleReview(ctx, pr, s.bob, later)
+	s.Require().NoError(err)
+	s.Equal(pr, got.PRID)
+
+	_, err = s.prs.GetStaleReview(ctx, exhausted, s.bob, later)
+	s.ErrorIs(err, pgx.ErrNoRows)
+
+	_, err = s.prs.MarkReviewed(ctx, pr, s.bob, time.Now())
+	s.Require().NoError(err)
+	_, err = s.prs.GetStaleReview(ctx, pr, s.bob, later)
+	s.ErrorIs(err, pgx.ErrNoRows)
+}
@@ -3,21 +3,15 @@ package statistics
 import (
 	"context"
 
+	"github.com/georgysavva/scany/v2/pgxscan"
+
 	"PR/internal/client/db"
 	"PR/internal/model"
 	"PR/internal/repository"
 )
 
-type repo struct {
-	db db.Client
-}
-
-func NewRepository(db db.Client) repository.StatisticsRepository {
-	return &repo{db: db}
-}
-
-func (r *repo) GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error) {
-	query := `
+const (
+	reviewerStatsQuery = `
         SELECT 
             u.id as reviewer_id,
             u.username as reviewer_name,
@@ -28,17 +22,7 @@ func (r *repo) GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStat
         ORDER BY assigned_count DESC
     `
 
-	var stats []*model.ReviewerStats
-	err := r.db.DB().ScanAllContext(ctx, &stats, db.Query{QueryRaw: query})
-	if err != nil {
-		return nil, err
-	}
-
-	return stats, nil
-}
-
-func (r *repo) GetPRStatistics(ctx context.Context) ([]*model.PRStats, error) {
-	query := `
+	prStatsQuery = `
         SELECT 
             p.id as pr_id,
             p.name as pr_name,
@@ -50,11 +34,99 @@ func (r *repo) GetPRStatistics(ctx context.Context) ([]*model.PRStats, error) {
         ORDER BY reviewer_count DESC
     `
 
+	// по истории: PR, на которые ревьюер был назначен хотя бы раз, даже если назначение потом сняли
+	reviewerHistoryStatsQuery = `
+        SELECT 
+            u.id as reviewer_id,
+            u.username as reviewer_name,
+            COUNT(DISTINCT a.pr_id) as assigned_count
+        FROM users u
+        LEFT JOIN pr_reviewer_assignments a ON a.reviewer_id = u.id
+        GROUP BY u.id, u.username
+        ORDER BY assigned_count DESC
+    `
+
+	prHistoryStatsQuery = `
+        SELECT 
+            p.id as pr_id,
+            p.name as pr_name,
+            p.status,
+            COUNT(DISTINCT a.reviewer_id) as reviewer_count
+        FROM prs p
+        LEFT JOIN pr_reviewer_assignments a ON a.pr_id = p.id
+        GROUP BY p.id, p.name, p.status
+        ORDER BY reviewer_count DESC
+    `
+)
+
+func reviewerQuery(f model.StatisticsFilter) db.Query {
+	if f.IncludeHistory {
+		return db.Query{Name: "statistics.ReviewerHistoryStats", QueryRaw: reviewerHistoryStatsQuery}
+	}
+	return db.Query{Name: "statistics.ReviewerStats", QueryRaw: reviewerStatsQuery}
+}
+
+func prQuery(f model.StatisticsFilter) db.Query {
+	if f.IncludeHistory {
+		return db.Query{Name: "statistics.PRHistoryStats", QueryRaw: prHistoryStatsQuery}
+	}
+	return db.Query{Name: "statistics.PRStats", QueryRaw: prStatsQuery}
+}
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.StatisticsRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error) {
+	var stats []*model.ReviewerStats
+	err := r.db.DB().ScanAllContext(ctx, &stats, reviewerQuery(f))
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *repo) GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error) {
 	var stats []*model.PRStats
-	err := r.db.DB().ScanAllContext(ctx, &stats, db.Query{QueryRaw: query})
+	err := r.db.DB().ScanAllContext(ctx, &stats, prQuery(f))
 	if err != nil {
 		return nil, err
 	}
 
 	return stats, nil
 }
+
+func (r *repo) StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error {
+	return streamRows(ctx, r.db.DB(), reviewerQuery(f), fn)
+}
+
+func (r *repo) StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error {
+	return streamRows(ctx, r.db.DB(), prQuery(f), fn)
+}
+
+// streamRows сканирует строки по одной и сразу отдает их в fn, не собирая весь результат в память
+func streamRows[T any](ctx context.Context, q db.QueryExecer, query db.Query, fn func(*T) error) error {
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	scanner := pgxscan.NewRowScanner(rows)
+	for rows.Next() {
+		var row T
+		if err := scanner.Scan(&row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
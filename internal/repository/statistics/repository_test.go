@@ -2,79 +2,58 @@ package statistics
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	"PR/internal/client/db"
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
 	testingpkg "PR/internal/repository/testing"
 )
 
 type StatisticsRepositoryTestSuite struct {
 	suite.Suite
-	db   *testingpkg.TestDatabase
-	repo *repo
+	backend *testingpkg.Backend
+	repo    repository.StatisticsRepository
+	users   map[string]uuid.UUID
 }
 
 func TestStatisticsRepositorySuite(t *testing.T) {
-	suite.Run(t, new(StatisticsRepositoryTestSuite))
-}
-func (s *StatisticsRepositoryTestSuite) SetupSuite() {
-	s.db = testingpkg.SetupTestDatabase(s.T())
-	s.repo = &repo{db: s.db.Client}
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &StatisticsRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
 }
 
-func (s *StatisticsRepositoryTestSuite) TearDownSuite() {
-	if s.db.Client != nil {
-		s.db.Client.Close()
+func newTestRepo(b *testingpkg.Backend) repository.StatisticsRepository {
+	if b.Store != nil {
+		return memory.NewStatisticsRepository(b.Store)
 	}
+	return &repo{db: b.Client}
 }
+
 func (s *StatisticsRepositoryTestSuite) SetupTest() {
-	s.db.CleanupTables(s.T())
+	s.backend.Cleanup(s.T())
 	s.seedTestData()
 }
 
 func (s *StatisticsRepositoryTestSuite) seedTestData() {
-	ctx := context.Background()
-
-	teamID := uuid.New()
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO teams(id, team_name) VALUES ($1, $2)",
-	}, teamID, "stats-team")
-	require.NoError(s.T(), err)
-
-	s.createUser("author1", "stats-team", true)
-	s.createUser("reviewer1", "stats-team", true)
-	s.createUser("reviewer2", "stats-team", true)
-	s.createUser("reviewer3", "stats-team", true)
-}
+	s.backend.CreateTeam(s.T(), "stats-team")
 
-func (s *StatisticsRepositoryTestSuite) createUser(username, teamName string, isActive bool) uuid.UUID {
-	ctx := context.Background()
-	userID := uuid.New()
-
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, userID, username, teamName, isActive)
-	require.NoError(s.T(), err)
-
-	return userID
+	s.users = make(map[string]uuid.UUID)
+	for _, name := range []string{"author1", "reviewer1", "reviewer2", "reviewer3"} {
+		s.users[name] = s.backend.CreateUser(s.T(), name, "stats-team", true)
+	}
 }
 
 func (s *StatisticsRepositoryTestSuite) getUserID(username string) uuid.UUID {
-	ctx := context.Background()
-	var userID uuid.UUID
-
-	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT id FROM users WHERE username = $1",
-	}, username)
-
-	err := row.Scan(&userID)
-	require.NoError(s.T(), err)
-
+	userID, ok := s.users[username]
+	require.True(s.T(), ok, username)
 	return userID
 }
 
@@ -101,10 +80,7 @@ func (s *StatisticsRepositoryTestSuite) TestGetReviewerStatistics_Success() {
 	}
 
 	for _, pr := range prs {
-		_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-			QueryRaw: "INSERT INTO prs(id, name, author_id, status, created_at) VALUES ($1, $2, $3, $4, NOW())",
-		}, pr.id, pr.name, pr.authorID, "OPEN")
-		require.NoError(s.T(), err)
+		s.backend.CreatePR(s.T(), pr.id, pr.name, pr.authorID, "OPEN")
 	}
 
 	reviewerAssignments := []struct {
@@ -118,13 +94,10 @@ func (s *StatisticsRepositoryTestSuite) TestGetReviewerStatistics_Success() {
 	}
 
 	for _, assignment := range reviewerAssignments {
-		_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-			QueryRaw: "INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at) VALUES ($1, $2, NOW())",
-		}, assignment.prID, assignment.reviewerID)
-		require.NoError(s.T(), err)
+		s.backend.AddReviewer(s.T(), assignment.prID, assignment.reviewerID)
 	}
 
-	stats, err := s.repo.GetReviewerStatistics(ctx)
+	stats, err := s.repo.GetReviewerStatistics(ctx, model.StatisticsFilter{})
 	require.NoError(s.T(), err)
 
 	assert.GreaterOrEqual(s.T(), len(stats), 3)
@@ -165,10 +138,7 @@ func (s *StatisticsRepositoryTestSuite) TestGetPRStatistics_Success() {
 	}
 
 	for _, pr := range prs {
-		_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-			QueryRaw: "INSERT INTO prs(id, name, author_id, status, created_at) VALUES ($1, $2, $3, $4, NOW())",
-		}, pr.id, pr.name, authorID, pr.status)
-		require.NoError(s.T(), err)
+		s.backend.CreatePR(s.T(), pr.id, pr.name, authorID, pr.status)
 	}
 
 	reviewerAssignments := []struct {
@@ -181,13 +151,10 @@ func (s *StatisticsRepositoryTestSuite) TestGetPRStatistics_Success() {
 	}
 
 	for _, assignment := range reviewerAssignments {
-		_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-			QueryRaw: "INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at) VALUES ($1, $2, NOW())",
-		}, assignment.prID, assignment.reviewerID)
-		require.NoError(s.T(), err)
+		s.backend.AddReviewer(s.T(), assignment.prID, assignment.reviewerID)
 	}
 
-	stats, err := s.repo.GetPRStatistics(ctx)
+	stats, err := s.repo.GetPRStatistics(ctx, model.StatisticsFilter{})
 	require.NoError(s.T(), err)
 
 	assert.Len(s.T(), stats, 3)
@@ -218,10 +185,47 @@ func (s *StatisticsRepositoryTestSuite) TestGetPRStatistics_Success() {
 	assert.Equal(s.T(), 0, statsMap[pr3ID].reviewerCount)
 }
 
+func (s *StatisticsRepositoryTestSuite) TestStreamPRStatistics_Success() {
+	ctx := context.Background()
+
+	authorID := s.getUserID("author1")
+	reviewerID := s.getUserID("reviewer1")
+
+	prID := uuid.New()
+	s.backend.CreatePR(s.T(), prID, "Streamed PR", authorID, "OPEN")
+	s.backend.AddReviewer(s.T(), prID, reviewerID)
+
+	var streamed []*model.PRStats
+	err := s.repo.StreamPRStatistics(ctx, model.StatisticsFilter{}, func(stat *model.PRStats) error {
+		streamed = append(streamed, stat)
+		return nil
+	})
+	require.NoError(s.T(), err)
+
+	require.Len(s.T(), streamed, 1)
+	assert.Equal(s.T(), prID, streamed[0].PRID)
+	assert.Equal(s.T(), "Streamed PR", streamed[0].PRName)
+	assert.Equal(s.T(), 1, streamed[0].ReviewerCount)
+}
+
+func (s *StatisticsRepositoryTestSuite) TestStreamReviewerStatistics_StopsOnCallbackError() {
+	ctx := context.Background()
+
+	stopErr := errors.New("stop")
+	calls := 0
+	err := s.repo.StreamReviewerStatistics(ctx, model.StatisticsFilter{}, func(*model.ReviewerStats) error {
+		calls++
+		return stopErr
+	})
+
+	assert.ErrorIs(s.T(), err, stopErr)
+	assert.Equal(s.T(), 1, calls)
+}
+
 func (s *StatisticsRepositoryTestSuite) TestGetReviewerStatistics_EmptyDatabase() {
 	ctx := context.Background()
 
-	stats, err := s.repo.GetReviewerStatistics(ctx)
+	stats, err := s.repo.GetReviewerStatistics(ctx, model.StatisticsFilter{})
 	require.NoError(s.T(), err)
 
 	assert.NotEmpty(s.T(), stats)
@@ -230,8 +234,55 @@ func (s *StatisticsRepositoryTestSuite) TestGetReviewerStatistics_EmptyDatabase(
 func (s *StatisticsRepositoryTestSuite) TestGetPRStatistics_EmptyDatabase() {
 	ctx := context.Background()
 
-	stats, err := s.repo.GetPRStatistics(ctx)
+	stats, err := s.repo.GetPRStatistics(ctx, model.StatisticsFilter{})
 	require.NoError(s.T(), err)
 
 	assert.Empty(s.T(), stats)
 }
+
+func (s *StatisticsRepositoryTestSuite) TestStatistics_IncludeHistory() {
+	ctx := context.Background()
+
+	authorID := s.getUserID("author1")
+	reviewer1ID := s.getUserID("reviewer1")
+	reviewer2ID := s.getUserID("reviewer2")
+
+	prID := uuid.New()
+	s.backend.CreatePR(s.T(), prID, "PR history", authorID, "OPEN")
+
+	// reviewer1 был назначен и заменен на reviewer2
+	s.backend.AddReviewer(s.T(), prID, reviewer2ID)
+	now := time.Now()
+	s.backend.CreateAssignment(s.T(), &model.ReviewerAssignment{
+		PRID: prID, ReviewerID: reviewer1ID, AssignedAt: now.Add(-time.Hour), UnassignedAt: &now, Reason: model.AssignmentInitial,
+	})
+	s.backend.CreateAssignment(s.T(), &model.ReviewerAssignment{
+		PRID: prID, ReviewerID: reviewer2ID, AssignedAt: now, Reason: model.AssignmentReassign,
+	})
+
+	reviewerCount := func(f model.StatisticsFilter) map[uuid.UUID]int {
+		stats, err := s.repo.GetReviewerStatistics(ctx, f)
+		require.NoError(s.T(), err)
+		m := make(map[uuid.UUID]int)
+		for _, stat := range stats {
+			m[stat.ReviewerID] = stat.AssignedCount
+		}
+		return m
+	}
+
+	current := reviewerCount(model.StatisticsFilter{})
+	assert.Equal(s.T(), 0, current[reviewer1ID])
+	assert.Equal(s.T(), 1, current[reviewer2ID])
+
+	historical := reviewerCount(model.StatisticsFilter{IncludeHistory: true})
+	assert.Equal(s.T(), 1, historical[reviewer1ID])
+	assert.Equal(s.T(), 1, historical[reviewer2ID])
+
+	prStats, err := s.repo.GetPRStatistics(ctx, model.StatisticsFilter{IncludeHistory: true})
+	require.NoError(s.T(), err)
+	for _, stat := range prStats {
+		if stat.PRID == prID {
+			assert.Equal(s.T(), 2, stat.ReviewerCount)
+		}
+	}
+}
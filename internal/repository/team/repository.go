@@ -26,7 +26,7 @@ func (r *repo) CreateTeam(ctx context.Context, teamName string) error {
 	query := `INSERT INTO teams(id, team_name)
 				VALUES ($1, $2)`
 	args := []any{uuid.New(), teamName}
-	_, err := r.db.DB().ExecContext(ctx, db.Query{QueryRaw: query}, args...)
+	_, err := r.db.DB().ExecContext(ctx, db.Query{Name: "team.CreateTeam", QueryRaw: query}, args...)
 	if err != nil {
 		return err
 	}
@@ -48,7 +48,7 @@ func (r *repo) CreateMembers(ctx context.Context, t *serviceModel.Team) error {
 		batch.Queue(query, u.ID, u.Username, t.TeamName, u.IsActive)
 	}
 
-	results := r.db.DB().SendBatch(ctx, batch)
+	results := r.db.DB().SendBatch(ctx, db.Query{Name: "team.CreateMembers"}, batch)
 	defer func() {
 		if err := results.Close(); err != nil {
 			log.Error().Msgf("Close row error: %v", err)
@@ -63,10 +63,45 @@ func (r *repo) CreateMembers(ctx context.Context, t *serviceModel.Team) error {
 	return results.Close()
 }
 
+// CreateTeams создает команды одним батчем
+func (r *repo) CreateTeams(ctx context.Context, names []string) error {
+	batch := &pgx.Batch{}
+	query := `INSERT INTO teams(id, team_name)
+				VALUES ($1, $2)`
+	for _, name := range names {
+		batch.Queue(query, uuid.New(), name)
+	}
+
+	results := r.db.DB().SendBatch(ctx, db.Query{Name: "team.CreateTeams"}, batch)
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.Error().Msgf("Close row error: %v", err)
+		}
+	}()
+	for range names {
+		if _, err := results.Exec(); err != nil {
+			return err
+		}
+	}
+
+	return results.Close()
+}
+
+// GetExistingNames возвращает те из names, для которых команда уже есть
+func (r *repo) GetExistingNames(ctx context.Context, names []string) ([]string, error) {
+	var existing []string
+	query := `SELECT team_name FROM teams WHERE team_name = ANY($1)`
+	err := r.db.DB().ScanAllContext(ctx, &existing, db.Query{Name: "team.GetExistingNames", QueryRaw: query}, names)
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
 func (r *repo) GetTeamIDByName(ctx context.Context, name string) (uuid.UUID, error) {
 	query := `SELECT id FROM teams WHERE team_name = $1`
 
-	res := r.db.DB().QueryRowContext(ctx, db.Query{QueryRaw: query}, name)
+	res := r.db.DB().QueryRowContext(ctx, db.Query{Name: "team.GetTeamIDByName", QueryRaw: query}, name)
 	var id uuid.UUID
 	err := res.Scan(&id)
 	if err != nil {
@@ -82,7 +117,7 @@ func (r *repo) GetTeamByName(ctx context.Context, name string) (*serviceModel.Te
 	query := `SELECT id, username, is_active
 				FROM users
 				WHERE team_name = $1`
-	err := r.db.DB().ScanAllContext(ctx, &members, db.Query{QueryRaw: query}, name)
+	err := r.db.DB().ScanAllContext(ctx, &members, db.Query{Name: "team.GetTeamByName", QueryRaw: query}, name)
 	if err != nil {
 		return nil, err
 	}
@@ -5,34 +5,34 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	"PR/internal/client/db"
 	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
 	testingpkg "PR/internal/repository/testing"
 )
 
 type TeamRepositoryTestSuite struct {
 	suite.Suite
-	db   *testingpkg.TestDatabase
-	repo *repo
+	backend *testingpkg.Backend
+	repo    repository.TeamRepository
 }
 
 func TestTeamRepositorySuite(t *testing.T) {
-	suite.Run(t, new(TeamRepositoryTestSuite))
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &TeamRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
 }
 
-func (s *TeamRepositoryTestSuite) SetupSuite() {
-	s.db = testingpkg.SetupTestDatabase(s.T())
-	s.repo = &repo{db: s.db.Client}
-}
-
-func (s *TeamRepositoryTestSuite) TearDownSuite() {
-	if s.db.Client != nil {
-		s.db.Client.Close()
+func newTestRepo(b *testingpkg.Backend) repository.TeamRepository {
+	if b.Store != nil {
+		return memory.NewTeamRepository(b.Store)
 	}
+	return &repo{db: b.Client}
 }
 
 func (s *TeamRepositoryTestSuite) TestCreateTeam_Success() {
@@ -42,13 +42,20 @@ func (s *TeamRepositoryTestSuite) TestCreateTeam_Success() {
 	err := s.repo.CreateTeam(ctx, teamName)
 	require.NoError(s.T(), err)
 
-	var count int
-	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT COUNT(*) FROM teams WHERE team_name = $1",
-	}, teamName)
-	err = row.Scan(&count)
+	_, err = s.repo.GetTeamIDByName(ctx, teamName)
 	require.NoError(s.T(), err)
-	assert.Equal(s.T(), 1, count)
+}
+
+func (s *TeamRepositoryTestSuite) TestCreateTeam_Duplicate() {
+	ctx := context.Background()
+	teamName := "duplicate-team"
+
+	require.NoError(s.T(), s.repo.CreateTeam(ctx, teamName))
+
+	err := s.repo.CreateTeam(ctx, teamName)
+	var pgErr *pgconn.PgError
+	require.ErrorAs(s.T(), err, &pgErr)
+	assert.Equal(s.T(), "23505", pgErr.Code)
 }
 
 func (s *TeamRepositoryTestSuite) TestCreateMembers_Success() {
@@ -82,13 +89,9 @@ func (s *TeamRepositoryTestSuite) TestCreateMembers_Success() {
 	err = s.repo.CreateMembers(ctx, team)
 	require.NoError(s.T(), err)
 
-	var count int
-	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT COUNT(*) FROM users WHERE team_name = $1",
-	}, teamName)
-	err = row.Scan(&count)
+	result, err := s.repo.GetTeamByName(ctx, teamName)
 	require.NoError(s.T(), err)
-	assert.Equal(s.T(), 3, count)
+	assert.Len(s.T(), result.Members, 3)
 }
 
 func (s *TeamRepositoryTestSuite) TestCreateMembers_Upsert() {
@@ -128,16 +131,13 @@ func (s *TeamRepositoryTestSuite) TestCreateMembers_Upsert() {
 	err = s.repo.CreateMembers(ctx, team2)
 	require.NoError(s.T(), err)
 
-	var username string
-	var isActive bool
-	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT username, is_active FROM users WHERE id = $1",
-	}, userID)
-	err = row.Scan(&username, &isActive)
+	result, err := s.repo.GetTeamByName(ctx, teamName)
 	require.NoError(s.T(), err)
+	require.Len(s.T(), result.Members, 1)
 
-	assert.Equal(s.T(), "mobile-dev-updated", username)
-	assert.False(s.T(), isActive)
+	assert.Equal(s.T(), userID, result.Members[0].ID)
+	assert.Equal(s.T(), "mobile-dev-updated", result.Members[0].Username)
+	assert.False(s.T(), result.Members[0].IsActive)
 }
 
 func (s *TeamRepositoryTestSuite) TestGetTeamIDByName_Success() {
@@ -187,3 +187,19 @@ func (s *TeamRepositoryTestSuite) TestGetTeamByName_Success() {
 	assert.Contains(s.T(), usernames, "qa2")
 	assert.Contains(s.T(), usernames, "qa3")
 }
+
+func (s *TeamRepositoryTestSuite) TestCreateTeamsAndGetExistingNames() {
+	ctx := context.Background()
+
+	err := s.repo.CreateTeams(ctx, []string{"alpha", "beta"})
+	require.NoError(s.T(), err)
+
+	existing, err := s.repo.GetExistingNames(ctx, []string{"alpha", "beta", "gamma"})
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), []string{"alpha", "beta"}, existing)
+
+	err = s.repo.CreateTeams(ctx, []string{"gamma", "alpha"})
+	var pgErr *pgconn.PgError
+	require.ErrorAs(s.T(), err, &pgErr)
+	assert.Equal(s.T(), "23505", pgErr.Code)
+}
@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+	"PR/internal/repository/memory"
+)
+
+const (
+	BackendPostgres = "postgres"
+	BackendMemory   = "memory"
+)
+
+// Backend — хранилище, на котором гоняются контрактные тесты репозиториев.
+// Для postgres задан Client, для memory — Store
+type Backend struct {
+	Name      string
+	Client    db.Client
+	Store     *memory.Store
+	TxManager db.TxManager
+
+	testDB *TestDatabase
+}
+
+// RunBackends запускает run подтестом на каждом хранилище: одни и те же
+// сценарии проверяют, что реализация в памяти ведет себя как Postgres.
+// Без Docker postgres подтест пропускается, а memory все равно выполняется
+func RunBackends(t *testing.T, run func(t *testing.T, b *Backend)) {
+	t.Run(BackendPostgres, func(t *testing.T) {
+		testDB := SetupTestDatabase(t)
+		t.Cleanup(func() { _ = testDB.Client.Close() })
+
+		run(t, &Backend{
+			Name:      BackendPostgres,
+			Client:    testDB.Client,
+			TxManager: testDB.TxManager,
+			testDB:    testDB,
+		})
+	})
+
+	t.Run(BackendMemory, func(t *testing.T) {
+		store := memory.NewStore()
+		run(t, &Backend{
+			Name:      BackendMemory,
+			Store:     store,
+			TxManager: memory.NewTxManager(store),
+		})
+	})
+}
+
+func (b *Backend) Cleanup(t *testing.T) {
+	if b.Store != nil {
+		b.Store.Reset()
+		return
+	}
+	b.testDB.CleanupTables(t)
+}
+
+// Методы ниже заполняют хранилище напрямую, минуя проверяемые репозитории
+
+func (b *Backend) CreateTeam(t *testing.T, name string) {
+	ctx := context.Background()
+	if b.Store != nil {
+		require.NoError(t, memory.NewTeamRepository(b.Store).CreateTeam(ctx, name))
+		return
+	}
+
+	_, err := b.Client.DB().ExecContext(ctx, db.Query{
+		QueryRaw: "INSERT INTO teams(id, team_name) VALUES ($1, $2)",
+	}, uuid.New(), name)
+	require.NoError(t, err)
+}
+
+func (b *Backend) CreateUser(t *testing.T, username, teamName string, isActive bool) uuid.UUID {
+	ctx := context.Background()
+	userID := uuid.New()
+	if b.Store != nil {
+		require.NoError(t, memory.NewTeamRepository(b.Store).CreateMembers(ctx, &model.Team{
+			TeamName: teamName,
+			Members:  []*model.TeamMember{{ID: userID, Username: username, IsActive: isActive}},
+		}))
+		return userID
+	}
+
+	_, err := b.Client.DB().ExecContext(ctx, db.Query{
+		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
+	}, userID, username, teamName, isActive)
+	require.NoError(t, err)
+	return userID
+}
+
+func (b *Backend) CreatePR(t *testing.T, id uuid.UUID, name string, authorID uuid.UUID, status string) {
+	ctx := context.Background()
+	if b.Store != nil {
+		require.NoError(t, memory.NewPullRequestRepository(b.Store).CreatePR(ctx, &model.PullRequest{
+			ID: id, Name: name, AuthorID: authorID, Status: status,
+		}))
+		return
+	}
+
+	_, err := b.Client.DB().ExecContext(ctx, db.Query{
+		QueryRaw: "INSERT INTO prs(id, name, author_id, status, created_at) VALUES ($1, $2, $3, $4, NOW())",
+	}, id, name, authorID, status)
+	require.NoError(t, err)
+}
+
+func (b *Backend) AddReviewer(t *testing.T, prID, reviewerID uuid.UUID) {
+	ctx := context.Background()
+	if b.Store != nil {
+		require.NoError(t, memory.NewPullRequestRepository(b.Store).AddReviewer(ctx, prID, reviewerID))
+		return
+	}
+
+	_, err := b.Client.DB().ExecContext(ctx, db.Query{
+		QueryRaw: "INSERT INTO pr_reviewers(pr_id, reviewer_id, assigned_at) VALUES ($1, $2, NOW())",
+	}, prID, reviewerID)
+	require.NoError(t, err)
+}
+
+// CreateAssignment добавляет запись истории; если задан UnassignedAt, назначение
+// сразу снято
+func (b *Backend) CreateAssignment(t *testing.T, a *model.ReviewerAssignment) {
+	ctx := context.Background()
+	if b.Store != nil {
+		require.NoError(t, memory.NewPullRequestRepository(b.Store).CreateAssignments(ctx, []*model.ReviewerAssignment{a}))
+		return
+	}
+
+	_, err := b.Client.DB().ExecContext(ctx, db.Query{
+		QueryRaw: `INSERT INTO pr_reviewer_assignments(pr_id, reviewer_id, assigned_at, unassigned_at, reason)
+			VALUES ($1, $2, $3, $4, $5)`,
+	}, a.PRID, a.ReviewerID, a.AssignedAt, a.UnassignedAt, a.Reason)
+	require.NoError(t, err)
+}
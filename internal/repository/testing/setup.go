@@ -2,15 +2,10 @@ package testing
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -20,6 +15,7 @@ import (
 	"PR/internal/client/db"
 	"PR/internal/client/db/pg"
 	"PR/internal/client/db/transaction"
+	"PR/internal/migrator"
 )
 
 const (
@@ -36,6 +32,8 @@ type TestDatabase struct {
 }
 
 func SetupTestDatabase(t *testing.T) *TestDatabase {
+	// без Docker testcontainers паникует и роняет весь пакет, поэтому тест пропускается
+	testcontainers.SkipIfProviderIsNotHealthy(t)
 	ctx := context.Background()
 
 	pgContainer, err := postgres.Run(
@@ -62,10 +60,10 @@ func SetupTestDatabase(t *testing.T) *TestDatabase {
 	connStr, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
 	require.NoError(t, err)
 
-	err = runMigrationsFromFiles(connStr, "../../../migrations")
+	err = runMigrations(ctx, connStr)
 	require.NoError(t, err)
 
-	client, err := pg.New(ctx, connStr)
+	client, err := pg.New(ctx, connStr, pg.QueryLogConfig{})
 	require.NoError(t, err)
 
 	txManager := transaction.NewTransactionManager(client.DB())
@@ -78,39 +76,37 @@ func SetupTestDatabase(t *testing.T) *TestDatabase {
 	}
 }
 
-func runMigrationsFromFiles(dbURI, migrationsPath string) error {
-	migrationURL := strings.Replace(dbURI, "postgres://", "pgx5://", 1)
-
-	m, err := migrate.New(
-		fmt.Sprintf("file://%s", migrationsPath),
-		migrationURL,
-	)
+func runMigrations(ctx context.Context, dsn string) error {
+	m, err := migrator.New(dsn, time.Minute)
 	if err != nil {
-		return fmt.Errorf("failed to create migrate instance: %w", err)
+		return fmt.Errorf("failed to create migrator: %w", err)
 	}
 	defer func() {
-		if errSource, errDatabase := m.Close(); errSource != nil {
-			log.Error().Msgf("Migrate source error: %v", errSource)
-		} else if errDatabase != nil {
-			log.Error().Msgf("Migrate database error: %v", errDatabase)
+		if err := m.Close(); err != nil {
+			log.Error().Msgf("Close migrator error: %v", err)
 		}
 	}()
 
-	err = m.Up()
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+	if err := m.Up(ctx); err != nil {
 		return fmt.Errorf("migration failed: %w", err)
 	}
-
 	return nil
 }
 
 func (td *TestDatabase) CleanupTables(t *testing.T) {
 	ctx := context.Background()
 	queries := []string{
+		"TRUNCATE TABLE pr_reviewer_assignments CASCADE",
 		"TRUNCATE TABLE pr_reviewers CASCADE",
 		"TRUNCATE TABLE prs CASCADE",
 		"TRUNCATE TABLE users CASCADE",
 		"TRUNCATE TABLE teams CASCADE",
+		"TRUNCATE TABLE api_keys CASCADE",
+		"TRUNCATE TABLE audit_events CASCADE",
+		"TRUNCATE TABLE idempotency_keys CASCADE",
+		"TRUNCATE TABLE rate_limit_buckets CASCADE",
+		"TRUNCATE TABLE team_sla_settings CASCADE",
+		"TRUNCATE TABLE holidays CASCADE",
 	}
 
 	for _, q := range queries {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
 
 	"PR/internal/client/db"
 	serviceModel "PR/internal/model"
@@ -22,9 +23,9 @@ func NewRepository(db db.Client) repository.UserRepository {
 }
 
 func (r *repo) GetByID(ctx context.Context, id uuid.UUID) (*serviceModel.User, error) {
-	query := "SELECT * FROM users WHERE id = $1"
+	query := "SELECT id, username, team_name, is_active FROM users WHERE id = $1"
 	var u repoModel.User
-	err := r.db.DB().ScanOneContext(ctx, &u, db.Query{QueryRaw: query}, id)
+	err := r.db.DB().ScanOneContext(ctx, &u, db.Query{Name: "user.GetByID", QueryRaw: query}, id)
 	if err != nil {
 		return nil, err
 	}
@@ -34,21 +35,71 @@ func (r *repo) GetByID(ctx context.Context, id uuid.UUID) (*serviceModel.User, e
 
 func (r *repo) GetActiveByTeam(ctx context.Context, teamName string) ([]*serviceModel.User, error) {
 	var teamMates []*repoModel.User
-	query := "SELECT * FROM users WHERE team_name = $1 AND is_active = true"
-	err := r.db.DB().ScanAllContext(ctx, &teamMates, db.Query{QueryRaw: query}, teamName)
+	query := "SELECT id, username, team_name, is_active FROM users WHERE team_name = $1 AND is_active = true"
+	err := r.db.DB().ScanAllContext(ctx, &teamMates, db.Query{Name: "user.GetActiveByTeam", QueryRaw: query}, teamName)
 	if err != nil {
 		return nil, err
 	}
 	return converter.FromRepoList(teamMates), nil
 }
 
+func (r *repo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*serviceModel.User, error) {
+	var users []*repoModel.User
+	query := "SELECT id, username, team_name, is_active FROM users WHERE id = ANY($1)"
+	err := r.db.DB().ScanAllContext(ctx, &users, db.Query{Name: "user.GetByIDs", QueryRaw: query}, ids)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoList(users), nil
+}
+
+func (r *repo) GetByTeams(ctx context.Context, teamNames []string) ([]*serviceModel.User, error) {
+	var users []*repoModel.User
+	query := "SELECT id, username, team_name, is_active FROM users WHERE team_name = ANY($1)"
+	err := r.db.DB().ScanAllContext(ctx, &users, db.Query{Name: "user.GetByTeams", QueryRaw: query}, teamNames)
+	if err != nil {
+		return nil, err
+	}
+	return converter.FromRepoList(users), nil
+}
+
+// Upsert создает или обновляет пользователей одним батчем
+func (r *repo) Upsert(ctx context.Context, users []*serviceModel.User) error {
+	batch := &pgx.Batch{}
+	query := `
+		INSERT INTO users(id, username, team_name, is_active)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET
+			username = EXCLUDED.username,
+			team_name = EXCLUDED.team_name,
+			is_active = EXCLUDED.is_active
+	`
+	for _, u := range users {
+		batch.Queue(query, u.ID, u.Username, u.TeamName, u.IsActive)
+	}
+
+	results := r.db.DB().SendBatch(ctx, db.Query{Name: "user.Upsert"}, batch)
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.Error().Msgf("Close row error: %v", err)
+		}
+	}()
+	for range users {
+		if _, err := results.Exec(); err != nil {
+			return err
+		}
+	}
+
+	return results.Close()
+}
+
 func (r *repo) SetActive(ctx context.Context, req *serviceModel.UserSetActive) error {
 	query := `
 		UPDATE users
 		SET is_active = $1
 		WHERE id = $2
 	`
-	res, err := r.db.DB().ExecContext(ctx, db.Query{QueryRaw: query}, req.IsActive, req.UserID)
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "user.SetActive", QueryRaw: query}, req.IsActive, req.UserID)
 	if err != nil {
 		return err
 	}
@@ -10,56 +10,41 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	"PR/internal/client/db"
 	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/repository/memory"
 	testingpkg "PR/internal/repository/testing"
 )
 
 type UserRepositoryTestSuite struct {
 	suite.Suite
-	db   *testingpkg.TestDatabase
-	repo *repo
+	backend *testingpkg.Backend
+	repo    repository.UserRepository
 }
 
 func TestUserRepositorySuite(t *testing.T) {
-	suite.Run(t, new(UserRepositoryTestSuite))
+	testingpkg.RunBackends(t, func(t *testing.T, b *testingpkg.Backend) {
+		suite.Run(t, &UserRepositoryTestSuite{backend: b, repo: newTestRepo(b)})
+	})
 }
 
-func (s *UserRepositoryTestSuite) SetupSuite() {
-	s.db = testingpkg.SetupTestDatabase(s.T())
-	s.repo = &repo{db: s.db.Client}
-}
-
-func (s *UserRepositoryTestSuite) TearDownSuite() {
-	if s.db.Client != nil {
-		s.db.Client.Close()
+func newTestRepo(b *testingpkg.Backend) repository.UserRepository {
+	if b.Store != nil {
+		return memory.NewUserRepository(b.Store)
 	}
+	return &repo{db: b.Client}
 }
-func (s *UserRepositoryTestSuite) SetupTest() {
-	s.db.CleanupTables(s.T())
-	s.seedTestData()
-}
-
-func (s *UserRepositoryTestSuite) seedTestData() {
-	ctx := context.Background()
 
-	teamID := uuid.New()
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO teams(id, team_name) VALUES ($1, $2)",
-	}, teamID, "test-team")
-	require.NoError(s.T(), err)
+func (s *UserRepositoryTestSuite) SetupTest() {
+	s.backend.Cleanup(s.T())
+	s.backend.CreateTeam(s.T(), "test-team")
 }
 
 func (s *UserRepositoryTestSuite) TestGetByID_Success() {
 	ctx := context.Background()
 
-	userID := uuid.New()
 	username := "test-user"
-
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, userID, username, "test-team", true)
-	require.NoError(s.T(), err)
+	userID := s.backend.CreateUser(s.T(), username, "test-team", true)
 
 	user, err := s.repo.GetByID(ctx, userID)
 	require.NoError(s.T(), err)
@@ -92,10 +77,7 @@ func (s *UserRepositoryTestSuite) TestGetActiveByTeam_Success() {
 	}
 
 	for _, u := range users {
-		_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-			QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-		}, uuid.New(), u.username, "test-team", u.isActive)
-		require.NoError(s.T(), err)
+		s.backend.CreateUser(s.T(), u.username, "test-team", u.isActive)
 	}
 
 	activeUsers, err := s.repo.GetActiveByTeam(ctx, "test-team")
@@ -111,10 +93,7 @@ func (s *UserRepositoryTestSuite) TestGetActiveByTeam_Success() {
 func (s *UserRepositoryTestSuite) TestGetActiveByTeam_NoActiveUsers() {
 	ctx := context.Background()
 
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, uuid.New(), "inactive", "test-team", false)
-	require.NoError(s.T(), err)
+	s.backend.CreateUser(s.T(), "inactive", "test-team", false)
 
 	activeUsers, err := s.repo.GetActiveByTeam(ctx, "test-team")
 	require.NoError(s.T(), err)
@@ -125,28 +104,19 @@ func (s *UserRepositoryTestSuite) TestGetActiveByTeam_NoActiveUsers() {
 func (s *UserRepositoryTestSuite) TestSetActive_Success() {
 	ctx := context.Background()
 
-	userID := uuid.New()
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, userID, "test-user", "test-team", true)
-	require.NoError(s.T(), err)
+	userID := s.backend.CreateUser(s.T(), "test-user", "test-team", true)
 
 	req := &model.UserSetActive{
 		UserID:   userID,
 		IsActive: false,
 	}
 
-	err = s.repo.SetActive(ctx, req)
+	err := s.repo.SetActive(ctx, req)
 	require.NoError(s.T(), err)
 
-	var isActive bool
-	row := s.db.Client.DB().QueryRowContext(ctx, db.Query{
-		QueryRaw: "SELECT is_active FROM users WHERE id = $1",
-	}, userID)
-	err = row.Scan(&isActive)
+	user, err := s.repo.GetByID(ctx, userID)
 	require.NoError(s.T(), err)
-
-	assert.False(s.T(), isActive)
+	assert.False(s.T(), user.IsActive)
 }
 
 func (s *UserRepositoryTestSuite) TestSetActive_UserNotFound() {
@@ -166,13 +136,9 @@ func (s *UserRepositoryTestSuite) TestSetActive_UserNotFound() {
 func (s *UserRepositoryTestSuite) TestSetActive_ToggleMultipleTimes() {
 	ctx := context.Background()
 
-	userID := uuid.New()
-	_, err := s.db.Client.DB().ExecContext(ctx, db.Query{
-		QueryRaw: "INSERT INTO users(id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-	}, userID, "toggle-user", "test-team", true)
-	require.NoError(s.T(), err)
+	userID := s.backend.CreateUser(s.T(), "toggle-user", "test-team", true)
 
-	err = s.repo.SetActive(ctx, &model.UserSetActive{UserID: userID, IsActive: false})
+	err := s.repo.SetActive(ctx, &model.UserSetActive{UserID: userID, IsActive: false})
 	require.NoError(s.T(), err)
 
 	err = s.repo.SetActive(ctx, &model.UserSetActive{UserID: userID, IsActive: true})
@@ -182,3 +148,57 @@ func (s *UserRepositoryTestSuite) TestSetActive_ToggleMultipleTimes() {
 	require.NoError(s.T(), err)
 	assert.True(s.T(), user.IsActive)
 }
+
+func (s *UserRepositoryTestSuite) TestGetByIDsAndTeams() {
+	ctx := context.Background()
+	s.backend.CreateTeam(s.T(), "other-team")
+
+	a := s.backend.CreateUser(s.T(), "a", "test-team", true)
+	b := s.backend.CreateUser(s.T(), "b", "test-team", false)
+	c := s.backend.CreateUser(s.T(), "c", "other-team", true)
+
+	byIDs, err := s.repo.GetByIDs(ctx, []uuid.UUID{a, c, uuid.New()})
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), []uuid.UUID{a, c}, userIDs(byIDs))
+
+	byTeams, err := s.repo.GetByTeams(ctx, []string{"test-team"})
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), []uuid.UUID{a, b}, userIDs(byTeams))
+}
+
+func (s *UserRepositoryTestSuite) TestUpsert() {
+	ctx := context.Background()
+	s.backend.CreateTeam(s.T(), "other-team")
+
+	existing := s.backend.CreateUser(s.T(), "old-name", "test-team", true)
+	created := uuid.New()
+
+	err := s.repo.Upsert(ctx, []*model.User{
+		{ID: existing, Username: "new-name", TeamName: "other-team", IsActive: false},
+		{ID: created, Username: "fresh", TeamName: "test-team", IsActive: true},
+	})
+	require.NoError(s.T(), err)
+
+	u, err := s.repo.GetByID(ctx, existing)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &model.User{ID: existing, Username: "new-name", TeamName: "other-team", IsActive: false}, u)
+
+	u, err = s.repo.GetByID(ctx, created)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "fresh", u.Username)
+}
+
+func (s *UserRepositoryTestSuite) TestUpsert_UnknownTeam() {
+	err := s.repo.Upsert(context.Background(), []*model.User{
+		{ID: uuid.New(), Username: "ghost", TeamName: "no-such-team", IsActive: true},
+	})
+	assert.Error(s.T(), err)
+}
+
+func userIDs(users []*model.User) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
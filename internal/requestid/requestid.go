@@ -0,0 +1,41 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Header = "X-Request-ID"
+
+// maxLen ограничивает чужой идентификатор, пришедший в заголовке
+const maxLen = 128
+
+type key struct{}
+
+func WithContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, key{}, id)
+}
+
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(key{}).(string)
+	return id
+}
+
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid пропускает только непустые печатные ASCII идентификаторы разумной длины,
+// чтобы их можно было без экранирования писать в логи и заголовки ответа
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+)
+
+// Create выпускает ключ. С userID ключ действует от имени пользователя и
+// проходит те же проверки владения PR, что и его JWT
+func (s *serv) Create(ctx context.Context, name string, role model.Role, userID *uuid.UUID) (*model.APIKeyCreated, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidRole
+	}
+
+	raw, err := generateKey()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Create error: %v", op, err)
+		return nil, err
+	}
+
+	key := model.APIKey{
+		ID:        uuid.New(),
+		Name:      name,
+		Role:      role,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err = s.repo.Create(ctx, &key, hashKey(raw))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return nil, ErrUserNotFound
+		}
+		zerolog.Ctx(ctx).Error().Msgf("%s.Create error: %v", op, err)
+		return nil, err
+	}
+
+	return &model.APIKeyCreated{APIKey: key, Key: raw}, nil
+}
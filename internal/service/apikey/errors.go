@@ -0,0 +1,11 @@
+package apikey
+
+import "errors"
+
+var (
+	ErrNotFound    = errors.New("api key not found")
+	ErrInvalidKey  = errors.New("invalid api key")
+	ErrInvalidRole = errors.New("invalid role")
+
+	ErrUserNotFound = errors.New("user not found")
+)
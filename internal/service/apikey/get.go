@@ -0,0 +1,46 @@
+package apikey
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+)
+
+func (s *serv) List(ctx context.Context) ([]*model.APIKey, error) {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.List error: %v", op, err)
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *serv) Authenticate(ctx context.Context, key string) (*model.APIKey, error) {
+	if key == "" {
+		return nil, ErrInvalidKey
+	}
+	hash := hashKey(key)
+
+	if s.bootstrapHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(s.bootstrapHash)) == 1 {
+		return &model.APIKey{Name: "bootstrap", Role: model.RoleAdmin}, nil
+	}
+
+	k, err := s.repo.GetByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidKey
+		}
+		zerolog.Ctx(ctx).Error().Msgf("%s.Authenticate error: %v", op, err)
+		return nil, err
+	}
+
+	if k.RevokedAt != nil || !k.Role.Valid() {
+		return nil, ErrInvalidKey
+	}
+	return k, nil
+}
@@ -0,0 +1,32 @@
+package apikey
+
+import (
+	"PR/internal/client/db"
+	"PR/internal/repository"
+	"PR/internal/service"
+)
+
+const op = "service.APIKeyService"
+
+type serv struct {
+	repo          repository.APIKeyRepository
+	txManager     db.TxManager
+	bootstrapHash string
+}
+
+// NewService принимает bootstrap-ключ из конфига: он всегда имеет роль admin
+// и нужен, чтобы выпустить первые ключи в пустой базе. Пустая строка отключает его.
+func NewService(
+	repo repository.APIKeyRepository,
+	txManager db.TxManager,
+	bootstrapKey string,
+) service.APIKeyService {
+	s := &serv{
+		repo:      repo,
+		txManager: txManager,
+	}
+	if bootstrapKey != "" {
+		s.bootstrapHash = hashKey(bootstrapKey)
+	}
+	return s
+}
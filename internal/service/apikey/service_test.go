@@ -0,0 +1,220 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func TestCreate(t *testing.T) {
+	userID := uuid.New()
+	tests := []struct {
+		name          string
+		role          model.Role
+		userID        *uuid.UUID
+		setupMocks    func(*mocks.MockAPIKeyRepository)
+		expectedError error
+	}{
+		{
+			name: "успешное создание ключа",
+			role: model.RoleMember,
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("Create", mock.Anything, mock.AnythingOfType("*model.APIKey"), mock.AnythingOfType("string")).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "ключ пользователя",
+			role:   model.RoleMember,
+			userID: &userID,
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(k *model.APIKey) bool {
+					return k.UserID != nil && *k.UserID == userID
+				}), mock.AnythingOfType("string")).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "неизвестный пользователь",
+			role:   model.RoleMember,
+			userID: &userID,
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: ErrUserNotFound,
+		},
+		{
+			name:          "неизвестная роль",
+			role:          model.Role("owner"),
+			setupMocks:    func(repo *mocks.MockAPIKeyRepository) {},
+			expectedError: ErrInvalidRole,
+		},
+		{
+			name: "ошибка репозитория",
+			role: model.RoleAdmin,
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockAPIKeyRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			tt.setupMocks(repo)
+
+			svc := NewService(repo, txMgr, "")
+
+			result, err := svc.Create(context.Background(), "ci-bot", tt.role, tt.userID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				if errors.Is(tt.expectedError, ErrInvalidRole) || errors.Is(tt.expectedError, ErrUserNotFound) {
+					assert.ErrorIs(t, err, tt.expectedError)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(result.Key, keyPrefix))
+				assert.Equal(t, tt.role, result.Role)
+				assert.Equal(t, tt.userID, result.UserID)
+				repo.AssertCalled(t, "Create", mock.Anything, mock.Anything, hashKey(result.Key))
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	revokedAt := time.Now()
+	keyID := uuid.New()
+
+	tests := []struct {
+		name          string
+		key           string
+		setupMocks    func(*mocks.MockAPIKeyRepository)
+		expectedRole  model.Role
+		expectedError error
+	}{
+		{
+			name:          "bootstrap ключ из конфига",
+			key:           "bootstrap-secret",
+			setupMocks:    func(repo *mocks.MockAPIKeyRepository) {},
+			expectedRole:  model.RoleAdmin,
+			expectedError: nil,
+		},
+		{
+			name: "действующий ключ из базы",
+			key:  "prk_member",
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("GetByHash", mock.Anything, hashKey("prk_member")).
+					Return(&model.APIKey{ID: keyID, Name: "ci", Role: model.RoleMember}, nil)
+			},
+			expectedRole:  model.RoleMember,
+			expectedError: nil,
+		},
+		{
+			name: "отозванный ключ",
+			key:  "prk_revoked",
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("GetByHash", mock.Anything, hashKey("prk_revoked")).
+					Return(&model.APIKey{ID: keyID, Role: model.RoleAdmin, RevokedAt: &revokedAt}, nil)
+			},
+			expectedError: ErrInvalidKey,
+		},
+		{
+			name: "неизвестный ключ",
+			key:  "prk_unknown",
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("GetByHash", mock.Anything, mock.Anything).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrInvalidKey,
+		},
+		{
+			name:          "пустой ключ",
+			key:           "",
+			setupMocks:    func(repo *mocks.MockAPIKeyRepository) {},
+			expectedError: ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockAPIKeyRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			tt.setupMocks(repo)
+
+			svc := NewService(repo, txMgr, "bootstrap-secret")
+
+			result, err := svc.Authenticate(context.Background(), tt.key)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRole, result.Role)
+			}
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*mocks.MockAPIKeyRepository)
+		expectedError error
+	}{
+		{
+			name: "успешный отзыв",
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				now := time.Now()
+				repo.On("Revoke", mock.Anything, mock.Anything).
+					Return(&model.APIKey{ID: uuid.New(), RevokedAt: &now}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "ключ не найден",
+			setupMocks: func(repo *mocks.MockAPIKeyRepository) {
+				repo.On("Revoke", mock.Anything, mock.Anything).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockAPIKeyRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			tt.setupMocks(repo)
+
+			svc := NewService(repo, txMgr, "")
+
+			result, err := svc.Revoke(context.Background(), uuid.New())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result.RevokedAt)
+			}
+		})
+	}
+}
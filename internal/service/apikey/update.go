@@ -0,0 +1,24 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+)
+
+func (s *serv) Revoke(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	key, err := s.repo.Revoke(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Revoke error: %v", op, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,26 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+
+	"PR/internal/model"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	ErrConflict           = errors.New("archive conflicts with database")
+)
+
+// ConflictError перечисляет все найденные проблемы архива, а не только первую
+type ConflictError struct {
+	Conflicts []*model.ArchiveConflict
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: %d conflict(s)", ErrConflict, len(e.Conflicts))
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
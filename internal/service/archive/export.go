@@ -0,0 +1,29 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+)
+
+// Export читает все разделы в одной транзакции RepeatableRead,
+// чтобы архив был согласованным снимком
+func (s *serv) Export(ctx context.Context) (*model.Archive, error) {
+	var a *model.Archive
+	err := s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+		var errTx error
+		a, errTx = s.repo.Export(ctx)
+		return errTx
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Export error: %v", op, err)
+		return nil, err
+	}
+
+	a.Version = model.ArchiveVersion
+	a.ExportedAt = time.Now().UTC()
+	return a, nil
+}
@@ -0,0 +1,253 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/businesstime"
+	"PR/internal/client/webhook"
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+var validReasons = map[string]bool{
+	model.AssignmentInitial:      true,
+	model.AssignmentReassign:     true,
+	model.AssignmentDeactivation: true,
+	model.AssignmentManual:       true,
+}
+
+// Import восстанавливает архив в пустую базу одной транзакцией. Архив
+// проверяется целиком до записи: все нарушенные ссылки и повторы, как и
+// уже существующие данные, возвращаются в ConflictError
+func (s *serv) Import(ctx context.Context, a *model.Archive) (*model.ArchiveCounts, error) {
+	if a.Version != model.ArchiveVersion {
+		return nil, fmt.Errorf("%w: %d, expected %d", ErrUnsupportedVersion, a.Version, model.ArchiveVersion)
+	}
+	if conflicts := validate(a); len(conflicts) > 0 {
+		return nil, &ConflictError{Conflicts: conflicts}
+	}
+
+	counts := &model.ArchiveCounts{
+		Teams:        len(a.Teams),
+		Users:        len(a.Users),
+		PullRequests: len(a.PullRequests),
+		Reviewers:    len(a.Reviewers),
+		Assignments:  len(a.Assignments),
+		SLASettings:  len(a.SLASettings),
+
+		UserWorkingHours: len(a.UserWorkingHours),
+		Holidays:         len(a.Holidays),
+	}
+
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		existing, errTx := s.repo.Counts(ctx)
+		if errTx != nil {
+			return errTx
+		}
+		if !existing.Empty() {
+			return &ConflictError{Conflicts: notEmptyConflicts(existing)}
+		}
+
+		if errTx = s.repo.Import(ctx, a); errTx != nil {
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditArchiveImport, model.AuditEntityArchive,
+			a.ExportedAt.UTC().Format(time.RFC3339), nil, counts)
+	})
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Import error: %v", op, err)
+		return nil, err
+	}
+	return counts, nil
+}
+
+// validate проверяет обязательные поля, повторы ключей и ссылки между разделами
+func validate(a *model.Archive) []*model.ArchiveConflict {
+	var conflicts []*model.ArchiveConflict
+	add := func(entity, id, format string, args ...any) {
+		conflicts = append(conflicts, &model.ArchiveConflict{Entity: entity, ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	teamIDs := make(map[uuid.UUID]bool, len(a.Teams))
+	teamNames := make(map[string]bool, len(a.Teams))
+	teamHours := make(map[string]model.WorkingHours, len(a.Teams))
+	for _, t := range a.Teams {
+		wh := model.DefaultWorkingHours()
+		if t.WorkingHours != nil {
+			wh = *t.WorkingHours
+		}
+		switch {
+		case t.TeamName == "":
+			add("teams", t.ID.String(), "team_name is required")
+		case teamNames[t.TeamName]:
+			add("teams", t.TeamName, "duplicate team_name")
+		case teamIDs[t.ID]:
+			add("teams", t.ID.String(), "duplicate id")
+		case businesstime.ValidateWorkingHours(wh) != nil:
+			add("teams", t.TeamName, "invalid working hours")
+		}
+		teamIDs[t.ID] = true
+		teamNames[t.TeamName] = true
+		teamHours[t.TeamName] = wh
+	}
+
+	type holidayKey struct{ date, team string }
+	holidays := make(map[holidayKey]bool, len(a.Holidays))
+	for _, h := range a.Holidays {
+		id := h.Date
+		if h.TeamName != "" {
+			id = h.TeamName + "/" + h.Date
+		}
+		switch {
+		case businesstime.ValidateDate(h.Date) != nil:
+			add("holidays", id, "date must be YYYY-MM-DD")
+		case h.TeamName != "" && !teamNames[h.TeamName]:
+			add("holidays", id, "unknown team")
+		case holidays[holidayKey{h.Date, h.TeamName}]:
+			add("holidays", id, "duplicate holiday")
+		case utf8.RuneCountInString(h.Name) > businesstime.MaxHolidayName:
+			add("holidays", id, "name is longer than %d characters", businesstime.MaxHolidayName)
+		}
+		holidays[holidayKey{h.Date, h.TeamName}] = true
+	}
+
+	slaTeams := make(map[string]bool, len(a.SLASettings))
+	for _, s := range a.SLASettings {
+		switch {
+		case !teamNames[s.TeamName]:
+			add("sla_settings", s.TeamName, "unknown team")
+		case slaTeams[s.TeamName]:
+			add("sla_settings", s.TeamName, "duplicate team_name")
+		case s.FirstReviewHours <= 0:
+			add("sla_settings", s.TeamName, "first_review_hours must be positive")
+		case s.ReminderIntervalHours < 0:
+			add("sla_settings", s.TeamName, "reminder_interval_hours must not be negative")
+		case s.AutoReassignHours < 0 || s.MaxAutoReassigns < 0:
+			add("sla_settings", s.TeamName, "auto_reassign_hours and max_auto_reassigns must not be negative")
+		case s.WebhookURL != "" && !webhook.ValidURL(s.WebhookURL):
+			add("sla_settings", s.TeamName, "webhook_url must be a public http(s) URL")
+		}
+		slaTeams[s.TeamName] = true
+	}
+
+	users := make(map[uuid.UUID]bool, len(a.Users))
+	for _, u := range a.Users {
+		id := u.ID.String()
+		switch {
+		case u.ID == uuid.Nil:
+			add("users", id, "user_id is required")
+		case users[u.ID]:
+			add("users", id, "duplicate user_id")
+		case u.Username == "":
+			add("users", id, "username is required")
+		case !teamNames[u.TeamName]:
+			add("users", id, "unknown team %q", u.TeamName)
+		}
+		users[u.ID] = true
+	}
+
+	userTeams := make(map[uuid.UUID]string, len(a.Users))
+	for _, u := range a.Users {
+		userTeams[u.ID] = u.TeamName
+	}
+	userHours := make(map[uuid.UUID]bool, len(a.UserWorkingHours))
+	for _, u := range a.UserWorkingHours {
+		id := u.UserID.String()
+		switch {
+		case !users[u.UserID]:
+			add("user_working_hours", id, "unknown user")
+		case userHours[u.UserID]:
+			add("user_working_hours", id, "duplicate user_id")
+		case businesstime.ValidateWorkingHours(u.WorkingHours.Or(teamHours[userTeams[u.UserID]])) != nil:
+			add("user_working_hours", id, "invalid working hours")
+		}
+		userHours[u.UserID] = true
+	}
+
+	prs := make(map[uuid.UUID]bool, len(a.PullRequests))
+	for _, pr := range a.PullRequests {
+		id := pr.ID.String()
+		switch {
+		case pr.ID == uuid.Nil:
+			add("pull_requests", id, "pull_request_id is required")
+		case prs[pr.ID]:
+			add("pull_requests", id, "duplicate pull_request_id")
+		case pr.Name == "":
+			add("pull_requests", id, "pull_request_name is required")
+		case pr.Status != "OPEN" && pr.Status != "MERGED":
+			add("pull_requests", id, "unknown status %q", pr.Status)
+		case pr.AuthorID != nil && !users[*pr.AuthorID]:
+			add("pull_requests", id, "unknown author %s", pr.AuthorID)
+		}
+		prs[pr.ID] = true
+	}
+
+	type pair struct{ pr, user uuid.UUID }
+	reviewers := make(map[pair]bool, len(a.Reviewers))
+	for _, r := range a.Reviewers {
+		id := r.PRID.String() + "/" + r.ReviewerID.String()
+		switch {
+		case !prs[r.PRID]:
+			add("reviewers", id, "unknown pull request")
+		case !users[r.ReviewerID]:
+			add("reviewers", id, "unknown reviewer")
+		case reviewers[pair{r.PRID, r.ReviewerID}]:
+			add("reviewers", id, "duplicate reviewer")
+		}
+		reviewers[pair{r.PRID, r.ReviewerID}] = true
+	}
+
+	active := make(map[pair]bool, len(a.Assignments))
+	for i, e := range a.Assignments {
+		id := fmt.Sprintf("%d", i+1)
+		switch {
+		case !prs[e.PRID]:
+			add("assignments", id, "unknown pull request %s", e.PRID)
+		case !users[e.ReviewerID]:
+			add("assignments", id, "unknown reviewer %s", e.ReviewerID)
+		case !validReasons[e.Reason]:
+			add("assignments", id, "unknown reason %q", e.Reason)
+		case e.UnassignedAt == nil && active[pair{e.PRID, e.ReviewerID}]:
+			add("assignments", id, "second active assignment of %s to %s", e.ReviewerID, e.PRID)
+		}
+		if e.UnassignedAt == nil {
+			active[pair{e.PRID, e.ReviewerID}] = true
+		}
+	}
+
+	return conflicts
+}
+
+// notEmptyConflicts описывает непустые таблицы: восстановление не сливает данные
+func notEmptyConflicts(c *model.ArchiveCounts) []*model.ArchiveConflict {
+	var conflicts []*model.ArchiveConflict
+	for _, t := range []struct {
+		entity string
+		n      int
+	}{
+		{"teams", c.Teams},
+		{"users", c.Users},
+		{"pull_requests", c.PullRequests},
+		{"reviewers", c.Reviewers},
+		{"assignments", c.Assignments},
+		{"sla_settings", c.SLASettings},
+		{"user_working_hours", c.UserWorkingHours},
+		{"holidays", c.Holidays},
+	} {
+		if t.n > 0 {
+			conflicts = append(conflicts, &model.ArchiveConflict{
+				Entity:  t.entity,
+				Message: fmt.Sprintf("database already has %d row(s)", t.n),
+			})
+		}
+	}
+	return conflicts
+}
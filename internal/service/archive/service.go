@@ -0,0 +1,27 @@
+package archive
+
+import (
+	"PR/internal/client/db"
+	"PR/internal/repository"
+	"PR/internal/service"
+)
+
+const op = "service.ArchiveService"
+
+type serv struct {
+	repo      repository.ArchiveRepository
+	auditRepo repository.AuditRepository
+	txManager db.TxManager
+}
+
+func NewService(
+	repo repository.ArchiveRepository,
+	auditRepo repository.AuditRepository,
+	txManager db.TxManager,
+) service.ArchiveService {
+	return &serv{
+		repo:      repo,
+		auditRepo: auditRepo,
+		txManager: txManager,
+	}
+}
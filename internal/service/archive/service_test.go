@@ -0,0 +1,220 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func validArchive() *model.Archive {
+	alice, bob, prID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now().UTC()
+	return &model.Archive{
+		Version:    model.ArchiveVersion,
+		ExportedAt: now,
+		Teams:      []*model.ArchiveTeam{{ID: uuid.New(), TeamName: "backend"}},
+		Users: []*model.User{
+			{ID: alice, Username: "alice", TeamName: "backend", IsActive: true},
+			{ID: bob, Username: "bob", TeamName: "backend", IsActive: true},
+		},
+		PullRequests: []*model.ArchivePullRequest{{ID: prID, Name: "feature", AuthorID: &alice, Status: "OPEN", CreatedAt: &now}},
+		Reviewers:    []*model.ArchiveReviewer{{PRID: prID, ReviewerID: bob, AssignedAt: &now}},
+		Assignments: []*model.ReviewerAssignment{
+			{PRID: prID, ReviewerID: bob, AssignedAt: now, UnassignedAt: &now, Reason: model.AssignmentInitial},
+			{PRID: prID, ReviewerID: bob, AssignedAt: now, Reason: model.AssignmentManual},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Empty(t, validate(validArchive()))
+
+	a := validArchive()
+	ghost := uuid.New()
+	a.Teams = append(a.Teams, &model.ArchiveTeam{ID: uuid.New(), TeamName: "backend"})
+	a.Users = append(a.Users, &model.User{ID: uuid.New(), Username: "carol", TeamName: "mobile"})
+	a.PullRequests[0].Status = "CLOSED"
+	a.Reviewers = append(a.Reviewers, &model.ArchiveReviewer{PRID: a.PullRequests[0].ID, ReviewerID: ghost})
+	a.Assignments = append(a.Assignments,
+		&model.ReviewerAssignment{PRID: a.PullRequests[0].ID, ReviewerID: a.Users[1].ID, Reason: model.AssignmentReassign},
+		&model.ReviewerAssignment{PRID: a.PullRequests[0].ID, ReviewerID: a.Users[0].ID, Reason: "magic"},
+	)
+
+	conflicts := validate(a)
+	entities := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		entities = append(entities, c.Entity)
+	}
+	// все проблемы собираются за один проход
+	assert.Equal(t, []string{"teams", "users", "pull_requests", "reviewers", "assignments", "assignments"}, entities)
+}
+
+func TestValidate_SLAWebhook(t *testing.T) {
+	a := validArchive()
+	a.SLASettings = []*model.TeamSLA{{TeamName: "backend", FirstReviewHours: 4, WebhookURL: "https://hooks.example.com/sla"}}
+	assert.Empty(t, validate(a))
+
+	a.SLASettings[0].WebhookURL = "http://10.0.0.5/hook"
+	conflicts := validate(a)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "webhook_url must be a public http(s) URL", conflicts[0].Message)
+}
+
+func TestValidate_WorkingHours(t *testing.T) {
+	a := validArchive()
+	// команда из архива до появления графиков получает график по умолчанию
+	a.UserWorkingHours = []*model.UserWorkingHours{{UserID: a.Users[0].ID, WorkingHours: model.WorkingHours{WorkEnd: "24:00"}}}
+	a.Holidays = []*model.Holiday{{Date: "2026-12-25"}, {Date: "2026-12-25", TeamName: "backend"}}
+	assert.Empty(t, validate(a))
+
+	a.Teams = append(a.Teams, &model.ArchiveTeam{ID: uuid.New(), TeamName: "mobile", WorkingHours: &model.WorkingHours{Timezone: "Mars/Olympus", WorkStart: "09:00", WorkEnd: "18:00"}})
+	a.UserWorkingHours = append(a.UserWorkingHours,
+		&model.UserWorkingHours{UserID: a.Users[1].ID, WorkingHours: model.WorkingHours{WorkStart: "20:00"}},
+		&model.UserWorkingHours{UserID: uuid.New(), WorkingHours: model.WorkingHours{Timezone: "UTC"}},
+	)
+	a.Holidays = append(a.Holidays,
+		&model.Holiday{Date: "2026-12-25"},
+		&model.Holiday{Date: "2026-12-31", TeamName: "missing"},
+		&model.Holiday{Date: "31.12.2026"},
+	)
+
+	conflicts := validate(a)
+	messages := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		messages = append(messages, c.Entity+": "+c.Message)
+	}
+	assert.Equal(t, []string{
+		"teams: invalid working hours",
+		"holidays: duplicate holiday",
+		"holidays: unknown team",
+		"holidays: date must be YYYY-MM-DD",
+		"user_working_hours: invalid working hours",
+		"user_working_hours: unknown user",
+	}, messages)
+}
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name       string
+		archive    func() *model.Archive
+		setupMocks func(*mocks.MockArchiveRepository, *mocks.MockAuditRepository, *mocks.MockTxManager)
+		expected   error
+	}{
+		{
+			name:    "success",
+			archive: validArchive,
+			setupMocks: func(repo *mocks.MockArchiveRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				txMgr.EXPECT().Serializable(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+				repo.EXPECT().Counts(mock.Anything).Return(&model.ArchiveCounts{}, nil)
+				repo.EXPECT().Import(mock.Anything, mock.Anything).Return(nil)
+				auditRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditArchiveImport && e.EntityType == model.AuditEntityArchive
+				})).Return(nil)
+			},
+		},
+		{
+			name: "unsupported version",
+			archive: func() *model.Archive {
+				a := validArchive()
+				a.Version = model.ArchiveVersion + 1
+				return a
+			},
+			setupMocks: func(*mocks.MockArchiveRepository, *mocks.MockAuditRepository, *mocks.MockTxManager) {},
+			expected:   ErrUnsupportedVersion,
+		},
+		{
+			name: "invalid archive is rejected before transaction",
+			archive: func() *model.Archive {
+				a := validArchive()
+				a.Users[0].TeamName = "missing"
+				return a
+			},
+			setupMocks: func(*mocks.MockArchiveRepository, *mocks.MockAuditRepository, *mocks.MockTxManager) {},
+			expected:   ErrConflict,
+		},
+		{
+			name:    "database not empty",
+			archive: validArchive,
+			setupMocks: func(repo *mocks.MockArchiveRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				txMgr.EXPECT().Serializable(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+				repo.EXPECT().Counts(mock.Anything).Return(&model.ArchiveCounts{Teams: 1, Users: 4}, nil)
+			},
+			expected: ErrConflict,
+		},
+		{
+			name:    "repository error",
+			archive: validArchive,
+			setupMocks: func(repo *mocks.MockArchiveRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				txMgr.EXPECT().Serializable(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+				repo.EXPECT().Counts(mock.Anything).Return(&model.ArchiveCounts{}, nil)
+				repo.EXPECT().Import(mock.Anything, mock.Anything).Return(errors.New("connection lost"))
+			},
+			expected: errors.New("connection lost"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockArchiveRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+			tt.setupMocks(repo, auditRepo, txMgr)
+
+			svc := NewService(repo, auditRepo, txMgr)
+			counts, err := svc.Import(context.Background(), tt.archive())
+
+			if tt.expected == nil {
+				require.NoError(t, err)
+				assert.Equal(t, model.ArchiveCounts{Teams: 1, Users: 2, PullRequests: 1, Reviewers: 1, Assignments: 2}, *counts)
+				return
+			}
+			require.Error(t, err)
+			assert.Nil(t, counts)
+			if errors.Is(tt.expected, ErrConflict) || errors.Is(tt.expected, ErrUnsupportedVersion) {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestImport_NotEmptyConflicts(t *testing.T) {
+	repo := mocks.NewMockArchiveRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	txMgr.EXPECT().Serializable(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	repo.EXPECT().Counts(mock.Anything).Return(&model.ArchiveCounts{Teams: 1, Users: 4}, nil)
+
+	_, err := NewService(repo, mocks.NewMockAuditRepository(t), txMgr).Import(context.Background(), validArchive())
+
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Len(t, conflict.Conflicts, 2)
+	assert.Equal(t, "teams", conflict.Conflicts[0].Entity)
+	assert.Equal(t, "users", conflict.Conflicts[1].Entity)
+}
+
+func TestExport(t *testing.T) {
+	repo := mocks.NewMockArchiveRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	txMgr.EXPECT().RepeatableRead(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	repo.EXPECT().Export(mock.Anything).Return(&model.Archive{}, nil)
+
+	a, err := NewService(repo, mocks.NewMockAuditRepository(t), txMgr).Export(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, model.ArchiveVersion, a.Version)
+	assert.False(t, a.ExportedAt.IsZero())
+}
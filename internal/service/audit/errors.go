@@ -0,0 +1,5 @@
+package audit
+
+import "errors"
+
+var ErrInvalidFilter = errors.New("invalid filter")
@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+)
+
+func (s *serv) List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error) {
+	if f.Limit == 0 {
+		f.Limit = model.AuditDefaultLimit
+	}
+	if f.Limit < 0 || f.Limit > model.AuditMaxLimit || f.Offset < 0 {
+		return nil, ErrInvalidFilter
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return nil, ErrInvalidFilter
+	}
+
+	events, err := s.repo.List(ctx, f)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.List error: %v", op, err)
+		return nil, err
+	}
+	return events, nil
+}
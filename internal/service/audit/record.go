@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"PR/internal/actor"
+	"PR/internal/model"
+	"PR/internal/repository"
+	"PR/internal/requestid"
+)
+
+// NewEvent собирает событие из вызывающего и request ID в контексте.
+// before/after сериализуются в JSON; nil означает, что сущности не было.
+func NewEvent(ctx context.Context, action, entityType, entityID string, before, after any) (*model.AuditEvent, error) {
+	e := &model.AuditEvent{
+		ID:         uuid.New(),
+		OccurredAt: time.Now().UTC(),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		RequestID:  requestid.FromContext(ctx),
+	}
+
+	if a, ok := actor.FromContext(ctx); ok {
+		e.ActorName = a.Name
+		e.ActorRole = string(a.Role)
+		e.ActorUserID = a.UserID
+		e.ActorKeyID = a.KeyID
+	}
+
+	var err error
+	if e.Before, err = marshal(before); err != nil {
+		return nil, fmt.Errorf("marshal before: %w", err)
+	}
+	if e.After, err = marshal(after); err != nil {
+		return nil, fmt.Errorf("marshal after: %w", err)
+	}
+	return e, nil
+}
+
+// Record пишет событие через repo. Вызывается внутри транзакции изменяющего
+// метода, поэтому событие фиксируется или откатывается вместе с изменением.
+func Record(ctx context.Context, repo repository.AuditRepository, action, entityType, entityID string, before, after any) error {
+	e, err := NewEvent(ctx, action, entityType, entityID, before, after)
+	if err != nil {
+		return err
+	}
+	return repo.Create(ctx, e)
+}
+
+func marshal(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// типизированный nil-указатель тоже означает отсутствие сущности
+	if string(b) == "null" {
+		return nil, nil
+	}
+	return b, nil
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"PR/internal/repository"
+	"PR/internal/service"
+)
+
+const op = "service.AuditService"
+
+type serv struct {
+	repo repository.AuditRepository
+}
+
+func NewService(repo repository.AuditRepository) service.AuditService {
+	return &serv{repo: repo}
+}
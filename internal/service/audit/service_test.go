@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"PR/internal/actor"
+	"PR/internal/mocks"
+	"PR/internal/model"
+	"PR/internal/requestid"
+)
+
+func TestList(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		filter        *model.AuditFilter
+		setupMocks    func(*mocks.MockAuditRepository)
+		expectedLimit int
+		expectedError error
+	}{
+		{
+			name:   "лимит по умолчанию",
+			filter: &model.AuditFilter{},
+			setupMocks: func(repo *mocks.MockAuditRepository) {
+				repo.On("List", mock.Anything, mock.Anything).Return([]*model.AuditEvent{}, nil)
+			},
+			expectedLimit: model.AuditDefaultLimit,
+		},
+		{
+			name:          "лимит больше максимального",
+			filter:        &model.AuditFilter{Limit: model.AuditMaxLimit + 1},
+			setupMocks:    func(repo *mocks.MockAuditRepository) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "отрицательное смещение",
+			filter:        &model.AuditFilter{Offset: -1},
+			setupMocks:    func(repo *mocks.MockAuditRepository) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "from позже to",
+			filter:        &model.AuditFilter{From: &now, To: &earlier},
+			setupMocks:    func(repo *mocks.MockAuditRepository) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:   "ошибка репозитория",
+			filter: &model.AuditFilter{Limit: 10},
+			setupMocks: func(repo *mocks.MockAuditRepository) {
+				repo.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockAuditRepository(t)
+			tt.setupMocks(repo)
+
+			svc := NewService(repo)
+
+			_, err := svc.List(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrInvalidFilter) {
+					assert.ErrorIs(t, err, ErrInvalidFilter)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLimit, tt.filter.Limit)
+			}
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	userID := uuid.New()
+	keyID := uuid.New()
+
+	ctx := actor.WithContext(context.Background(), &actor.Actor{
+		Name:   "alice",
+		Role:   model.RoleMember,
+		UserID: &userID,
+		KeyID:  &keyID,
+	})
+	ctx = requestid.WithContext(ctx, "req-42")
+
+	e, err := NewEvent(ctx, model.AuditTeamCreate, model.AuditEntityTeam, "backend", nil, &model.Team{TeamName: "backend"})
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, uuid.Nil, e.ID)
+	assert.Equal(t, "alice", e.ActorName)
+	assert.Equal(t, "member", e.ActorRole)
+	assert.Equal(t, &userID, e.ActorUserID)
+	assert.Equal(t, &keyID, e.ActorKeyID)
+	assert.Equal(t, "req-42", e.RequestID)
+	assert.Nil(t, e.Before)
+	assert.JSONEq(t, `{"team_name":"backend","members":null}`, string(e.After))
+}
+
+func TestNewEvent_WithoutActor(t *testing.T) {
+	var missing *model.User
+
+	e, err := NewEvent(context.Background(), model.AuditUserSetActive, model.AuditEntityUser, "u1", missing, nil)
+	assert.NoError(t, err)
+
+	assert.Empty(t, e.ActorName)
+	assert.Nil(t, e.ActorUserID)
+	assert.Empty(t, e.RequestID)
+	assert.Nil(t, e.Before)
+	assert.Nil(t, e.After)
+}
@@ -0,0 +1,12 @@
+package calendar
+
+import "errors"
+
+var (
+	ErrTeamNotFound        = errors.New("team not found")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrInvalidWorkingHours = errors.New("invalid working hours")
+	ErrInvalidHoliday      = errors.New("invalid holiday")
+	ErrHolidayExists       = errors.New("holiday already exists")
+	ErrHolidayNotFound     = errors.New("holiday not found")
+)
@@ -0,0 +1,105 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+
+	"PR/internal/businesstime"
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+func (s *serv) AddHoliday(ctx context.Context, h *model.Holiday) (*model.Holiday, error) {
+	if businesstime.ValidateDate(h.Date) != nil || utf8.RuneCountInString(h.Name) > businesstime.MaxHolidayName {
+		return nil, ErrInvalidHoliday
+	}
+
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.calendarRepo.AddHoliday(ctx, h)
+		if errTx != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(errTx, &pgErr) {
+				switch pgErr.Code {
+				case "23505":
+					return ErrHolidayExists
+				case "23503":
+					return ErrTeamNotFound
+				}
+			}
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditHolidayAdd, model.AuditEntityHoliday, holidayID(h), nil, h)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.AddHoliday error: %v", op, err)
+		return nil, err
+	}
+	return h, nil
+}
+
+// RemoveHoliday — без teamName удаляется общий праздник
+func (s *serv) RemoveHoliday(ctx context.Context, date, teamName string) error {
+	if businesstime.ValidateDate(date) != nil {
+		return ErrInvalidHoliday
+	}
+
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		list, errTx := s.calendarRepo.GetHolidays(ctx, &model.HolidayFilter{TeamName: teamName, From: date, To: date})
+		if errTx != nil {
+			return errTx
+		}
+		var before *model.Holiday
+		for _, h := range list {
+			if h.TeamName == teamName {
+				before = h
+			}
+		}
+
+		errTx = s.calendarRepo.RemoveHoliday(ctx, date, teamName)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrHolidayNotFound
+			}
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditHolidayRemove, model.AuditEntityHoliday,
+			holidayID(&model.Holiday{Date: date, TeamName: teamName}), before, nil)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.RemoveHoliday error: %v", op, err)
+		return err
+	}
+	return nil
+}
+
+// ListHolidays — с TeamName праздники команды вместе с общими
+func (s *serv) ListHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error) {
+	if f.From != "" && businesstime.ValidateDate(f.From) != nil || f.To != "" && businesstime.ValidateDate(f.To) != nil {
+		return nil, ErrInvalidHoliday
+	}
+	if f.From != "" && f.To != "" && f.From > f.To {
+		return nil, ErrInvalidHoliday
+	}
+
+	list, err := s.calendarRepo.GetHolidays(ctx, f)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.ListHolidays error: %v", op, err)
+		return nil, err
+	}
+	return list, nil
+}
+
+// holidayID — ID праздника в аудите: дата, у праздника команды с ее именем
+func holidayID(h *model.Holiday) string {
+	if h.TeamName == "" {
+		return h.Date
+	}
+	return h.TeamName + "/" + h.Date
+}
@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/businesstime"
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+func (s *serv) SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) (*model.TeamWorkingHours, error) {
+	if teamName == "" || businesstime.ValidateWorkingHours(*wh) != nil {
+		return nil, ErrInvalidWorkingHours
+	}
+
+	res := &model.TeamWorkingHours{TeamName: teamName, WorkingHours: *wh}
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		before, errTx := s.calendarRepo.GetTeamHours(ctx, teamName)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrTeamNotFound
+			}
+			return errTx
+		}
+
+		errTx = s.calendarRepo.SetTeamHours(ctx, teamName, wh)
+		if errTx != nil {
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditTeamSetHours, model.AuditEntityTeam, teamName,
+			&model.TeamWorkingHours{TeamName: teamName, WorkingHours: *before}, res)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.SetTeamHours error: %v", op, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetUserHours задает собственный график пользователя; пустые поля wh
+// берутся у команды. Итоговый график должен быть верным
+func (s *serv) SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) (*model.UserSchedule, error) {
+	var res *model.UserSchedule
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		before, errTx := s.getSchedule(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+		team, errTx := s.calendarRepo.GetTeamHours(ctx, before.TeamName)
+		if errTx != nil {
+			return errTx
+		}
+
+		res = &model.UserSchedule{
+			UserID:       userID,
+			TeamName:     before.TeamName,
+			Override:     *wh,
+			WorkingHours: wh.Or(*team),
+		}
+		if businesstime.ValidateWorkingHours(res.WorkingHours) != nil {
+			return ErrInvalidWorkingHours
+		}
+
+		errTx = s.calendarRepo.SetUserHours(ctx, userID, wh)
+		if errTx != nil {
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditUserSetHours, model.AuditEntityUser, userID.String(), before, res)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.SetUserHours error: %v", op, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *serv) GetTeamHours(ctx context.Context, teamName string) (*model.TeamWorkingHours, error) {
+	wh, err := s.calendarRepo.GetTeamHours(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetTeamHours error: %v", op, err)
+		return nil, err
+	}
+	return &model.TeamWorkingHours{TeamName: teamName, WorkingHours: *wh}, nil
+}
+
+func (s *serv) GetUserSchedule(ctx context.Context, userID uuid.UUID) (*model.UserSchedule, error) {
+	schedule, err := s.getSchedule(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, ErrUserNotFound) {
+			zerolog.Ctx(ctx).Error().Msgf("%s.GetUserSchedule error: %v", op, err)
+		}
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (s *serv) getSchedule(ctx context.Context, userID uuid.UUID) (*model.UserSchedule, error) {
+	list, err := s.calendarRepo.GetSchedules(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrUserNotFound
+	}
+	return list[0], nil
+}
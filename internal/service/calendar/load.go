@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/businesstime"
+	"PR/internal/model"
+	"PR/internal/repository"
+)
+
+// Calendars — рабочие календари пользователей по ID
+type Calendars struct {
+	byUser map[uuid.UUID]*businesstime.Calendar
+	def    *businesstime.Calendar
+}
+
+// Get — календарь пользователя; для неизвестного — график по умолчанию
+// с общими праздниками
+func (c *Calendars) Get(userID uuid.UUID) *businesstime.Calendar {
+	if cal, ok := c.byUser[userID]; ok {
+		return cal
+	}
+	return c.def
+}
+
+type scheduleKey struct {
+	teamName string
+	hours    model.WorkingHours
+}
+
+// Load собирает календари пользователей с праздниками их команд и общими
+// за период с from по to; по ним сервисы SLA и PR считают рабочее время.
+// Если собственный график пользователя перестал сочетаться с графиком команды
+// (например, начало дня позже нового конца), используется график по умолчанию
+func Load(ctx context.Context, repo repository.CalendarRepository, userIDs []uuid.UUID, from, to time.Time) (*Calendars, error) {
+	// запас в сутки с каждой стороны покрывает любой часовой пояс
+	holidays, err := repo.GetHolidays(ctx, &model.HolidayFilter{
+		From: from.UTC().AddDate(0, 0, -1).Format(businesstime.DateLayout),
+		To:   to.UTC().AddDate(0, 0, 1).Format(businesstime.DateLayout),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []*model.UserSchedule
+	if len(userIDs) > 0 {
+		schedules, err = repo.GetSchedules(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byTeam := make(map[string][]string)
+	for _, h := range holidays {
+		byTeam[h.TeamName] = append(byTeam[h.TeamName], h.Date)
+	}
+	build := func(teamName string, wh model.WorkingHours) (*businesstime.Calendar, error) {
+		dates := byTeam[""]
+		if teamName != "" {
+			dates = slices.Concat(dates, byTeam[teamName])
+		}
+		return businesstime.New(wh.Timezone, wh.WorkStart, wh.WorkEnd, dates)
+	}
+
+	def, err := build("", model.DefaultWorkingHours())
+	if err != nil {
+		return nil, err
+	}
+	res := &Calendars{byUser: make(map[uuid.UUID]*businesstime.Calendar, len(schedules)), def: def}
+
+	// у пользователей одной команды обычно один график, календарь строится один раз
+	cache := make(map[scheduleKey]*businesstime.Calendar)
+	for _, s := range schedules {
+		key := scheduleKey{teamName: s.TeamName, hours: s.WorkingHours}
+		cal, ok := cache[key]
+		if !ok {
+			cal, err = build(s.TeamName, s.WorkingHours)
+			if err != nil {
+				zerolog.Ctx(ctx).Warn().Msgf("%s.Load user %s: invalid working hours %+v: %v", op, s.UserID, s.WorkingHours, err)
+				cal, err = build(s.TeamName, model.DefaultWorkingHours())
+				if err != nil {
+					return nil, err
+				}
+			}
+			cache[key] = cal
+		}
+		res.byUser[s.UserID] = cal
+	}
+	return res, nil
+}
@@ -0,0 +1,27 @@
+package calendar
+
+import (
+	"PR/internal/client/db"
+	"PR/internal/repository"
+	"PR/internal/service"
+)
+
+const op = "service.CalendarService"
+
+type serv struct {
+	calendarRepo repository.CalendarRepository
+	auditRepo    repository.AuditRepository
+	txManager    db.TxManager
+}
+
+func NewService(
+	calendarRepo repository.CalendarRepository,
+	auditRepo repository.AuditRepository,
+	txManager db.TxManager,
+) service.CalendarService {
+	return &serv{
+		calendarRepo: calendarRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+	}
+}
@@ -0,0 +1,255 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func passTx(txMgr *mocks.MockTxManager) {
+	txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) }).Maybe()
+}
+
+func newTestService(t *testing.T, repo *mocks.MockCalendarRepository) *serv {
+	auditRepo := mocks.NewMockAuditRepository(t)
+	auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+	txMgr := mocks.NewMockTxManager(t)
+	passTx(txMgr)
+	return NewService(repo, auditRepo, txMgr).(*serv)
+}
+
+func TestSetTeamHours(t *testing.T) {
+	tests := []struct {
+		name          string
+		hours         model.WorkingHours
+		setupMocks    func(*mocks.MockCalendarRepository)
+		expectedError error
+	}{
+		{
+			name:  "новый график",
+			hours: model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "08:30", WorkEnd: "17:00"},
+			setupMocks: func(r *mocks.MockCalendarRepository) {
+				def := model.DefaultWorkingHours()
+				r.On("GetTeamHours", mock.Anything, "backend").Return(&def, nil)
+				r.On("SetTeamHours", mock.Anything, "backend", mock.AnythingOfType("*model.WorkingHours")).Return(nil)
+			},
+		},
+		{
+			name:  "команда не найдена",
+			hours: model.DefaultWorkingHours(),
+			setupMocks: func(r *mocks.MockCalendarRepository) {
+				r.On("GetTeamHours", mock.Anything, "backend").Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:          "неизвестный часовой пояс",
+			hours:         model.WorkingHours{Timezone: "Mars/Olympus", WorkStart: "09:00", WorkEnd: "18:00"},
+			setupMocks:    func(r *mocks.MockCalendarRepository) {},
+			expectedError: ErrInvalidWorkingHours,
+		},
+		{
+			name:          "Local зависит от сервера",
+			hours:         model.WorkingHours{Timezone: "Local", WorkStart: "09:00", WorkEnd: "18:00"},
+			setupMocks:    func(r *mocks.MockCalendarRepository) {},
+			expectedError: ErrInvalidWorkingHours,
+		},
+		{
+			name:          "конец раньше начала",
+			hours:         model.WorkingHours{Timezone: "UTC", WorkStart: "18:00", WorkEnd: "09:00"},
+			setupMocks:    func(r *mocks.MockCalendarRepository) {},
+			expectedError: ErrInvalidWorkingHours,
+		},
+		{
+			name:          "час из одной цифры",
+			hours:         model.WorkingHours{Timezone: "UTC", WorkStart: "9:00", WorkEnd: "18:00"},
+			setupMocks:    func(r *mocks.MockCalendarRepository) {},
+			expectedError: ErrInvalidWorkingHours,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockCalendarRepository(t)
+			tt.setupMocks(repo)
+
+			res, err := newTestService(t, repo).SetTeamHours(context.Background(), "backend", &tt.hours)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.hours, res.WorkingHours)
+		})
+	}
+}
+
+func TestSetUserHours(t *testing.T) {
+	userID := uuid.New()
+	team := model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "09:00", WorkEnd: "18:00"}
+	schedule := &model.UserSchedule{UserID: userID, TeamName: "backend", WorkingHours: team}
+
+	tests := []struct {
+		name          string
+		override      model.WorkingHours
+		setupMocks    func(*mocks.MockCalendarRepository)
+		expected      model.WorkingHours
+		expectedError error
+	}{
+		{
+			name:     "пустые поля берутся у команды",
+			override: model.WorkingHours{WorkStart: "07:00"},
+			setupMocks: func(r *mocks.MockCalendarRepository) {
+				r.On("GetSchedules", mock.Anything, []uuid.UUID{userID}).Return([]*model.UserSchedule{schedule}, nil)
+				r.On("GetTeamHours", mock.Anything, "backend").Return(&team, nil)
+				r.On("SetUserHours", mock.Anything, userID, &model.WorkingHours{WorkStart: "07:00"}).Return(nil)
+			},
+			expected: model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "07:00", WorkEnd: "18:00"},
+		},
+		{
+			name:     "итоговый график неверен",
+			override: model.WorkingHours{WorkStart: "19:00"},
+			setupMocks: func(r *mocks.MockCalendarRepository) {
+				r.On("GetSchedules", mock.Anything, []uuid.UUID{userID}).Return([]*model.UserSchedule{schedule}, nil)
+				r.On("GetTeamHours", mock.Anything, "backend").Return(&team, nil)
+			},
+			expectedError: ErrInvalidWorkingHours,
+		},
+		{
+			name:     "пользователь не найден",
+			override: model.WorkingHours{Timezone: "UTC"},
+			setupMocks: func(r *mocks.MockCalendarRepository) {
+				r.On("GetSchedules", mock.Anything, []uuid.UUID{userID}).Return([]*model.UserSchedule{}, nil)
+			},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockCalendarRepository(t)
+			tt.setupMocks(repo)
+
+			res, err := newTestService(t, repo).SetUserHours(context.Background(), userID, &tt.override)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.override, res.Override)
+			assert.Equal(t, tt.expected, res.WorkingHours)
+		})
+	}
+}
+
+func TestAddHoliday(t *testing.T) {
+	tests := []struct {
+		name          string
+		holiday       *model.Holiday
+		repoErr       error
+		expectedError error
+	}{
+		{
+			name:    "общий праздник",
+			holiday: &model.Holiday{Date: "2026-12-25", Name: "Christmas"},
+		},
+		{
+			name:          "уже есть",
+			holiday:       &model.Holiday{Date: "2026-12-25"},
+			repoErr:       &pgconn.PgError{Code: "23505"},
+			expectedError: ErrHolidayExists,
+		},
+		{
+			name:          "команда не найдена",
+			holiday:       &model.Holiday{Date: "2026-12-25", TeamName: "missing"},
+			repoErr:       &pgconn.PgError{Code: "23503"},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:          "неверная дата",
+			holiday:       &model.Holiday{Date: "2026-02-30"},
+			expectedError: ErrInvalidHoliday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockCalendarRepository(t)
+			repo.On("AddHoliday", mock.Anything, tt.holiday).Return(tt.repoErr).Maybe()
+
+			res, err := newTestService(t, repo).AddHoliday(context.Background(), tt.holiday)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.holiday, res)
+		})
+	}
+}
+
+func TestRemoveHoliday_NotFound(t *testing.T) {
+	repo := mocks.NewMockCalendarRepository(t)
+	repo.On("GetHolidays", mock.Anything, &model.HolidayFilter{TeamName: "backend", From: "2026-12-25", To: "2026-12-25"}).
+		Return([]*model.Holiday{{Date: "2026-12-25"}}, nil)
+	repo.On("RemoveHoliday", mock.Anything, "2026-12-25", "backend").Return(pgx.ErrNoRows)
+
+	// общий праздник в тот же день не удаляется вместо праздника команды
+	err := newTestService(t, repo).RemoveHoliday(context.Background(), "2026-12-25", "backend")
+	assert.ErrorIs(t, err, ErrHolidayNotFound)
+}
+
+func TestListHolidays_InvalidRange(t *testing.T) {
+	svc := newTestService(t, mocks.NewMockCalendarRepository(t))
+
+	_, err := svc.ListHolidays(context.Background(), &model.HolidayFilter{From: "2026-12-31", To: "2026-01-01"})
+	assert.ErrorIs(t, err, ErrInvalidHoliday)
+
+	_, err = svc.ListHolidays(context.Background(), &model.HolidayFilter{From: "31.12.2026"})
+	assert.ErrorIs(t, err, ErrInvalidHoliday)
+}
+
+func TestLoad(t *testing.T) {
+	backend, frontend, broken, unknown := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	berlin := model.WorkingHours{Timezone: "Europe/Berlin", WorkStart: "09:00", WorkEnd: "18:00"}
+
+	repo := mocks.NewMockCalendarRepository(t)
+	repo.On("GetHolidays", mock.Anything, &model.HolidayFilter{From: "2026-12-23", To: "2026-12-29"}).
+		Return([]*model.Holiday{
+			{Date: "2026-12-25"},
+			{Date: "2026-12-28", TeamName: "backend"},
+		}, nil)
+	repo.On("GetSchedules", mock.Anything, []uuid.UUID{backend, frontend, broken, unknown}).
+		Return([]*model.UserSchedule{
+			{UserID: backend, TeamName: "backend", WorkingHours: berlin},
+			{UserID: frontend, TeamName: "frontend", WorkingHours: berlin},
+			// собственный график перестал сочетаться с новым графиком команды
+			{UserID: broken, TeamName: "frontend", WorkingHours: model.WorkingHours{Timezone: "UTC", WorkStart: "19:00", WorkEnd: "18:00"}},
+		}, nil)
+
+	from := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 12, 28, 23, 0, 0, 0, time.UTC)
+	cals, err := Load(context.Background(), repo, []uuid.UUID{backend, frontend, broken, unknown}, from, to)
+	require.NoError(t, err)
+
+	// 24.12 — четверг, 25.12 — общий праздник, 28.12 — праздник backend
+	assert.Equal(t, 9*time.Hour, cals.Get(backend).Between(from, to))
+	assert.Equal(t, 18*time.Hour, cals.Get(frontend).Between(from, to))
+	assert.Equal(t, 18*time.Hour, cals.Get(broken).Between(from, to))
+	assert.Equal(t, 18*time.Hour, cals.Get(unknown).Between(from, to))
+	assert.False(t, cals.Get(unknown).IsWorkday(time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,49 @@
+package pr
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"PR/internal/actor"
+	"PR/internal/model"
+)
+
+// ownershipCaller — пользователь, к которому применяются правила владения.
+// Внутренние вызовы без вызывающего, админы и сервисные API ключи, не привязанные
+// к пользователю, проходят без проверки (check=false)
+func ownershipCaller(ctx context.Context) (userID uuid.UUID, check bool) {
+	a, ok := actor.FromContext(ctx)
+	if !ok || a.IsAdmin() || a.UserID == nil {
+		return uuid.UUID{}, false
+	}
+	return *a.UserID, true
+}
+
+// canMerge: смержить PR может только его автор
+func canMerge(ctx context.Context, pr *model.PullRequest) bool {
+	userID, check := ownershipCaller(ctx)
+	if !check {
+		return true
+	}
+	return pr.AuthorID == userID
+}
+
+// canReassign: переназначить ревьюера может автор или назначенный ревьюер
+func canReassign(ctx context.Context, pr *model.PullRequest) bool {
+	userID, check := ownershipCaller(ctx)
+	if !check {
+		return true
+	}
+	return pr.AuthorID == userID || slices.Contains(pr.AssignedReviewers, userID)
+}
+
+// canReview: отметить ревью может только сам назначенный ревьюер
+func canReview(ctx context.Context, reviewerID uuid.UUID) bool {
+	userID, check := ownershipCaller(ctx)
+	if !check {
+		return true
+	}
+	return reviewerID == userID
+}
@@ -7,15 +7,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
+	"PR/internal/service/audit"
 )
 
 func (s *serv) Create(ctx context.Context, p *model.PullRequestShort) (*model.PullRequest, error) {
 
 	var pr *model.PullRequest
-	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
 		var errTx error
 
 		author, errTx := s.userRepo.GetByID(ctx, p.AuthorID)
@@ -35,7 +36,7 @@ func (s *serv) Create(ctx context.Context, p *model.PullRequestShort) (*model.Pu
 			return errTx
 		}
 
-		reviewers := selectReviewers(teamMembers, p.AuthorID, 2)
+		reviewers := selectReviewers(teamMembers, p.AuthorID, maxReviewers)
 
 		pr = &model.PullRequest{
 			ID:                p.ID,
@@ -60,11 +61,20 @@ func (s *serv) Create(ctx context.Context, p *model.PullRequestShort) (*model.Pu
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+
+		errTx = s.pullRequestRepo.CreateAssignments(ctx, newAssignments(ctx, pr.ID, reviewers, model.AssignmentInitial))
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.publisher.Publish(ctx, newEvent(model.EventPRCreated, pr, author.TeamName, nil, nil)); errTx != nil {
+			return errTx
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditPRCreate, model.AuditEntityPR, pr.ID.String(), nil, pr)
 	})
 
 	if err != nil {
-		log.Error().Msgf("%s.Create error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.Create error: %v", op, err)
 		return nil, err
 	}
 	return pr, nil
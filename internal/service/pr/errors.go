@@ -3,10 +3,14 @@ package pr
 import "errors"
 
 var (
-	ErrNotFound    = errors.New("not found")
-	ErrNotActive   = errors.New("not active")
-	ErrPRExists    = errors.New("PR exists")
-	ErrNoCandidate = errors.New("no candidate")
-	ErrPRMerged    = errors.New("PR merged")
-	ErrNoAssigned  = errors.New("no assigned")
+	ErrNotFound        = errors.New("not found")
+	ErrNotActive       = errors.New("not active")
+	ErrPRExists        = errors.New("PR exists")
+	ErrNoCandidate     = errors.New("no candidate")
+	ErrPRMerged        = errors.New("PR merged")
+	ErrNoAssigned      = errors.New("no assigned")
+	ErrForbidden       = errors.New("forbidden")
+	ErrAuthorReviewer  = errors.New("author cannot review own PR")
+	ErrAlreadyAssigned = errors.New("already assigned")
+	ErrReviewersLimit  = errors.New("reviewers limit reached")
 )
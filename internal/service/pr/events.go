@@ -0,0 +1,31 @@
+package pr
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"PR/internal/model"
+)
+
+// newEvent описывает изменение PR для потока событий. Затронуты автор,
+// текущие ревьюеры и снятый ревьюер, если он есть
+func newEvent(typ string, pr *model.PullRequest, teamName string, reviewerID, replacedID *uuid.UUID) *model.Event {
+	users := append([]uuid.UUID{pr.AuthorID}, pr.AssignedReviewers...)
+	for _, id := range []*uuid.UUID{reviewerID, replacedID} {
+		if id != nil && !slices.Contains(users, *id) {
+			users = append(users, *id)
+		}
+	}
+
+	return &model.Event{
+		Type:     typ,
+		TeamName: teamName,
+		UserIDs:  users,
+		Data: &model.PREventData{
+			PullRequest: pr,
+			ReviewerID:  reviewerID,
+			ReplacedID:  replacedID,
+		},
+	}
+}
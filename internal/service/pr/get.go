@@ -2,18 +2,73 @@ package pr
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/rs/zerolog/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
+	"PR/internal/service/calendar"
 )
 
+// GetByReviewer возвращает PR ревьюера; у открытых AgeHours — сколько его
+// рабочих часов прошло с назначения
 func (s *serv) GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*model.PullRequestShort, error) {
 	prs, err := s.pullRequestRepo.GetByReviewer(ctx, userID)
 	if err != nil {
-		log.Error().Msgf("%s.GetByReviewer error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetByReviewer error: %v", op, err)
 		return nil, err
 	}
+
+	now := time.Now().UTC()
+	var open []*model.PullRequestShort
+	from := now
+	for _, p := range prs {
+		if p.Status != "OPEN" || p.AssignedAt == nil {
+			continue
+		}
+		open = append(open, p)
+		if p.AssignedAt.Before(from) {
+			from = *p.AssignedAt
+		}
+	}
+	if len(open) == 0 {
+		return prs, nil
+	}
+
+	cals, err := calendar.Load(ctx, s.calendarRepo, []uuid.UUID{userID}, from, now)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetByReviewer error: %v", op, err)
+		return nil, err
+	}
+	cal := cals.Get(userID)
+	for _, p := range open {
+		// с точностью до минуты, чтобы не отдавать наносекунды
+		age := cal.Between(*p.AssignedAt, now).Round(time.Minute).Hours()
+		p.AgeHours = &age
+	}
 	return prs, nil
 }
+
+func (s *serv) GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error) {
+	var history []*model.ReviewerAssignment
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		_, errTx := s.pullRequestRepo.GetByID(ctx, prID)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
+			return errTx
+		}
+
+		history, errTx = s.pullRequestRepo.GetHistory(ctx, prID)
+		return errTx
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetHistory error: %v", op, err)
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,41 @@
+package pr
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"PR/internal/actor"
+	"PR/internal/model"
+)
+
+// newAssignments готовит записи истории для назначенных ревьюеров от имени вызывающего
+func newAssignments(ctx context.Context, prID uuid.UUID, reviewers []uuid.UUID, reason string) []*model.ReviewerAssignment {
+	now := time.Now().UTC()
+	a, _ := actor.FromContext(ctx)
+
+	list := make([]*model.ReviewerAssignment, 0, len(reviewers))
+	for _, id := range reviewers {
+		item := &model.ReviewerAssignment{
+			PRID:       prID,
+			ReviewerID: id,
+			AssignedAt: now,
+			Reason:     reason,
+		}
+		if a != nil {
+			item.ActorName = a.Name
+			item.ActorUserID = a.UserID
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+// replaceReason: замена неактивного ревьюера — следствие деактивации, а не ручного решения
+func replaceReason(old *model.User) string {
+	if !old.IsActive {
+		return model.AssignmentDeactivation
+	}
+	return model.AssignmentReassign
+}
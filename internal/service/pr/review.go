@@ -0,0 +1,58 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+// Review отмечает, что назначенный ревьюер оставил ревью. Повторная отметка
+// не сдвигает время первого ревью, по которому считается SLA
+func (s *serv) Review(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, *model.PRReview, error) {
+	var (
+		pr     *model.PullRequest
+		review *model.PRReview
+	)
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+		pr, errTx = s.pullRequestRepo.GetByID(ctx, prID)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
+			return errTx
+		}
+
+		if !canReview(ctx, reviewerID) {
+			return ErrForbidden
+		}
+		if pr.Status == "MERGED" {
+			return ErrPRMerged
+		}
+
+		review, errTx = s.pullRequestRepo.MarkReviewed(ctx, prID, reviewerID, time.Now().UTC())
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrNoAssigned
+			}
+			return errTx
+		}
+
+		if errTx = s.publisher.Publish(ctx, newEvent(model.EventPRReviewed, pr, "", &reviewerID, nil)); errTx != nil {
+			return errTx
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditPRReview, model.AuditEntityPR, pr.ID.String(), nil, review)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Review error: %v", op, err)
+		return nil, nil, err
+	}
+	return pr, review, nil
+}
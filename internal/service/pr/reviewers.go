@@ -0,0 +1,140 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+// maxReviewers — сколько ревьюеров может быть назначено на PR одновременно
+const maxReviewers = 2
+
+func (s *serv) AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	var pr *model.PullRequest
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		before, errTx := s.openPRForChange(ctx, prID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.checkReviewer(ctx, before, reviewerID)
+		if errTx != nil {
+			return errTx
+		}
+		if len(before.AssignedReviewers) >= maxReviewers {
+			return ErrReviewersLimit
+		}
+
+		errTx = s.pullRequestRepo.AddReviewer(ctx, prID, reviewerID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.pullRequestRepo.CreateAssignments(ctx, newAssignments(ctx, prID, []uuid.UUID{reviewerID}, model.AssignmentManual))
+		if errTx != nil {
+			return errTx
+		}
+
+		pr, errTx = s.pullRequestRepo.GetByID(ctx, prID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.publisher.Publish(ctx, newEvent(model.EventReviewerAssigned, pr, "", &reviewerID, nil)); errTx != nil {
+			return errTx
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditPRAddReviewer, model.AuditEntityPR, pr.ID.String(), before, pr)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.AddReviewer error: %v", op, err)
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (s *serv) RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	var pr *model.PullRequest
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		before, errTx := s.openPRForChange(ctx, prID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.pullRequestRepo.RemoveReviewer(ctx, prID, reviewerID)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrNoAssigned
+			}
+			return errTx
+		}
+
+		errTx = s.pullRequestRepo.CloseAssignment(ctx, prID, reviewerID)
+		if errTx != nil && !errors.Is(errTx, pgx.ErrNoRows) {
+			return errTx
+		}
+
+		pr, errTx = s.pullRequestRepo.GetByID(ctx, prID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.publisher.Publish(ctx, newEvent(model.EventReviewerRemoved, pr, "", &reviewerID, nil)); errTx != nil {
+			return errTx
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditPRRemoveReviewer, model.AuditEntityPR, pr.ID.String(), before, pr)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.RemoveReviewer error: %v", op, err)
+		return nil, err
+	}
+	return pr, nil
+}
+
+// openPRForChange загружает PR, который вызывающий может менять: он существует,
+// открыт и вызывающий — автор или назначенный ревьюер
+func (s *serv) openPRForChange(ctx context.Context, prID uuid.UUID) (*model.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if !canReassign(ctx, pr) {
+		return nil, ErrForbidden
+	}
+	if pr.Status == "MERGED" {
+		return nil, ErrPRMerged
+	}
+	return pr, nil
+}
+
+// checkReviewer проверяет явно выбранного ревьюера: он существует, активен,
+// не автор PR и еще не назначен на него
+func (s *serv) checkReviewer(ctx context.Context, pr *model.PullRequest, reviewerID uuid.UUID) error {
+	user, err := s.userRepo.GetByID(ctx, reviewerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	switch {
+	case !user.IsActive:
+		return ErrNotActive
+	case user.ID == pr.AuthorID:
+		return ErrAuthorReviewer
+	case slices.Contains(pr.AssignedReviewers, user.ID):
+		return ErrAlreadyAssigned
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package pr
 
 import (
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/repository"
 	"PR/internal/service"
 )
@@ -11,17 +12,26 @@ const op = "service.PullRequestService"
 type serv struct {
 	pullRequestRepo repository.PullRequestRepository
 	userRepo        repository.UserRepository
+	calendarRepo    repository.CalendarRepository
+	auditRepo       repository.AuditRepository
 	txManager       db.TxManager
+	publisher       events.Publisher
 }
 
 func NewService(
 	pullRequestRepo repository.PullRequestRepository,
 	userRepo repository.UserRepository,
+	calendarRepo repository.CalendarRepository,
+	auditRepo repository.AuditRepository,
 	txManager db.TxManager,
+	publisher events.Publisher,
 ) service.PullRequestService {
 	return &serv{
 		pullRequestRepo: pullRequestRepo,
 		userRepo:        userRepo,
+		calendarRepo:    calendarRepo,
+		auditRepo:       auditRepo,
 		txManager:       txManager,
+		publisher:       publisher,
 	}
 }
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"PR/internal/actor"
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/mocks"
 	"PR/internal/model"
 )
@@ -45,7 +49,7 @@ func TestCreate(t *testing.T) {
 
 				teamMembers := []*model.User{author, reviewer1, reviewer2}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -55,6 +59,9 @@ func TestCreate(t *testing.T) {
 				userRepo.On("GetActiveByTeam", mock.Anything, teamName).Return(teamMembers, nil)
 				prRepo.On("CreatePR", mock.Anything, mock.AnythingOfType("*model.PullRequest")).Return(nil)
 				prRepo.On("CreatePRReviewers", mock.Anything, mock.AnythingOfType("*model.PullRequest")).Return(nil)
+				prRepo.On("CreateAssignments", mock.Anything, mock.MatchedBy(func(list []*model.ReviewerAssignment) bool {
+					return len(list) == 2 && list[0].Reason == model.AssignmentInitial
+				})).Return(nil)
 			},
 			expectedError: nil,
 		},
@@ -66,7 +73,7 @@ func TestCreate(t *testing.T) {
 				AuthorID: uuid.New(),
 			},
 			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -90,7 +97,7 @@ func TestCreate(t *testing.T) {
 					TeamName: "team",
 				}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -118,7 +125,7 @@ func TestCreate(t *testing.T) {
 
 				pgErr := &pgconn.PgError{Code: "23505"}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -136,11 +143,13 @@ func TestCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			prRepo := mocks.NewMockPullRequestRepository(t)
 			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
 			tt.setupMocks(prRepo, userRepo, txMgr)
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
 
-			svc := NewService(prRepo, userRepo, txMgr)
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
 
 			result, err := svc.Create(context.Background(), tt.input)
 
@@ -189,6 +198,20 @@ func TestGetByReviewer(t *testing.T) {
 			expectedCount: 0,
 			expectedError: nil,
 		},
+		{
+			name:       "возраст только у открытых PR",
+			reviewerID: uuid.New(),
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				assigned := time.Now().UTC().Add(-72 * time.Hour)
+				prs := []*model.PullRequestShort{
+					{ID: uuid.New(), Name: "open", AuthorID: uuid.New(), Status: "OPEN", AssignedAt: &assigned},
+					{ID: uuid.New(), Name: "merged", AuthorID: uuid.New(), Status: "MERGED", AssignedAt: &assigned},
+				}
+				prRepo.On("GetByReviewer", mock.Anything, mock.Anything).Return(prs, nil)
+			},
+			expectedCount: 2,
+			expectedError: nil,
+		},
 		{
 			name:       "ошибка репозитория",
 			reviewerID: uuid.New(),
@@ -205,9 +228,13 @@ func TestGetByReviewer(t *testing.T) {
 			userRepo := mocks.NewMockUserRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
+			calendarRepo := mocks.NewMockCalendarRepository(t)
+
 			tt.setupMocks(prRepo)
+			calendarRepo.On("GetHolidays", mock.Anything, mock.Anything).Return([]*model.Holiday{}, nil).Maybe()
+			calendarRepo.On("GetSchedules", mock.Anything, []uuid.UUID{tt.reviewerID}).Return([]*model.UserSchedule{}, nil).Maybe()
 
-			svc := NewService(prRepo, userRepo, txMgr)
+			svc := NewService(prRepo, userRepo, calendarRepo, mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0))
 
 			result, err := svc.GetByReviewer(context.Background(), tt.reviewerID)
 
@@ -217,65 +244,145 @@ func TestGetByReviewer(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
 				assert.Len(t, result, tt.expectedCount)
+				for _, p := range result {
+					if p.Status != "OPEN" {
+						assert.Nil(t, p.AgeHours)
+						continue
+					}
+					// за 72 часа всегда проходит хотя бы один полный рабочий день,
+					// но выходные в возраст не входят
+					require.NotNil(t, p.AgeHours)
+					assert.GreaterOrEqual(t, *p.AgeHours, 9.0)
+					assert.LessOrEqual(t, *p.AgeHours, 27.0)
+				}
 			}
 		})
 	}
 }
 
 func TestMerge(t *testing.T) {
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	openPR := &model.PullRequest{
+		ID:                uuid.New(),
+		Name:              "open-pr",
+		AuthorID:          authorID,
+		Status:            "OPEN",
+		AssignedReviewers: []uuid.UUID{reviewerID},
+	}
+	mergedPR := &model.PullRequest{
+		ID:     openPR.ID,
+		Name:   "merged-pr",
+		Status: "MERGED",
+	}
+
 	tests := []struct {
 		name          string
-		prID          uuid.UUID
+		actor         *actor.Actor
 		setupMocks    func(*mocks.MockPullRequestRepository)
 		expectedError error
 	}{
 		{
 			name: "успешный merge PR",
-			prID: uuid.New(),
 			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
-				mergedPR := &model.PullRequest{
-					ID:     uuid.New(),
-					Name:   "merged-pr",
-					Status: "MERGED",
-				}
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
 				prRepo.On("Merge", mock.Anything, mock.Anything).Return(mergedPR, nil)
 			},
 			expectedError: nil,
 		},
 		{
 			name: "PR не найден",
-			prID: uuid.New(),
 			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
-				prRepo.On("Merge", mock.Anything, mock.Anything).Return(nil, pgx.ErrNoRows)
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(nil, pgx.ErrNoRows)
 			},
 			expectedError: ErrNotFound,
 		},
 		{
 			name: "ошибка базы данных",
-			prID: uuid.New(),
 			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
 				prRepo.On("Merge", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
 			},
 			expectedError: errors.New("db error"),
 		},
+		{
+			name:  "автор мержит свой PR",
+			actor: &actor.Actor{Role: model.RoleMember, UserID: &authorID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("Merge", mock.Anything, openPR.ID).Return(mergedPR, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "ревьюер не может смержить PR",
+			actor: &actor.Actor{Role: model.RoleMember, UserID: &reviewerID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+			},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:  "админ мержит чужой PR без проверки",
+			actor: &actor.Actor{Role: model.RoleAdmin, UserID: &reviewerID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("Merge", mock.Anything, openPR.ID).Return(mergedPR, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "сервисный API ключ member мержит PR",
+			actor: &actor.Actor{Name: "ci", Role: model.RoleMember},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("Merge", mock.Anything, openPR.ID).Return(mergedPR, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "API ключ ревьюера не может смержить PR",
+			actor: &actor.Actor{Name: "bob-cli", Role: model.RoleMember, UserID: &reviewerID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+			},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:  "PR не найден при проверке владельца",
+			actor: &actor.Actor{Role: model.RoleMember, UserID: &authorID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			prRepo := mocks.NewMockPullRequestRepository(t)
 			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+			txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
 			tt.setupMocks(prRepo)
 
-			svc := NewService(prRepo, userRepo, txMgr)
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
 
-			result, err := svc.Merge(context.Background(), tt.prID)
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = actor.WithContext(ctx, tt.actor)
+			}
+			result, err := svc.Merge(ctx, openPR.ID)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				if errors.Is(tt.expectedError, ErrNotFound) {
-					assert.ErrorIs(t, err, ErrNotFound)
+				assert.Nil(t, result)
+				if errors.Is(tt.expectedError, ErrNotFound) || errors.Is(tt.expectedError, ErrForbidden) {
+					assert.ErrorIs(t, err, tt.expectedError)
 				}
 			} else {
 				assert.NoError(t, err)
@@ -314,6 +421,7 @@ func TestReassignReviewers(t *testing.T) {
 				user := &model.User{
 					ID:       oldReviewerID,
 					TeamName: "team",
+					IsActive: true,
 				}
 
 				teamMembers := []*model.User{
@@ -330,7 +438,7 @@ func TestReassignReviewers(t *testing.T) {
 					AssignedReviewers: []uuid.UUID{currentReviewerID, newReviewerID},
 				}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -340,6 +448,10 @@ func TestReassignReviewers(t *testing.T) {
 				userRepo.On("GetByID", mock.Anything, mock.Anything).Return(user, nil)
 				userRepo.On("GetActiveByTeam", mock.Anything, "team").Return(teamMembers, nil)
 				prRepo.On("ReassignReviewers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				prRepo.On("CloseAssignment", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				prRepo.On("CreateAssignments", mock.Anything, mock.MatchedBy(func(list []*model.ReviewerAssignment) bool {
+					return len(list) == 1 && list[0].ReviewerID == newReviewerID && list[0].Reason == model.AssignmentReassign
+				})).Return(nil)
 				prRepo.On("GetByID", mock.Anything, mock.Anything).Return(updatedPR, nil).Once()
 			},
 			expectedError: nil,
@@ -349,7 +461,7 @@ func TestReassignReviewers(t *testing.T) {
 			oldID: uuid.New(),
 			prID:  uuid.New(),
 			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -369,7 +481,7 @@ func TestReassignReviewers(t *testing.T) {
 					Status: "MERGED",
 				}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -406,7 +518,7 @@ func TestReassignReviewers(t *testing.T) {
 					{ID: reviewer2, IsActive: true},
 				}
 
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
 						_ = fn(args.Get(0).(context.Context))
@@ -424,13 +536,15 @@ func TestReassignReviewers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			prRepo := mocks.NewMockPullRequestRepository(t)
 			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
 			tt.setupMocks(prRepo, userRepo, txMgr)
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
 
-			svc := NewService(prRepo, userRepo, txMgr)
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
 
-			result, replaceBy, err := svc.ReassignReviewers(context.Background(), tt.oldID, tt.prID)
+			result, replaceBy, err := svc.ReassignReviewers(context.Background(), tt.oldID, tt.prID, nil)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -493,3 +607,777 @@ func TestSelectReviewers(t *testing.T) {
 		})
 	}
 }
+
+func TestReassignReviewers_Forbidden(t *testing.T) {
+	outsiderID := uuid.New()
+	pr := &model.PullRequest{
+		ID:                uuid.New(),
+		AuthorID:          uuid.New(),
+		Status:            "OPEN",
+		AssignedReviewers: []uuid.UUID{uuid.New()},
+	}
+
+	tests := []struct {
+		name  string
+		actor *actor.Actor
+	}{
+		{name: "посторонний пользователь", actor: &actor.Actor{Role: model.RoleMember, UserID: &outsiderID}},
+		{name: "API ключ постороннего пользователя", actor: &actor.Actor{Name: "carol-cli", Role: model.RoleMember, UserID: &outsiderID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil)
+
+			svc := NewService(prRepo, mocks.NewMockUserRepository(t), mocks.NewMockCalendarRepository(t),
+				mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0))
+
+			ctx := actor.WithContext(context.Background(), tt.actor)
+			result, replaceBy, err := svc.ReassignReviewers(ctx, pr.AssignedReviewers[0], pr.ID, nil)
+
+			assert.ErrorIs(t, err, ErrForbidden)
+			assert.Nil(t, result)
+			assert.Equal(t, uuid.UUID{}, replaceBy)
+		})
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	outsiderID := uuid.New()
+	keyID := uuid.New()
+	pr := &model.PullRequest{
+		AuthorID:          authorID,
+		AssignedReviewers: []uuid.UUID{reviewerID},
+	}
+
+	tests := []struct {
+		name        string
+		actor       *actor.Actor
+		canMerge    bool
+		canReassign bool
+	}{
+		{
+			name:        "внутренний вызов без вызывающего",
+			canMerge:    true,
+			canReassign: true,
+		},
+		{
+			name:        "автор",
+			actor:       &actor.Actor{Role: model.RoleMember, UserID: &authorID},
+			canMerge:    true,
+			canReassign: true,
+		},
+		{
+			name:        "назначенный ревьюер",
+			actor:       &actor.Actor{Role: model.RoleMember, UserID: &reviewerID},
+			canMerge:    false,
+			canReassign: true,
+		},
+		{
+			name:        "посторонний пользователь",
+			actor:       &actor.Actor{Role: model.RoleMember, UserID: &outsiderID},
+			canMerge:    false,
+			canReassign: false,
+		},
+		{
+			name:        "админ",
+			actor:       &actor.Actor{Role: model.RoleAdmin, UserID: &outsiderID},
+			canMerge:    true,
+			canReassign: true,
+		},
+		{
+			name:        "сервисный API ключ member",
+			actor:       &actor.Actor{Role: model.RoleMember, KeyID: &keyID},
+			canMerge:    true,
+			canReassign: true,
+		},
+		{
+			name:        "API ключ, привязанный к ревьюеру",
+			actor:       &actor.Actor{Role: model.RoleMember, KeyID: &keyID, UserID: &reviewerID},
+			canMerge:    false,
+			canReassign: true,
+		},
+		{
+			name:        "API ключ admin без пользователя",
+			actor:       &actor.Actor{Role: model.RoleAdmin, KeyID: &keyID},
+			canMerge:    true,
+			canReassign: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = actor.WithContext(ctx, tt.actor)
+			}
+
+			assert.Equal(t, tt.canMerge, canMerge(ctx, pr))
+			assert.Equal(t, tt.canReassign, canReassign(ctx, pr))
+		})
+	}
+}
+
+func TestMerge_Audit(t *testing.T) {
+	openPR := &model.PullRequest{ID: uuid.New(), AuthorID: uuid.New(), Status: "OPEN"}
+	mergedPR := &model.PullRequest{ID: openPR.ID, AuthorID: openPR.AuthorID, Status: "MERGED"}
+
+	tests := []struct {
+		name        string
+		before      *model.PullRequest
+		expectEvent bool
+	}{
+		{
+			name:        "merge открытого PR пишет событие",
+			before:      openPR,
+			expectEvent: true,
+		},
+		{
+			name:        "повторный merge не пишет событие",
+			before:      mergedPR,
+			expectEvent: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			prRepo.On("GetByID", mock.Anything, openPR.ID).Return(tt.before, nil)
+			prRepo.On("Merge", mock.Anything, openPR.ID).Return(mergedPR, nil)
+			if tt.expectEvent {
+				auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditPRMerge &&
+						e.EntityType == model.AuditEntityPR &&
+						e.EntityID == openPR.ID.String() &&
+						len(e.Before) > 0 && len(e.After) > 0
+				})).Return(nil)
+			}
+
+			bus := events.NewBus(0)
+			sub, _, _ := bus.Subscribe(&model.EventFilter{UserID: &openPR.AuthorID}, "")
+			defer sub.Close()
+
+			svc := NewService(prRepo, mocks.NewMockUserRepository(t), mocks.NewMockCalendarRepository(t), auditRepo, txMgr, bus)
+
+			_, err := svc.Merge(context.Background(), openPR.ID)
+			assert.NoError(t, err)
+
+			// в поток событий merge попадает вместе с аудитом
+			if tt.expectEvent && assert.Len(t, sub.C, 1) {
+				e := <-sub.C
+				assert.Equal(t, model.EventPRMerged, e.Type)
+				assert.Equal(t, mergedPR, e.Data.(*model.PREventData).PullRequest)
+			} else {
+				assert.Empty(t, sub.C)
+			}
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	authorID, reviewerID, newID := uuid.New(), uuid.New(), uuid.New()
+	pr := &model.PullRequest{ID: uuid.New(), AuthorID: authorID, AssignedReviewers: []uuid.UUID{newID}}
+
+	e := newEvent(model.EventReviewerReassigned, pr, "", &newID, &reviewerID)
+
+	// снятый ревьюер тоже видит событие в своем потоке
+	assert.Equal(t, []uuid.UUID{authorID, newID, reviewerID}, e.UserIDs)
+	data := e.Data.(*model.PREventData)
+	assert.Equal(t, &newID, data.ReviewerID)
+	assert.Equal(t, &reviewerID, data.ReplacedID)
+}
+
+func TestReplaceReason(t *testing.T) {
+	assert.Equal(t, model.AssignmentReassign, replaceReason(&model.User{IsActive: true}))
+	assert.Equal(t, model.AssignmentDeactivation, replaceReason(&model.User{IsActive: false}))
+}
+
+func TestNewAssignments(t *testing.T) {
+	userID := uuid.New()
+	prID := uuid.New()
+	reviewers := []uuid.UUID{uuid.New(), uuid.New()}
+
+	ctx := actor.WithContext(context.Background(), &actor.Actor{Name: "alice", Role: model.RoleMember, UserID: &userID})
+	list := newAssignments(ctx, prID, reviewers, model.AssignmentInitial)
+
+	assert.Len(t, list, 2)
+	for i, a := range list {
+		assert.Equal(t, prID, a.PRID)
+		assert.Equal(t, reviewers[i], a.ReviewerID)
+		assert.Equal(t, model.AssignmentInitial, a.Reason)
+		assert.Equal(t, "alice", a.ActorName)
+		assert.Equal(t, &userID, a.ActorUserID)
+		assert.Nil(t, a.UnassignedAt)
+	}
+
+	list = newAssignments(context.Background(), prID, reviewers[:1], model.AssignmentReassign)
+	assert.Empty(t, list[0].ActorName)
+	assert.Nil(t, list[0].ActorUserID)
+}
+
+func TestReassignReviewers_ExplicitTarget(t *testing.T) {
+	authorID := uuid.New()
+	oldReviewerID := uuid.New()
+	otherReviewerID := uuid.New()
+	targetID := uuid.New()
+
+	pr := &model.PullRequest{
+		ID:                uuid.New(),
+		Status:            "OPEN",
+		AuthorID:          authorID,
+		AssignedReviewers: []uuid.UUID{oldReviewerID, otherReviewerID},
+	}
+	oldReviewer := &model.User{ID: oldReviewerID, TeamName: "team", IsActive: true}
+
+	tests := []struct {
+		name          string
+		target        *model.User
+		expectedError error
+	}{
+		{
+			name:   "явно выбранный ревьюер из другой команды",
+			target: &model.User{ID: targetID, TeamName: "other", IsActive: true},
+		},
+		{
+			name:          "неактивный ревьюер",
+			target:        &model.User{ID: targetID, IsActive: false},
+			expectedError: ErrNotActive,
+		},
+		{
+			name:          "автор PR",
+			target:        &model.User{ID: authorID, IsActive: true},
+			expectedError: ErrAuthorReviewer,
+		},
+		{
+			name:          "уже назначен",
+			target:        &model.User{ID: otherReviewerID, IsActive: true},
+			expectedError: ErrAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil).Once()
+			userRepo.On("GetByID", mock.Anything, oldReviewerID).Return(oldReviewer, nil)
+			userRepo.On("GetByID", mock.Anything, tt.target.ID).Return(tt.target, nil)
+
+			if tt.expectedError == nil {
+				prRepo.On("ReassignReviewers", mock.Anything, pr.ID, oldReviewerID, targetID).Return(nil)
+				prRepo.On("CloseAssignment", mock.Anything, pr.ID, oldReviewerID).Return(nil)
+				prRepo.On("CreateAssignments", mock.Anything, mock.MatchedBy(func(list []*model.ReviewerAssignment) bool {
+					return len(list) == 1 && list[0].ReviewerID == targetID && list[0].Reason == model.AssignmentReassign
+				})).Return(nil)
+				prRepo.On("GetByID", mock.Anything, pr.ID).Return(&model.PullRequest{
+					ID:                pr.ID,
+					Status:            "OPEN",
+					AuthorID:          authorID,
+					AssignedReviewers: []uuid.UUID{otherReviewerID, targetID},
+				}, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil)
+			}
+
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
+
+			target := tt.target.ID
+			result, replaceBy, err := svc.ReassignReviewers(context.Background(), oldReviewerID, pr.ID, &target)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, targetID, replaceBy)
+			assert.Contains(t, result.AssignedReviewers, targetID)
+		})
+	}
+}
+
+func TestAddReviewer(t *testing.T) {
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	newReviewerID := uuid.New()
+
+	tests := []struct {
+		name          string
+		pr            *model.PullRequest
+		setupMocks    func(*mocks.MockPullRequestRepository, *mocks.MockUserRepository, *mocks.MockAuditRepository, *model.PullRequest)
+		expectedError error
+	}{
+		{
+			name: "успешное добавление ревьюера",
+			pr: &model.PullRequest{
+				ID:                uuid.New(),
+				Status:            "OPEN",
+				AuthorID:          authorID,
+				AssignedReviewers: []uuid.UUID{reviewerID},
+			},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, pr *model.PullRequest) {
+				userRepo.On("GetByID", mock.Anything, newReviewerID).Return(&model.User{ID: newReviewerID, IsActive: true}, nil)
+				prRepo.On("AddReviewer", mock.Anything, pr.ID, newReviewerID).Return(nil)
+				prRepo.On("CreateAssignments", mock.Anything, mock.MatchedBy(func(list []*model.ReviewerAssignment) bool {
+					return len(list) == 1 && list[0].ReviewerID == newReviewerID && list[0].Reason == model.AssignmentManual
+				})).Return(nil)
+				prRepo.On("GetByID", mock.Anything, pr.ID).Return(&model.PullRequest{
+					ID:                pr.ID,
+					Status:            "OPEN",
+					AuthorID:          authorID,
+					AssignedReviewers: []uuid.UUID{reviewerID, newReviewerID},
+				}, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditPRAddReviewer
+				})).Return(nil)
+			},
+		},
+		{
+			name: "уже назначено максимальное число ревьюеров",
+			pr: &model.PullRequest{
+				ID:                uuid.New(),
+				Status:            "OPEN",
+				AuthorID:          authorID,
+				AssignedReviewers: []uuid.UUID{reviewerID, uuid.New()},
+			},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, pr *model.PullRequest) {
+				userRepo.On("GetByID", mock.Anything, newReviewerID).Return(&model.User{ID: newReviewerID, IsActive: true}, nil)
+			},
+			expectedError: ErrReviewersLimit,
+		},
+		{
+			name: "PR уже смержен",
+			pr: &model.PullRequest{
+				ID:       uuid.New(),
+				Status:   "MERGED",
+				AuthorID: authorID,
+			},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, pr *model.PullRequest) {
+			},
+			expectedError: ErrPRMerged,
+		},
+		{
+			name: "ревьюер не найден",
+			pr: &model.PullRequest{
+				ID:       uuid.New(),
+				Status:   "OPEN",
+				AuthorID: authorID,
+			},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, pr *model.PullRequest) {
+				userRepo.On("GetByID", mock.Anything, newReviewerID).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			prRepo.On("GetByID", mock.Anything, tt.pr.ID).Return(tt.pr, nil).Once()
+			tt.setupMocks(prRepo, userRepo, auditRepo, tt.pr)
+
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
+
+			result, err := svc.AddReviewer(context.Background(), tt.pr.ID, newReviewerID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, result.AssignedReviewers, newReviewerID)
+		})
+	}
+}
+
+func TestRemoveReviewer(t *testing.T) {
+	reviewerID := uuid.New()
+	pr := &model.PullRequest{
+		ID:                uuid.New(),
+		Status:            "OPEN",
+		AuthorID:          uuid.New(),
+		AssignedReviewers: []uuid.UUID{reviewerID},
+	}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*mocks.MockPullRequestRepository, *mocks.MockAuditRepository)
+		expectedError error
+	}{
+		{
+			name: "успешное снятие ревьюера",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, auditRepo *mocks.MockAuditRepository) {
+				prRepo.On("RemoveReviewer", mock.Anything, pr.ID, reviewerID).Return(nil)
+				prRepo.On("CloseAssignment", mock.Anything, pr.ID, reviewerID).Return(nil)
+				prRepo.On("GetByID", mock.Anything, pr.ID).Return(&model.PullRequest{
+					ID:                pr.ID,
+					Status:            "OPEN",
+					AuthorID:          pr.AuthorID,
+					AssignedReviewers: []uuid.UUID{},
+				}, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditPRRemoveReviewer
+				})).Return(nil)
+			},
+		},
+		{
+			name: "ревьюер не назначен",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, auditRepo *mocks.MockAuditRepository) {
+				prRepo.On("RemoveReviewer", mock.Anything, pr.ID, reviewerID).Return(pgx.ErrNoRows)
+			},
+			expectedError: ErrNoAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil).Once()
+			tt.setupMocks(prRepo, auditRepo)
+
+			svc := NewService(prRepo, userRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0))
+
+			result, err := svc.RemoveReviewer(context.Background(), pr.ID, reviewerID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, result.AssignedReviewers)
+		})
+	}
+}
+
+func TestReview(t *testing.T) {
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	openPR := &model.PullRequest{
+		ID:                uuid.New(),
+		AuthorID:          authorID,
+		Status:            "OPEN",
+		AssignedReviewers: []uuid.UUID{reviewerID},
+	}
+	mergedPR := &model.PullRequest{ID: openPR.ID, AuthorID: authorID, Status: "MERGED"}
+	review := &model.PRReview{PRID: openPR.ID, ReviewerID: reviewerID, ReviewedAt: time.Now()}
+
+	tests := []struct {
+		name          string
+		actor         *actor.Actor
+		setupMocks    func(*mocks.MockPullRequestRepository)
+		expectedError error
+	}{
+		{
+			name:  "ревьюер отмечает ревью",
+			actor: &actor.Actor{Role: model.RoleMember, UserID: &reviewerID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("MarkReviewed", mock.Anything, openPR.ID, reviewerID, mock.AnythingOfType("time.Time")).Return(review, nil)
+			},
+		},
+		{
+			name:  "автор не может отметить ревью за ревьюера",
+			actor: &actor.Actor{Role: model.RoleMember, UserID: &authorID},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+			},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:  "сервисный API ключ member отмечает ревью",
+			actor: &actor.Actor{Name: "ci", Role: model.RoleMember},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("MarkReviewed", mock.Anything, openPR.ID, reviewerID, mock.AnythingOfType("time.Time")).Return(review, nil)
+			},
+		},
+		{
+			name: "PR не найден",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+		{
+			name: "PR уже смержен",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(mergedPR, nil)
+			},
+			expectedError: ErrPRMerged,
+		},
+		{
+			name: "пользователь не назначен ревьюером",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository) {
+				prRepo.On("GetByID", mock.Anything, openPR.ID).Return(openPR, nil)
+				prRepo.On("MarkReviewed", mock.Anything, openPR.ID, reviewerID, mock.AnythingOfType("time.Time")).Return(nil, pgx.ErrNoRows)
+			},
+			expectedError: ErrNoAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+			txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			tt.setupMocks(prRepo)
+
+			bus := events.NewBus(10)
+			svc := NewService(prRepo, mocks.NewMockUserRepository(t), mocks.NewMockCalendarRepository(t), auditRepo, txMgr, bus)
+
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = actor.WithContext(ctx, tt.actor)
+			}
+			pr, got, err := svc.Review(ctx, openPR.ID, reviewerID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, openPR, pr)
+			assert.Equal(t, review, got)
+
+			_, replay, _ := bus.Subscribe(&model.EventFilter{}, bus.Epoch()+"-0")
+			require.Len(t, replay, 1)
+			assert.Equal(t, model.EventPRReviewed, replay[0].Type)
+		})
+	}
+}
+
+func TestReassignStale(t *testing.T) {
+	authorID := uuid.New()
+	staleID := uuid.New()
+	otherID := uuid.New()
+	freeID := uuid.New()
+	// среда; назначение во вторник в 17:00 дает 4 рабочих часа по графику по умолчанию
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	assignedAt := time.Date(2026, 3, 3, 17, 0, 0, 0, time.UTC)
+
+	pr := &model.PullRequest{ID: uuid.New(), Status: "OPEN", AuthorID: authorID, AssignedReviewers: []uuid.UUID{staleID, otherID}}
+	busyPR := uuid.New()
+	stale := &model.StaleReview{PRID: pr.ID, ReviewerID: staleID, TeamName: "team", AssignedAt: assignedAt, AutoReassignHours: 4}
+	busy := &model.StaleReview{PRID: busyPR, ReviewerID: staleID, TeamName: "team", AssignedAt: assignedAt, AutoReassignHours: 4}
+	user := &model.User{ID: staleID, TeamName: "team", IsActive: true}
+
+	tests := []struct {
+		name       string
+		holidays   []*model.Holiday
+		setupMocks func(*mocks.MockPullRequestRepository, *mocks.MockUserRepository)
+		expected   int
+	}{
+		{
+			name: "заменяет просроченного ревьюера и пропускает занятый PR",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository) {
+				prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 10).Return([]*model.StaleReview{stale, busy}, nil)
+				prRepo.On("TryLockPR", mock.Anything, busyPR).Return(false, nil)
+				prRepo.On("TryLockPR", mock.Anything, pr.ID).Return(true, nil)
+				prRepo.On("GetStaleReview", mock.Anything, pr.ID, staleID, now).Return(stale, nil)
+				prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil)
+				userRepo.On("GetByID", mock.Anything, staleID).Return(user, nil)
+				userRepo.On("GetActiveByTeam", mock.Anything, "team").
+					Return([]*model.User{{ID: authorID}, {ID: staleID}, {ID: otherID}, {ID: freeID}}, nil)
+				prRepo.On("ReassignReviewers", mock.Anything, pr.ID, staleID, freeID).Return(nil)
+				prRepo.On("CloseAssignment", mock.Anything, pr.ID, staleID).Return(nil)
+				prRepo.On("CreateAssignments", mock.Anything, mock.MatchedBy(func(list []*model.ReviewerAssignment) bool {
+					return len(list) == 1 && list[0].ReviewerID == freeID && list[0].Reason == model.AssignmentTimeout
+				})).Return(nil)
+			},
+			expected: 1,
+		},
+		{
+			name: "ревью оставили до блокировки",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository) {
+				prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 10).Return([]*model.StaleReview{stale}, nil)
+				prRepo.On("TryLockPR", mock.Anything, pr.ID).Return(true, nil)
+				prRepo.On("GetStaleReview", mock.Anything, pr.ID, staleID, now).Return(nil, pgx.ErrNoRows)
+			},
+			expected: 0,
+		},
+		{
+			name: "нет кандидатов",
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository) {
+				prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 10).Return([]*model.StaleReview{stale}, nil)
+				prRepo.On("TryLockPR", mock.Anything, pr.ID).Return(true, nil)
+				prRepo.On("GetStaleReview", mock.Anything, pr.ID, staleID, now).Return(stale, nil)
+				prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil)
+				userRepo.On("GetByID", mock.Anything, staleID).Return(user, nil)
+				userRepo.On("GetActiveByTeam", mock.Anything, "team").
+					Return([]*model.User{{ID: authorID}, {ID: staleID}, {ID: otherID}}, nil)
+			},
+			expected: 0,
+		},
+		{
+			name:     "праздник не входит в срок",
+			holidays: []*model.Holiday{{Date: "2026-03-04", Name: "day off"}},
+			setupMocks: func(prRepo *mocks.MockPullRequestRepository, userRepo *mocks.MockUserRepository) {
+				prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 10).Return([]*model.StaleReview{stale}, nil)
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := mocks.NewMockPullRequestRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+				Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) }).Maybe()
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+			calendarRepo := mocks.NewMockCalendarRepository(t)
+			calendarRepo.On("GetHolidays", mock.Anything, mock.Anything).Return(tt.holidays, nil)
+			calendarRepo.On("GetSchedules", mock.Anything, mock.Anything).Return([]*model.UserSchedule{}, nil)
+			tt.setupMocks(prRepo, userRepo)
+
+			svc := NewService(prRepo, userRepo, calendarRepo, auditRepo, txMgr, events.NewBus(0))
+			n, err := svc.ReassignStale(context.Background(), now, 10)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, n)
+		})
+	}
+}
+
+func TestReassignStale_SkipsPRsWithoutCandidates(t *testing.T) {
+	authorID := uuid.New()
+	staleID := uuid.New()
+	freeID := uuid.New()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	assignedAt := time.Date(2026, 3, 3, 17, 0, 0, 0, time.UTC)
+	user := &model.User{ID: staleID, TeamName: "team", IsActive: true}
+
+	// первые limit назначений стоят в начале очереди, но заменить их некем
+	first := &model.PullRequest{ID: uuid.New(), Status: "OPEN", AuthorID: authorID, AssignedReviewers: []uuid.UUID{staleID}}
+	second := &model.PullRequest{ID: uuid.New(), Status: "OPEN", AuthorID: authorID, AssignedReviewers: []uuid.UUID{staleID}}
+	pr := &model.PullRequest{ID: uuid.New(), Status: "OPEN", AuthorID: authorID, AssignedReviewers: []uuid.UUID{staleID}}
+	newStale := func(prID uuid.UUID, at time.Time) *model.StaleReview {
+		return &model.StaleReview{PRID: prID, ReviewerID: staleID, TeamName: "team", AssignedAt: at, AutoReassignHours: 4}
+	}
+	staleFirst := newStale(first.ID, assignedAt.Add(-2*time.Minute))
+	staleSecond := newStale(second.ID, assignedAt.Add(-time.Minute))
+	stale := newStale(pr.ID, assignedAt)
+
+	prRepo := mocks.NewMockPullRequestRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	auditRepo := mocks.NewMockAuditRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	calendarRepo := mocks.NewMockCalendarRepository(t)
+
+	txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+	calendarRepo.On("GetHolidays", mock.Anything, mock.Anything).Return([]*model.Holiday{}, nil)
+	calendarRepo.On("GetSchedules", mock.Anything, mock.Anything).Return([]*model.UserSchedule{}, nil)
+
+	prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 2).
+		Return([]*model.StaleReview{staleFirst, staleSecond}, nil)
+	prRepo.On("GetStaleReviews", mock.Anything, now, staleSecond, 2).
+		Return([]*model.StaleReview{stale}, nil)
+	for _, p := range []*model.PullRequest{first, second, pr} {
+		s := newStale(p.ID, assignedAt)
+		prRepo.On("TryLockPR", mock.Anything, p.ID).Return(true, nil)
+		prRepo.On("GetStaleReview", mock.Anything, p.ID, staleID, now).Return(s, nil)
+		prRepo.On("GetByID", mock.Anything, p.ID).Return(p, nil)
+	}
+	userRepo.On("GetByID", mock.Anything, staleID).Return(user, nil)
+	userRepo.On("GetActiveByTeam", mock.Anything, "team").
+		Return([]*model.User{{ID: authorID}, {ID: staleID}}, nil).Twice()
+	userRepo.On("GetActiveByTeam", mock.Anything, "team").
+		Return([]*model.User{{ID: authorID}, {ID: staleID}, {ID: freeID}}, nil).Once()
+	prRepo.On("ReassignReviewers", mock.Anything, pr.ID, staleID, freeID).Return(nil)
+	prRepo.On("CloseAssignment", mock.Anything, pr.ID, staleID).Return(nil)
+	prRepo.On("CreateAssignments", mock.Anything, mock.Anything).Return(nil)
+
+	svc := NewService(prRepo, userRepo, calendarRepo, auditRepo, txMgr, events.NewBus(0))
+	n, err := svc.ReassignStale(context.Background(), now, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestReassignStale_SkipsNotStaleByCalendar(t *testing.T) {
+	authorID := uuid.New()
+	staleID := uuid.New()
+	freeID := uuid.New()
+	// среда; с вторника 17:00 прошло 4 рабочих часа
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	assignedAt := time.Date(2026, 3, 3, 17, 0, 0, 0, time.UTC)
+	user := &model.User{ID: staleID, TeamName: "team", IsActive: true}
+	pr := &model.PullRequest{ID: uuid.New(), Status: "OPEN", AuthorID: authorID, AssignedReviewers: []uuid.UUID{staleID}}
+
+	// по часам просрочены все, но у первой страницы по календарю срок не вышел
+	notStale := []*model.StaleReview{
+		{PRID: uuid.New(), ReviewerID: staleID, TeamName: "team", AssignedAt: assignedAt.Add(-2 * time.Minute), AutoReassignHours: 6},
+		{PRID: uuid.New(), ReviewerID: staleID, TeamName: "team", AssignedAt: assignedAt.Add(-time.Minute), AutoReassignHours: 6},
+	}
+	stale := &model.StaleReview{PRID: pr.ID, ReviewerID: staleID, TeamName: "team", AssignedAt: assignedAt, AutoReassignHours: 4}
+
+	prRepo := mocks.NewMockPullRequestRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	auditRepo := mocks.NewMockAuditRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	calendarRepo := mocks.NewMockCalendarRepository(t)
+
+	txMgr.On("Serializable", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+	calendarRepo.On("GetHolidays", mock.Anything, mock.Anything).Return([]*model.Holiday{}, nil)
+	calendarRepo.On("GetSchedules", mock.Anything, mock.Anything).Return([]*model.UserSchedule{}, nil)
+
+	prRepo.On("GetStaleReviews", mock.Anything, now, (*model.StaleReview)(nil), 2).Return(notStale, nil)
+	prRepo.On("GetStaleReviews", mock.Anything, now, notStale[1], 2).Return([]*model.StaleReview{stale}, nil)
+	prRepo.On("TryLockPR", mock.Anything, pr.ID).Return(true, nil)
+	prRepo.On("GetStaleReview", mock.Anything, pr.ID, staleID, now).Return(stale, nil)
+	prRepo.On("GetByID", mock.Anything, pr.ID).Return(pr, nil)
+	userRepo.On("GetByID", mock.Anything, staleID).Return(user, nil)
+	userRepo.On("GetActiveByTeam", mock.Anything, "team").
+		Return([]*model.User{{ID: authorID}, {ID: staleID}, {ID: freeID}}, nil)
+	prRepo.On("ReassignReviewers", mock.Anything, pr.ID, staleID, freeID).Return(nil)
+	prRepo.On("CloseAssignment", mock.Anything, pr.ID, staleID).Return(nil)
+	prRepo.On("CreateAssignments", mock.Anything, mock.Anything).Return(nil)
+
+	svc := NewService(prRepo, userRepo, calendarRepo, auditRepo, txMgr, events.NewBus(0))
+	n, err := svc.ReassignStale(context.Background(), now, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
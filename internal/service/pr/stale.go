@@ -0,0 +1,140 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+	"PR/internal/service/calendar"
+)
+
+// ReassignStale заменяет ревьюеров, не оставивших ревью за auto_reassign_hours
+// своей команды, и возвращает число замен (не больше limit). Часы считаются по
+// рабочему календарю ревьюера. Назначения читаются страницами по limit, пока не
+// наберется limit замен: PR без кандидатов и назначения, не просроченные по
+// календарю, не занимают место в проходе. Каждый PR обрабатывается в отдельной
+// транзакции под advisory блокировкой: PR, занятый другой репликой, пропускается
+// до следующего прохода, а после блокировки назначение перепроверяется
+func (s *serv) ReassignStale(ctx context.Context, now time.Time, limit int) (int, error) {
+	n := 0
+	var after *model.StaleReview
+	for n < limit {
+		page, err := s.pullRequestRepo.GetStaleReviews(ctx, now, after, limit)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Msgf("%s.ReassignStale error: %v", op, err)
+			return n, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		after = page[len(page)-1]
+
+		list, err := s.staleByCalendar(ctx, page, now)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Msgf("%s.ReassignStale error: %v", op, err)
+			return n, err
+		}
+
+		for _, stale := range list {
+			if n == limit {
+				break
+			}
+			done, err := s.reassignStale(ctx, stale, now)
+			if err != nil {
+				// PR без свободных кандидатов не мешает обработать остальные
+				if errors.Is(err, ErrNoCandidate) {
+					zerolog.Ctx(ctx).Warn().Msgf("%s.ReassignStale PR %s: no candidate to replace %s", op, stale.PRID, stale.ReviewerID)
+					continue
+				}
+				zerolog.Ctx(ctx).Error().Msgf("%s.ReassignStale PR %s error: %v", op, stale.PRID, err)
+				continue
+			}
+			if done {
+				n++
+			}
+		}
+
+		if len(page) < limit {
+			break
+		}
+	}
+	return n, nil
+}
+
+func (s *serv) reassignStale(ctx context.Context, stale *model.StaleReview, now time.Time) (bool, error) {
+	var done bool
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		done = false
+
+		locked, errTx := s.pullRequestRepo.TryLockPR(ctx, stale.PRID)
+		if errTx != nil || !locked {
+			return errTx
+		}
+
+		// пока PR не был заблокирован, ревью могли оставить или заменить ревьюера
+		fresh, errTx := s.pullRequestRepo.GetStaleReview(ctx, stale.PRID, stale.ReviewerID, now)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return nil
+			}
+			return errTx
+		}
+		// а команда — поменять политику или рабочие часы
+		stillStale, errTx := s.staleByCalendar(ctx, []*model.StaleReview{fresh}, now)
+		if errTx != nil || len(stillStale) == 0 {
+			return errTx
+		}
+
+		pr, errTx := s.pullRequestRepo.GetByID(ctx, stale.PRID)
+		if errTx != nil {
+			return errTx
+		}
+		user, errTx := s.userRepo.GetByID(ctx, stale.ReviewerID)
+		if errTx != nil {
+			return errTx
+		}
+
+		_, _, errTx = s.replaceReviewer(ctx, pr, user, nil, model.AssignmentTimeout)
+		if errTx != nil {
+			return errTx
+		}
+		done = true
+		return nil
+	})
+	return done, err
+}
+
+// staleByCalendar оставляет назначения, по которым ревью нет auto_reassign_hours
+// рабочих часов ревьюера. Репозиторий отбирает их по часам, а рабочих часов
+// проходит не больше
+func (s *serv) staleByCalendar(ctx context.Context, list []*model.StaleReview, now time.Time) ([]*model.StaleReview, error) {
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(list))
+	from := now
+	for _, r := range list {
+		ids = append(ids, r.ReviewerID)
+		if r.AssignedAt.Before(from) {
+			from = r.AssignedAt
+		}
+	}
+	cals, err := calendar.Load(ctx, s.calendarRepo, ids, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := make([]*model.StaleReview, 0, len(list))
+	for _, r := range list {
+		if cals.Get(r.ReviewerID).Between(r.AssignedAt, now) >= r.AutoReassign() {
+			stale = append(stale, r)
+		}
+	}
+	return stale, nil
+}
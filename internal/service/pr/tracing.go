@@ -0,0 +1,96 @@
+package pr
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"PR/internal/model"
+	"PR/internal/service"
+	"PR/internal/tracing"
+)
+
+// tracedServ оборачивает каждый метод сервиса в спан "PullRequestService.<Метод>"
+type tracedServ struct {
+	next   service.PullRequestService
+	tracer trace.Tracer
+}
+
+func WithTracing(next service.PullRequestService) service.PullRequestService {
+	return &tracedServ{next: next, tracer: otel.Tracer("PR/internal/service/pr")}
+}
+
+func (s *tracedServ) Create(ctx context.Context, p *model.PullRequestShort) (*model.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.Create",
+		trace.WithAttributes(attribute.String("pr.id", p.ID.String())))
+	res, err := s.next.Create(ctx, p)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.Merge",
+		trace.WithAttributes(attribute.String("pr.id", id.String())))
+	res, err := s.next.Merge(ctx, id)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID, newID *uuid.UUID) (*model.PullRequest, uuid.UUID, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.ReassignReviewers",
+		trace.WithAttributes(attribute.String("pr.id", prID.String())))
+	res, replacedBy, err := s.next.ReassignReviewers(ctx, oldID, prID, newID)
+	tracing.End(span, err)
+	return res, replacedBy, err
+}
+
+func (s *tracedServ) AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.AddReviewer",
+		trace.WithAttributes(attribute.String("pr.id", prID.String())))
+	res, err := s.next.AddReviewer(ctx, prID, reviewerID)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.RemoveReviewer",
+		trace.WithAttributes(attribute.String("pr.id", prID.String())))
+	res, err := s.next.RemoveReviewer(ctx, prID, reviewerID)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) Review(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, *model.PRReview, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.Review",
+		trace.WithAttributes(attribute.String("pr.id", prID.String())))
+	pr, review, err := s.next.Review(ctx, prID, reviewerID)
+	tracing.End(span, err)
+	return pr, review, err
+}
+
+func (s *tracedServ) ReassignStale(ctx context.Context, now time.Time, limit int) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.ReassignStale")
+	n, err := s.next.ReassignStale(ctx, now, limit)
+	span.SetAttributes(attribute.Int("pr.reassigned", n))
+	tracing.End(span, err)
+	return n, err
+}
+
+func (s *tracedServ) GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*model.PullRequestShort, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.GetByReviewer")
+	res, err := s.next.GetByReviewer(ctx, userID)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.GetHistory",
+		trace.WithAttributes(attribute.String("pr.id", prID.String())))
+	res, err := s.next.GetHistory(ctx, prID)
+	tracing.End(span, err)
+	return res, err
+}
@@ -0,0 +1,55 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func TestWithTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	next := mocks.NewMockPullRequestService(t)
+	s := WithTracing(next)
+
+	// вложенные вызовы должны получать контекст со спаном сервиса
+	withSpan := mock.MatchedBy(func(ctx context.Context) bool {
+		return trace.SpanFromContext(ctx).SpanContext().IsValid()
+	})
+
+	prID := uuid.New()
+	next.On("Merge", withSpan, prID).Return(&model.PullRequest{ID: prID}, nil).Once()
+	next.On("Merge", withSpan, prID).Return(nil, ErrNotFound).Once()
+
+	res, err := s.Merge(context.Background(), prID)
+	require.NoError(t, err)
+	assert.Equal(t, prID, res.ID)
+
+	_, err = s.Merge(context.Background(), prID)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for _, span := range spans {
+		assert.Equal(t, "PullRequestService.Merge", span.Name())
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Len(t, spans[1].Events(), 1)
+}
@@ -8,16 +8,39 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
+	"PR/internal/service/audit"
 )
 
 func (s *serv) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error) {
+	var pr *model.PullRequest
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		before, errTx := s.pullRequestRepo.GetByID(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		if !canMerge(ctx, before) {
+			return ErrForbidden
+		}
 
-	pr, err := s.pullRequestRepo.Merge(ctx, id)
+		pr, errTx = s.pullRequestRepo.Merge(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		// повторный merge идемпотентен и не меняет состояние, событие не пишем
+		if before.Status == pr.Status {
+			return nil
+		}
+		if errTx = s.publisher.Publish(ctx, newEvent(model.EventPRMerged, pr, "", nil, nil)); errTx != nil {
+			return errTx
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditPRMerge, model.AuditEntityPR, pr.ID.String(), before, pr)
+	})
 	if err != nil {
-		log.Error().Msgf("%s.Merge error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.Merge error: %v", op, err)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -27,10 +50,10 @@ func (s *serv) Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, err
 	return pr, nil
 }
 
-func (s *serv) ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID) (*model.PullRequest, uuid.UUID, error) {
+func (s *serv) ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID, newID *uuid.UUID) (*model.PullRequest, uuid.UUID, error) {
 	var pr *model.PullRequest
 	var replaceBy uuid.UUID
-	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+	err := s.txManager.Serializable(ctx, func(ctx context.Context) error {
 		var errTx error
 		pr, errTx = s.pullRequestRepo.GetByID(ctx, prID)
 		if errTx != nil {
@@ -40,6 +63,10 @@ func (s *serv) ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID) (*m
 			return errTx
 		}
 
+		if !canReassign(ctx, pr) {
+			return ErrForbidden
+		}
+
 		if pr.Status == "MERGED" {
 			return ErrPRMerged
 		}
@@ -52,39 +79,77 @@ func (s *serv) ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID) (*m
 			return errTx
 		}
 
-		members, errTx := s.userRepo.GetActiveByTeam(ctx, user.TeamName)
-		if errTx != nil {
-			if errors.Is(errTx, pgx.ErrNoRows) {
-				return ErrNotFound
+		pr, replaceBy, errTx = s.replaceReviewer(ctx, pr, user, newID, replaceReason(user))
+		return errTx
+
+	})
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.ReassignReviewers error: %v", op, err)
+		return nil, uuid.UUID{}, err
+	}
+	return pr, replaceBy, err
+}
+
+// replaceReviewer заменяет ревьюера old на newID, а без него — на случайного
+// активного участника команды old. Замена пишется в историю назначений с reason,
+// в поток событий и в аудит; вызывается внутри транзакции
+func (s *serv) replaceReviewer(ctx context.Context, pr *model.PullRequest, old *model.User, newID *uuid.UUID, reason string) (*model.PullRequest, uuid.UUID, error) {
+	var replaceBy uuid.UUID
+	if newID != nil {
+		err := s.checkReviewer(ctx, pr, *newID)
+		if err != nil {
+			return nil, uuid.UUID{}, err
+		}
+		replaceBy = *newID
+	} else {
+		members, err := s.userRepo.GetActiveByTeam(ctx, old.TeamName)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, uuid.UUID{}, ErrNotFound
 			}
-			return errTx
+			return nil, uuid.UUID{}, err
 		}
 
-		replaceBy, errTx = selectNewReviewer(members, pr.AuthorID, pr.AssignedReviewers)
-		if errTx != nil {
-			return errTx
+		replaceBy, err = selectNewReviewer(members, pr.AuthorID, pr.AssignedReviewers)
+		if err != nil {
+			return nil, uuid.UUID{}, err
 		}
+	}
 
-		errTx = s.pullRequestRepo.ReassignReviewers(ctx, prID, oldID, replaceBy)
-		if errTx != nil {
-			if errors.Is(errTx, pgx.ErrNoRows) {
-				return ErrNoAssigned
-			}
-			return errTx
-		}
-		pr, errTx = s.pullRequestRepo.GetByID(ctx, pr.ID)
-		if errTx != nil {
-			return errTx
+	err := s.pullRequestRepo.ReassignReviewers(ctx, pr.ID, old.ID, replaceBy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, uuid.UUID{}, ErrNoAssigned
 		}
-		return nil
+		return nil, uuid.UUID{}, err
+	}
 
-	})
+	// старое назначение закрывается, а не перезаписывается, чтобы оно осталось в истории
+	err = s.pullRequestRepo.CloseAssignment(ctx, pr.ID, old.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, uuid.UUID{}, err
+	}
 
+	err = s.pullRequestRepo.CreateAssignments(ctx, newAssignments(ctx, pr.ID, []uuid.UUID{replaceBy}, reason))
 	if err != nil {
-		log.Error().Msgf("%s.ReassignReviewers error: %v", op, err)
 		return nil, uuid.UUID{}, err
 	}
-	return pr, replaceBy, err
+	after, err := s.pullRequestRepo.GetByID(ctx, pr.ID)
+	if err != nil {
+		return nil, uuid.UUID{}, err
+	}
+
+	oldID := old.ID
+	err = s.publisher.Publish(ctx, newEvent(model.EventReviewerReassigned, after, "", &replaceBy, &oldID))
+	if err != nil {
+		return nil, uuid.UUID{}, err
+	}
+	err = audit.Record(ctx, s.auditRepo, model.AuditPRReassign, model.AuditEntityPR, after.ID.String(), pr, after)
+	if err != nil {
+		return nil, uuid.UUID{}, err
+	}
+	return after, replaceBy, nil
 }
 
 func selectNewReviewer(members []*model.User, authorID uuid.UUID, reviewers []uuid.UUID) (uuid.UUID, error) {
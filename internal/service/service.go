@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,13 +12,19 @@ import (
 type PullRequestService interface {
 	Create(ctx context.Context, p *model.PullRequestShort) (*model.PullRequest, error)
 	Merge(ctx context.Context, id uuid.UUID) (*model.PullRequest, error)
-	ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID) (*model.PullRequest, uuid.UUID, error)
+	ReassignReviewers(ctx context.Context, oldID, prID uuid.UUID, newID *uuid.UUID) (*model.PullRequest, uuid.UUID, error)
+	AddReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, error)
+	Review(ctx context.Context, prID, reviewerID uuid.UUID) (*model.PullRequest, *model.PRReview, error)
+	ReassignStale(ctx context.Context, now time.Time, limit int) (int, error)
 
 	GetByReviewer(ctx context.Context, userID uuid.UUID) ([]*model.PullRequestShort, error)
+	GetHistory(ctx context.Context, prID uuid.UUID) ([]*model.ReviewerAssignment, error)
 }
 
 type TeamService interface {
 	Create(ctx context.Context, t *model.Team) error
+	Import(ctx context.Context, roster []*model.RosterEntry, dryRun bool) (*model.ImportPlan, error)
 
 	GetTeamByName(ctx context.Context, name string) (*model.Team, error)
 }
@@ -27,6 +34,45 @@ type UserService interface {
 }
 
 type StatisticsService interface {
-	GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error)
-	GetPRStatistics(ctx context.Context) ([]*model.PRStats, error)
+	GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error)
+	GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error)
+
+	StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error
+	StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error
+}
+
+type SLAService interface {
+	SetTeamSettings(ctx context.Context, s *model.TeamSLA) (*model.TeamSLA, error)
+	SendReminders(ctx context.Context, now time.Time) (int, error)
+
+	GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error)
+	GetStats(ctx context.Context) ([]*model.SLAStats, error)
+}
+
+type CalendarService interface {
+	SetTeamHours(ctx context.Context, teamName string, wh *model.WorkingHours) (*model.TeamWorkingHours, error)
+	SetUserHours(ctx context.Context, userID uuid.UUID, wh *model.WorkingHours) (*model.UserSchedule, error)
+	AddHoliday(ctx context.Context, h *model.Holiday) (*model.Holiday, error)
+	RemoveHoliday(ctx context.Context, date, teamName string) error
+
+	GetTeamHours(ctx context.Context, teamName string) (*model.TeamWorkingHours, error)
+	GetUserSchedule(ctx context.Context, userID uuid.UUID) (*model.UserSchedule, error)
+	ListHolidays(ctx context.Context, f *model.HolidayFilter) ([]*model.Holiday, error)
+}
+
+type APIKeyService interface {
+	Create(ctx context.Context, name string, role model.Role, userID *uuid.UUID) (*model.APIKeyCreated, error)
+	Revoke(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+
+	List(ctx context.Context) ([]*model.APIKey, error)
+	Authenticate(ctx context.Context, key string) (*model.APIKey, error)
+}
+
+type AuditService interface {
+	List(ctx context.Context, f *model.AuditFilter) ([]*model.AuditEvent, error)
+}
+
+type ArchiveService interface {
+	Export(ctx context.Context) (*model.Archive, error)
+	Import(ctx context.Context, a *model.Archive) (*model.ArchiveCounts, error)
 }
@@ -0,0 +1,9 @@
+package sla
+
+import "errors"
+
+var (
+	ErrNotFound        = errors.New("sla settings not found")
+	ErrTeamNotFound    = errors.New("team not found")
+	ErrInvalidSettings = errors.New("invalid sla settings")
+)
@@ -0,0 +1,157 @@
+package sla
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/client/db"
+	"PR/internal/model"
+	"PR/internal/service/calendar"
+)
+
+// webhookWorkers — сколько вебхуков отправляется одновременно
+const webhookWorkers = 8
+
+// webhookPayload — тело запроса на вебхук команды
+type webhookPayload struct {
+	Type       string             `json:"type"`
+	OccurredAt time.Time          `json:"occurred_at"`
+	Reminder   *model.SLAReminder `json:"reminder"`
+}
+
+// SendReminders напоминает ревьюерам о назначениях, вышедших за SLA, и
+// возвращает число напоминаний. Срок и интервал повтора отсчитываются в
+// рабочих часах ревьюера; назначения, срок которых по календарю еще не истек,
+// не занимают место в пачке. Назначения отмечаются в той же транзакции,
+// в которой выбраны, поэтому каждое напоминание отправляется один раз даже
+// при нескольких репликах. Вебхуки вызываются после коммита; ошибка вебхука
+// только пишется в лог
+func (s *serv) SendReminders(ctx context.Context, now time.Time) (int, error) {
+	var reminders []*model.SLAReminder
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+		reminders, errTx = s.dueReminders(ctx, now)
+		if errTx != nil || len(reminders) == 0 {
+			return errTx
+		}
+
+		errTx = s.slaRepo.MarkReminded(ctx, reminders, now)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, r := range reminders {
+			if errTx = s.publisher.Publish(ctx, &model.Event{
+				Type:     model.EventReviewReminder,
+				TeamName: r.TeamName,
+				UserIDs:  []uuid.UUID{r.ReviewerID, r.AuthorID},
+				Data:     r,
+			}); errTx != nil {
+				return errTx
+			}
+		}
+		db.AfterCommit(ctx, func() { s.sendWebhooks(ctx, reminders, now) })
+		return nil
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.SendReminders error: %v", op, err)
+		return 0, err
+	}
+	return len(reminders), nil
+}
+
+// dueReminders читает назначения, просроченные по часам, страницами по batchSize,
+// пока не наберет batchSize просроченных по календарю или не дойдет до конца
+func (s *serv) dueReminders(ctx context.Context, now time.Time) ([]*model.SLAReminder, error) {
+	var res []*model.SLAReminder
+	var after *model.SLAReminder
+	for len(res) < s.batchSize {
+		page, err := s.slaRepo.GetDueReminders(ctx, now, after, s.batchSize)
+		if err != nil || len(page) == 0 {
+			return res, err
+		}
+		after = page[len(page)-1]
+
+		due, err := s.dueByCalendar(ctx, page, now)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, due...)
+
+		if len(page) < s.batchSize {
+			break
+		}
+	}
+	if len(res) > s.batchSize {
+		res = res[:s.batchSize]
+	}
+	return res, nil
+}
+
+// dueByCalendar оставляет назначения, срок которых истек по рабочему
+// календарю ревьюера, и выставляет им DueAt
+func (s *serv) dueByCalendar(ctx context.Context, list []*model.SLAReminder, now time.Time) ([]*model.SLAReminder, error) {
+	ids := make([]uuid.UUID, 0, len(list))
+	from := now
+	for _, r := range list {
+		ids = append(ids, r.ReviewerID)
+		if r.AssignedAt.Before(from) {
+			from = r.AssignedAt
+		}
+	}
+	cals, err := calendar.Load(ctx, s.calendarRepo, ids, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]*model.SLAReminder, 0, len(list))
+	for _, r := range list {
+		cal := cals.Get(r.ReviewerID)
+		if cal.Between(r.AssignedAt, now) < r.FirstReview() {
+			continue
+		}
+		if r.RemindedAt != nil {
+			interval := r.ReminderInterval()
+			if interval == 0 || cal.Between(*r.RemindedAt, now) < interval {
+				continue
+			}
+		}
+		r.DueAt = cal.Add(r.AssignedAt, r.FirstReview())
+		due = append(due, r)
+	}
+	return due, nil
+}
+
+// sendWebhooks отправляет вебхуки параллельно, не больше webhookWorkers
+// одновременно, и ждет все отправки: медленный адрес одной команды не задерживает
+// остальные, а отмена ctx при остановке прерывает незавершенные запросы
+func (s *serv) sendWebhooks(ctx context.Context, reminders []*model.SLAReminder, now time.Time) {
+	sem := make(chan struct{}, webhookWorkers)
+	var wg sync.WaitGroup
+	for _, r := range reminders {
+		if r.WebhookURL == "" {
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := s.webhooks.Send(ctx, r.WebhookURL, &webhookPayload{
+				Type:       model.EventReviewReminder,
+				OccurredAt: now,
+				Reminder:   r,
+			})
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Msgf("%s.SendReminders webhook for team %s error: %v", op, r.TeamName, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
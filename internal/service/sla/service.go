@@ -0,0 +1,48 @@
+package sla
+
+import (
+	"context"
+
+	"PR/internal/client/db"
+	"PR/internal/events"
+	"PR/internal/repository"
+	"PR/internal/service"
+)
+
+const op = "service.SLAService"
+
+// WebhookSender отправляет напоминание на адрес, указанный в настройках команды
+type WebhookSender interface {
+	Send(ctx context.Context, url string, payload any) error
+}
+
+type serv struct {
+	slaRepo      repository.SLARepository
+	calendarRepo repository.CalendarRepository
+	auditRepo    repository.AuditRepository
+	txManager    db.TxManager
+	publisher    events.Publisher
+	webhooks     WebhookSender
+	batchSize    int
+}
+
+// NewService принимает batchSize — сколько напоминаний отправляется за один проход планировщика
+func NewService(
+	slaRepo repository.SLARepository,
+	calendarRepo repository.CalendarRepository,
+	auditRepo repository.AuditRepository,
+	txManager db.TxManager,
+	publisher events.Publisher,
+	webhooks WebhookSender,
+	batchSize int,
+) service.SLAService {
+	return &serv{
+		slaRepo:      slaRepo,
+		calendarRepo: calendarRepo,
+		auditRepo:    auditRepo,
+		txManager:    txManager,
+		publisher:    publisher,
+		webhooks:     webhooks,
+		batchSize:    batchSize,
+	}
+}
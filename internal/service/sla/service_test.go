@@ -0,0 +1,379 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/events"
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+type sentWebhook struct {
+	url     string
+	payload any
+}
+
+type fakeWebhooks struct {
+	mu   sync.Mutex
+	sent []sentWebhook
+	err  error
+}
+
+func (f *fakeWebhooks) Send(_ context.Context, url string, payload any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, sentWebhook{url: url, payload: payload})
+	return f.err
+}
+
+// passTx выполняет f без транзакции, но с хуками после коммита, как настоящий менеджер
+func passTx(txMgr *mocks.MockTxManager) {
+	txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error {
+			ctx, run := db.WithAfterCommit(ctx)
+			if err := fn(ctx); err != nil {
+				return err
+			}
+			run()
+			return nil
+		}).Maybe()
+}
+
+func TestSetTeamSettings(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *model.TeamSLA
+		setupMocks    func(*mocks.MockSLARepository)
+		expectedError error
+	}{
+		{
+			name:     "новые настройки",
+			settings: &model.TeamSLA{TeamName: "backend", FirstReviewHours: 24, WebhookURL: "https://hooks.example.com/sla"},
+			setupMocks: func(r *mocks.MockSLARepository) {
+				r.On("GetTeamSettings", mock.Anything, "backend").Return(nil, pgx.ErrNoRows)
+				r.On("SetTeamSettings", mock.Anything, mock.AnythingOfType("*model.TeamSLA")).Return(nil)
+			},
+		},
+		{
+			name:     "команда не найдена",
+			settings: &model.TeamSLA{TeamName: "missing", FirstReviewHours: 24},
+			setupMocks: func(r *mocks.MockSLARepository) {
+				r.On("GetTeamSettings", mock.Anything, "missing").Return(nil, pgx.ErrNoRows)
+				r.On("SetTeamSettings", mock.Anything, mock.AnythingOfType("*model.TeamSLA")).
+					Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:          "нулевой срок",
+			settings:      &model.TeamSLA{TeamName: "backend"},
+			setupMocks:    func(r *mocks.MockSLARepository) {},
+			expectedError: ErrInvalidSettings,
+		},
+		{
+			name:          "отрицательный интервал",
+			settings:      &model.TeamSLA{TeamName: "backend", FirstReviewHours: 4, ReminderIntervalHours: -1},
+			setupMocks:    func(r *mocks.MockSLARepository) {},
+			expectedError: ErrInvalidSettings,
+		},
+		{
+			name:          "отрицательный лимит переназначений",
+			settings:      &model.TeamSLA{TeamName: "backend", FirstReviewHours: 4, AutoReassignHours: 8, MaxAutoReassigns: -1},
+			setupMocks:    func(r *mocks.MockSLARepository) {},
+			expectedError: ErrInvalidSettings,
+		},
+		{
+			name:          "вебхук не http",
+			settings:      &model.TeamSLA{TeamName: "backend", FirstReviewHours: 4, WebhookURL: "ftp://example.com"},
+			setupMocks:    func(r *mocks.MockSLARepository) {},
+			expectedError: ErrInvalidSettings,
+		},
+		{
+			name:          "вебхук во внутреннюю сеть",
+			settings:      &model.TeamSLA{TeamName: "backend", FirstReviewHours: 4, WebhookURL: "http://169.254.169.254/latest/meta-data"},
+			setupMocks:    func(r *mocks.MockSLARepository) {},
+			expectedError: ErrInvalidSettings,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slaRepo := mocks.NewMockSLARepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+			passTx(txMgr)
+			auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil).Maybe()
+			tt.setupMocks(slaRepo)
+
+			svc := NewService(slaRepo, mocks.NewMockCalendarRepository(t), auditRepo, txMgr, events.NewBus(0), &fakeWebhooks{}, 10)
+			res, err := svc.SetTeamSettings(context.Background(), tt.settings)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, res.UpdatedAt.IsZero())
+		})
+	}
+}
+
+func TestGetTeamSettings_NotFound(t *testing.T) {
+	slaRepo := mocks.NewMockSLARepository(t)
+	slaRepo.On("GetTeamSettings", mock.Anything, "backend").Return(nil, pgx.ErrNoRows)
+
+	svc := NewService(slaRepo, mocks.NewMockCalendarRepository(t), mocks.NewMockAuditRepository(t), mocks.NewMockTxManager(t), events.NewBus(0), &fakeWebhooks{}, 10)
+	_, err := svc.GetTeamSettings(context.Background(), "backend")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+// defaultCalendar — графиков и праздников нет, у всех график по умолчанию
+func defaultCalendar(t *testing.T) *mocks.MockCalendarRepository {
+	calendarRepo := mocks.NewMockCalendarRepository(t)
+	calendarRepo.On("GetHolidays", mock.Anything, mock.Anything).Return([]*model.Holiday{}, nil).Maybe()
+	calendarRepo.On("GetSchedules", mock.Anything, mock.Anything).Return([]*model.UserSchedule{}, nil).Maybe()
+	return calendarRepo
+}
+
+func TestSendReminders(t *testing.T) {
+	// среда; назначения в понедельник давно вышли за 4 рабочих часа
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	assigned := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	withHook := &model.SLAReminder{PRID: uuid.New(), ReviewerID: uuid.New(), AuthorID: uuid.New(), TeamName: "backend", FirstReviewHours: 4, AssignedAt: assigned, WebhookURL: "https://hooks.example.com/sla"}
+	noHook := &model.SLAReminder{PRID: uuid.New(), ReviewerID: uuid.New(), AuthorID: uuid.New(), TeamName: "frontend", FirstReviewHours: 4, AssignedAt: assigned}
+
+	slaRepo := mocks.NewMockSLARepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	passTx(txMgr)
+	slaRepo.On("GetDueReminders", mock.Anything, now, (*model.SLAReminder)(nil), 10).Return([]*model.SLAReminder{withHook, noHook}, nil)
+	slaRepo.On("MarkReminded", mock.Anything, []*model.SLAReminder{withHook, noHook}, now).Return(nil)
+
+	bus := events.NewBus(10)
+	hooks := &fakeWebhooks{err: errors.New("502 Bad Gateway")}
+	svc := NewService(slaRepo, defaultCalendar(t), mocks.NewMockAuditRepository(t), txMgr, bus, hooks, 10)
+
+	n, err := svc.SendReminders(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC), withHook.DueAt)
+
+	// ошибка вебхука не отменяет отметку о напоминании
+	require.Len(t, hooks.sent, 1)
+	assert.Equal(t, withHook.WebhookURL, hooks.sent[0].url)
+
+	_, replay, _ := bus.Subscribe(&model.EventFilter{UserID: &noHook.ReviewerID}, bus.Epoch()+"-0")
+	require.Len(t, replay, 1)
+	assert.Equal(t, model.EventReviewReminder, replay[0].Type)
+	assert.Equal(t, "frontend", replay[0].TeamName)
+}
+
+func TestSendReminders_BusinessHours(t *testing.T) {
+	// понедельник 10:00 UTC; назначение в пятницу в 17:00 UTC
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	assigned := time.Date(2026, 3, 6, 17, 0, 0, 0, time.UTC)
+	reviewerID := uuid.New()
+	tokyo := []*model.UserSchedule{{
+		UserID:       reviewerID,
+		TeamName:     "backend",
+		WorkingHours: model.WorkingHours{Timezone: "Asia/Tokyo", WorkStart: "09:00", WorkEnd: "18:00"},
+	}}
+
+	tests := []struct {
+		name       string
+		reminder   *model.SLAReminder
+		schedules  []*model.UserSchedule
+		holidays   []*model.Holiday
+		expectedAt *time.Time
+	}{
+		{
+			name:     "выходные не входят в срок",
+			reminder: &model.SLAReminder{FirstReviewHours: 4, AssignedAt: assigned},
+		},
+		{
+			name:       "срок по часовому поясу ревьюера",
+			reminder:   &model.SLAReminder{FirstReviewHours: 4, AssignedAt: assigned},
+			schedules:  tokyo,
+			expectedAt: ptr(time.Date(2026, 3, 9, 4, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:      "праздник команды ревьюера",
+			reminder:  &model.SLAReminder{FirstReviewHours: 4, AssignedAt: assigned},
+			schedules: tokyo,
+			holidays:  []*model.Holiday{{Date: "2026-03-09", TeamName: "backend"}},
+		},
+		{
+			name:       "повтор после интервала",
+			reminder:   &model.SLAReminder{FirstReviewHours: 1, ReminderIntervalHours: 1, AssignedAt: assigned, RemindedAt: ptr(time.Date(2026, 3, 6, 17, 30, 0, 0, time.UTC))},
+			expectedAt: ptr(time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "повтор до истечения интервала",
+			reminder: &model.SLAReminder{FirstReviewHours: 1, ReminderIntervalHours: 2, AssignedAt: assigned, RemindedAt: ptr(time.Date(2026, 3, 6, 17, 30, 0, 0, time.UTC))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.reminder.ReviewerID = reviewerID
+			slaRepo := mocks.NewMockSLARepository(t)
+			calendarRepo := mocks.NewMockCalendarRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+			passTx(txMgr)
+			slaRepo.On("GetDueReminders", mock.Anything, now, (*model.SLAReminder)(nil), 10).Return([]*model.SLAReminder{tt.reminder}, nil)
+			calendarRepo.On("GetHolidays", mock.Anything, mock.AnythingOfType("*model.HolidayFilter")).Return(tt.holidays, nil)
+			calendarRepo.On("GetSchedules", mock.Anything, []uuid.UUID{reviewerID}).Return(tt.schedules, nil)
+			if tt.expectedAt != nil {
+				slaRepo.On("MarkReminded", mock.Anything, []*model.SLAReminder{tt.reminder}, now).Return(nil)
+			}
+
+			svc := NewService(slaRepo, calendarRepo, mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0), &fakeWebhooks{}, 10)
+			n, err := svc.SendReminders(context.Background(), now)
+			require.NoError(t, err)
+
+			if tt.expectedAt == nil {
+				assert.Zero(t, n)
+				return
+			}
+			assert.Equal(t, 1, n)
+			assert.True(t, tt.expectedAt.Equal(tt.reminder.DueAt), "due at %s", tt.reminder.DueAt)
+		})
+	}
+}
+
+func TestSendReminders_SkipsNotDueByCalendar(t *testing.T) {
+	// понедельник 10:00 UTC; с пятницы 17:00 прошло 2 рабочих часа
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	friday := time.Date(2026, 3, 6, 17, 0, 0, 0, time.UTC)
+	newReminder := func(at time.Time, hours int) *model.SLAReminder {
+		return &model.SLAReminder{PRID: uuid.New(), ReviewerID: uuid.New(), FirstReviewHours: hours, AssignedAt: at}
+	}
+	// по часам срок истек у всех, но первые две страницы по календарю еще не просрочены
+	notDue := []*model.SLAReminder{newReminder(friday, 4), newReminder(friday.Add(time.Minute), 4)}
+	due := newReminder(friday.Add(30*time.Minute), 1)
+
+	slaRepo := mocks.NewMockSLARepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	passTx(txMgr)
+	slaRepo.On("GetDueReminders", mock.Anything, now, (*model.SLAReminder)(nil), 2).Return(notDue, nil)
+	slaRepo.On("GetDueReminders", mock.Anything, now, notDue[1], 2).Return([]*model.SLAReminder{due}, nil)
+	slaRepo.On("MarkReminded", mock.Anything, []*model.SLAReminder{due}, now).Return(nil)
+
+	svc := NewService(slaRepo, defaultCalendar(t), mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0), &fakeWebhooks{}, 2)
+	n, err := svc.SendReminders(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestSendReminders_RollbackSkipsWebhooks(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	r := &model.SLAReminder{PRID: uuid.New(), ReviewerID: uuid.New(), FirstReviewHours: 1, AssignedAt: now.Add(-24 * time.Hour), WebhookURL: "https://hooks.example.com/sla"}
+
+	slaRepo := mocks.NewMockSLARepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	passTx(txMgr)
+	slaRepo.On("GetDueReminders", mock.Anything, now, (*model.SLAReminder)(nil), 10).Return([]*model.SLAReminder{r}, nil)
+	slaRepo.On("MarkReminded", mock.Anything, mock.Anything, now).Return(errors.New("db error"))
+
+	hooks := &fakeWebhooks{}
+	svc := NewService(slaRepo, defaultCalendar(t), mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0), hooks, 10)
+
+	_, err := svc.SendReminders(context.Background(), now)
+	assert.Error(t, err)
+	assert.Empty(t, hooks.sent)
+}
+
+// blockingWebhooks отвечает, только когда одновременно пришли все wait запросов
+type blockingWebhooks struct {
+	wait    sync.WaitGroup
+	release chan struct{}
+}
+
+func (f *blockingWebhooks) Send(ctx context.Context, _ string, _ any) error {
+	f.wait.Done()
+	select {
+	case <-f.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestSendReminders_WebhooksInParallel(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	assigned := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	var reminders []*model.SLAReminder
+	for range 3 {
+		reminders = append(reminders, &model.SLAReminder{PRID: uuid.New(), ReviewerID: uuid.New(), FirstReviewHours: 4, AssignedAt: assigned, WebhookURL: "https://hooks.example.com/sla"})
+	}
+
+	slaRepo := mocks.NewMockSLARepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	passTx(txMgr)
+	slaRepo.On("GetDueReminders", mock.Anything, now, (*model.SLAReminder)(nil), 10).Return(reminders, nil)
+	slaRepo.On("MarkReminded", mock.Anything, reminders, now).Return(nil)
+
+	hooks := &blockingWebhooks{release: make(chan struct{})}
+	hooks.wait.Add(len(reminders))
+	go func() {
+		// последовательная отправка не дождалась бы второго запроса
+		hooks.wait.Wait()
+		close(hooks.release)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := NewService(slaRepo, defaultCalendar(t), mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0), hooks, 10)
+
+	n, err := svc.SendReminders(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.NoError(t, ctx.Err())
+}
+
+func TestGetStats(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+	answered := func(day, hour int) *time.Time { return ptr(at(day, hour)) }
+
+	slaRepo := mocks.NewMockSLARepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+	txMgr.On("RepeatableRead", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	slaRepo.On("ListTeamSettings", mock.Anything).Return([]*model.TeamSLA{
+		{TeamName: "backend", FirstReviewHours: 4},
+		{TeamName: "frontend", FirstReviewHours: 24},
+	}, nil)
+	slaRepo.On("GetAssignments", mock.Anything).Return([]*model.SLAAssignment{
+		{TeamName: "backend", ReviewerID: uuid.New(), AssignedAt: at(2, 9), AnsweredAt: answered(2, 12)},
+		// 66 часов по часам, но 3 рабочих: выходные не считаются
+		{TeamName: "backend", ReviewerID: uuid.New(), AssignedAt: at(6, 17), AnsweredAt: answered(9, 11)},
+		{TeamName: "backend", ReviewerID: uuid.New(), AssignedAt: at(2, 9), AnsweredAt: answered(3, 9)},
+		{TeamName: "backend", ReviewerID: uuid.New(), AssignedAt: at(2, 9)},
+		{TeamName: "backend", ReviewerID: uuid.New(), AssignedAt: time.Now().UTC()},
+		{TeamName: "frontend", ReviewerID: uuid.New(), AssignedAt: time.Now().UTC()},
+	}, nil)
+
+	svc := NewService(slaRepo, defaultCalendar(t), mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0), &fakeWebhooks{}, 10)
+	stats, err := svc.GetStats(context.Background())
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, &model.SLAStats{TeamName: "backend", FirstReviewHours: 4, Total: 5, OnTime: 2, Late: 1, Overdue: 1, Pending: 1, BreachRate: 0.5}, stats[0])
+	assert.Equal(t, &model.SLAStats{TeamName: "frontend", FirstReviewHours: 24, Total: 1, Pending: 1}, stats[1])
+}
@@ -0,0 +1,69 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+
+	"PR/internal/client/webhook"
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+func (s *serv) SetTeamSettings(ctx context.Context, settings *model.TeamSLA) (*model.TeamSLA, error) {
+	if !validSettings(settings) {
+		return nil, ErrInvalidSettings
+	}
+	settings.UpdatedAt = time.Now().UTC()
+
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		before, errTx := s.slaRepo.GetTeamSettings(ctx, settings.TeamName)
+		if errTx != nil && !errors.Is(errTx, pgx.ErrNoRows) {
+			return errTx
+		}
+
+		errTx = s.slaRepo.SetTeamSettings(ctx, settings)
+		if errTx != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(errTx, &pgErr) && pgErr.Code == "23503" {
+				return ErrTeamNotFound
+			}
+			return errTx
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditTeamSetSLA, model.AuditEntityTeam, settings.TeamName, before, settings)
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.SetTeamSettings error: %v", op, err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (s *serv) GetTeamSettings(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	settings, err := s.slaRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetTeamSettings error: %v", op, err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+// validSettings: срок первого ревью положительный, интервал повтора и политика
+// переназначения не отрицательные, вебхук — абсолютный http(s) адрес вне внутренней сети
+func validSettings(s *model.TeamSLA) bool {
+	if s.TeamName == "" || s.FirstReviewHours <= 0 || s.ReminderIntervalHours < 0 {
+		return false
+	}
+	if s.AutoReassignHours < 0 || s.MaxAutoReassigns < 0 {
+		return false
+	}
+	return s.WebhookURL == "" || webhook.ValidURL(s.WebhookURL)
+}
@@ -0,0 +1,88 @@
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+	"PR/internal/service/calendar"
+)
+
+// GetStats считает соблюдение SLA по текущим назначениям. Срок отсчитывается
+// в рабочих часах ревьюера, поэтому назначения классифицируются здесь, а не в SQL
+func (s *serv) GetStats(ctx context.Context) ([]*model.SLAStats, error) {
+	now := time.Now().UTC()
+
+	var (
+		settings    []*model.TeamSLA
+		assignments []*model.SLAAssignment
+		cals        *calendar.Calendars
+	)
+	// настройки, назначения и графики читаются из одного снимка
+	err := s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+		var errTx error
+		settings, errTx = s.slaRepo.ListTeamSettings(ctx)
+		if errTx != nil {
+			return errTx
+		}
+		assignments, errTx = s.slaRepo.GetAssignments(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		ids := make([]uuid.UUID, 0, len(assignments))
+		from := now
+		for _, a := range assignments {
+			ids = append(ids, a.ReviewerID)
+			if a.AssignedAt.Before(from) {
+				from = a.AssignedAt
+			}
+		}
+		cals, errTx = calendar.Load(ctx, s.calendarRepo, ids, from, now)
+		return errTx
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetStats error: %v", op, err)
+		return nil, err
+	}
+
+	stats := make([]*model.SLAStats, 0, len(settings))
+	byTeam := make(map[string]*model.SLAStats, len(settings))
+	for _, ts := range settings {
+		st := &model.SLAStats{TeamName: ts.TeamName, FirstReviewHours: ts.FirstReviewHours}
+		byTeam[ts.TeamName] = st
+		stats = append(stats, st)
+	}
+
+	for _, a := range assignments {
+		st, ok := byTeam[a.TeamName]
+		if !ok {
+			continue
+		}
+		sla := time.Duration(st.FirstReviewHours) * time.Hour
+		cal := cals.Get(a.ReviewerID)
+
+		st.Total++
+		switch {
+		case a.AnsweredAt != nil && cal.Between(a.AssignedAt, *a.AnsweredAt) <= sla:
+			st.OnTime++
+		case a.AnsweredAt != nil:
+			st.Late++
+		case cal.Between(a.AssignedAt, now) > sla:
+			st.Overdue++
+		default:
+			st.Pending++
+		}
+	}
+
+	for _, st := range stats {
+		// BreachRate считается только по назначениям с известным исходом
+		if decided := st.OnTime + st.Late + st.Overdue; decided > 0 {
+			st.BreachRate = float64(st.Breaches()) / float64(decided)
+		}
+	}
+	return stats, nil
+}
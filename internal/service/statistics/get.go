@@ -3,25 +3,43 @@ package statistics
 import (
 	"context"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
 )
 
-func (s *serv) GetReviewerStatistics(ctx context.Context) ([]*model.ReviewerStats, error) {
-	list, err := s.repo.GetReviewerStatistics(ctx)
+func (s *serv) GetReviewerStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.ReviewerStats, error) {
+	list, err := s.repo.GetReviewerStatistics(ctx, f)
 	if err != nil {
-		log.Error().Msgf("%s.GetReviewerStatistics error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetReviewerStatistics error: %v", op, err)
 		return nil, err
 	}
 	return list, nil
 }
 
-func (s *serv) GetPRStatistics(ctx context.Context) ([]*model.PRStats, error) {
-	list, err := s.repo.GetPRStatistics(ctx)
+func (s *serv) GetPRStatistics(ctx context.Context, f model.StatisticsFilter) ([]*model.PRStats, error) {
+	list, err := s.repo.GetPRStatistics(ctx, f)
 	if err != nil {
-		log.Error().Msgf("%s.GetPRStatistics error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetPRStatistics error: %v", op, err)
 		return nil, err
 	}
 	return list, nil
 }
+
+func (s *serv) StreamReviewerStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.ReviewerStats) error) error {
+	err := s.repo.StreamReviewerStatistics(ctx, f, fn)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.StreamReviewerStatistics error: %v", op, err)
+		return err
+	}
+	return nil
+}
+
+func (s *serv) StreamPRStatistics(ctx context.Context, f model.StatisticsFilter, fn func(*model.PRStats) error) error {
+	err := s.repo.StreamPRStatistics(ctx, f, fn)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.StreamPRStatistics error: %v", op, err)
+		return err
+	}
+	return nil
+}
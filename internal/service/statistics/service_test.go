@@ -35,7 +35,7 @@ func TestGetReviewerStatistics(t *testing.T) {
 						AssignedCount: 5,
 					},
 				}
-				repo.On("GetReviewerStatistics", mock.Anything).Return(stats, nil)
+				repo.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).Return(stats, nil)
 			},
 			expectedCount: 2,
 			expectedError: nil,
@@ -43,7 +43,7 @@ func TestGetReviewerStatistics(t *testing.T) {
 		{
 			name: "пустая статистика",
 			setupMocks: func(repo *mocks.MockStatisticsRepository) {
-				repo.On("GetReviewerStatistics", mock.Anything).Return([]*model.ReviewerStats{}, nil)
+				repo.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).Return([]*model.ReviewerStats{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: nil,
@@ -51,7 +51,7 @@ func TestGetReviewerStatistics(t *testing.T) {
 		{
 			name: "ошибка репозитория",
 			setupMocks: func(repo *mocks.MockStatisticsRepository) {
-				repo.On("GetReviewerStatistics", mock.Anything).Return(nil, errors.New("database error"))
+				repo.On("GetReviewerStatistics", mock.Anything, model.StatisticsFilter{}).Return(nil, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 		},
@@ -66,7 +66,7 @@ func TestGetReviewerStatistics(t *testing.T) {
 
 			svc := NewService(repo, txMgr)
 
-			result, err := svc.GetReviewerStatistics(context.Background())
+			result, err := svc.GetReviewerStatistics(context.Background(), model.StatisticsFilter{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -118,7 +118,7 @@ func TestGetPRStatistics(t *testing.T) {
 						Status:        "OPEN",
 					},
 				}
-				repo.On("GetPRStatistics", mock.Anything).Return(stats, nil)
+				repo.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).Return(stats, nil)
 			},
 			expectedCount: 3,
 			expectedError: nil,
@@ -126,7 +126,7 @@ func TestGetPRStatistics(t *testing.T) {
 		{
 			name: "пустая статистика",
 			setupMocks: func(repo *mocks.MockStatisticsRepository) {
-				repo.On("GetPRStatistics", mock.Anything).Return([]*model.PRStats{}, nil)
+				repo.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).Return([]*model.PRStats{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: nil,
@@ -134,7 +134,7 @@ func TestGetPRStatistics(t *testing.T) {
 		{
 			name: "ошибка репозитория",
 			setupMocks: func(repo *mocks.MockStatisticsRepository) {
-				repo.On("GetPRStatistics", mock.Anything).Return(nil, errors.New("connection timeout"))
+				repo.On("GetPRStatistics", mock.Anything, model.StatisticsFilter{}).Return(nil, errors.New("connection timeout"))
 			},
 			expectedError: errors.New("connection timeout"),
 		},
@@ -149,7 +149,7 @@ func TestGetPRStatistics(t *testing.T) {
 
 			svc := NewService(repo, txMgr)
 
-			result, err := svc.GetPRStatistics(context.Background())
+			result, err := svc.GetPRStatistics(context.Background(), model.StatisticsFilter{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -171,3 +171,57 @@ func TestGetPRStatistics(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamReviewerStatistics(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*mocks.MockStatisticsRepository)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name: "строки передаются в колбэк",
+			setupMocks: func(repo *mocks.MockStatisticsRepository) {
+				repo.On("StreamReviewerStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						fn := args.Get(2).(func(*model.ReviewerStats) error)
+						_ = fn(&model.ReviewerStats{ReviewerID: uuid.New(), ReviewerName: "John Doe", AssignedCount: 10})
+						_ = fn(&model.ReviewerStats{ReviewerID: uuid.New(), ReviewerName: "Jane Smith", AssignedCount: 5})
+					}).Return(nil)
+			},
+			expectedCount: 2,
+			expectedError: nil,
+		},
+		{
+			name: "ошибка репозитория",
+			setupMocks: func(repo *mocks.MockStatisticsRepository) {
+				repo.On("StreamReviewerStatistics", mock.Anything, model.StatisticsFilter{}, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockStatisticsRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			tt.setupMocks(repo)
+
+			svc := NewService(repo, txMgr)
+
+			count := 0
+			err := svc.StreamReviewerStatistics(context.Background(), model.StatisticsFilter{}, func(*model.ReviewerStats) error {
+				count++
+				return nil
+			})
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
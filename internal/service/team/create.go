@@ -5,9 +5,10 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
+	"PR/internal/service/audit"
 )
 
 func (s *serv) Create(ctx context.Context, t *model.Team) error {
@@ -30,11 +31,12 @@ func (s *serv) Create(ctx context.Context, t *model.Team) error {
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+
+		return audit.Record(ctx, s.auditRepo, model.AuditTeamCreate, model.AuditEntityTeam, t.TeamName, nil, t)
 	})
 
 	if err != nil {
-		log.Error().Msgf("%s.Create error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.Create error: %v", op, err)
 		return err
 	}
 	return nil
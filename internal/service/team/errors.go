@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrTeamExist = errors.New("team exist")
 	ErrNotFound  = errors.New("team not found")
+	// ErrInvalidRoster оборачивается с описанием проблемной строки
+	ErrInvalidRoster = errors.New("invalid roster")
 )
@@ -5,7 +5,7 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
 )
@@ -14,7 +14,7 @@ func (s *serv) GetTeamByName(ctx context.Context, name string) (*model.Team, err
 
 	t, err := s.repo.GetTeamByName(ctx, name)
 	if err != nil {
-		log.Error().Msgf("%s.GetTeamByName error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.GetTeamByName error: %v", op, err)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -0,0 +1,196 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"PR/internal/model"
+	"PR/internal/service/audit"
+)
+
+// Import сверяет список сотрудников с базой и в одной транзакции создает недостающие
+// команды и пользователей, переводит и обновляет существующих и деактивирует тех,
+// кого нет в списке своей команды. О каждом деактивированном, активированном или
+// переведенном пользователе публикуется user.activity_changed. С dryRun только возвращает план
+func (s *serv) Import(ctx context.Context, roster []*model.RosterEntry, dryRun bool) (*model.ImportPlan, error) {
+	teams, err := validateRoster(roster)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *model.ImportPlan
+	err = s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+		existing, errTx := s.repo.GetExistingNames(ctx, teams)
+		if errTx != nil {
+			return errTx
+		}
+
+		ids := make([]uuid.UUID, 0, len(roster))
+		for _, e := range roster {
+			ids = append(ids, e.UserID)
+		}
+		listed, errTx := s.userRepo.GetByIDs(ctx, ids)
+		if errTx != nil {
+			return errTx
+		}
+		members, errTx := s.userRepo.GetByTeams(ctx, teams)
+		if errTx != nil {
+			return errTx
+		}
+
+		current := append(listed, members...)
+		var upsert []*model.User
+		plan, upsert = buildPlan(roster, teams, existing, current)
+		plan.DryRun = dryRun
+		if dryRun || plan.Empty() {
+			return nil
+		}
+
+		if len(plan.TeamsCreated) > 0 {
+			if errTx = s.repo.CreateTeams(ctx, plan.TeamsCreated); errTx != nil {
+				return errTx
+			}
+		}
+		if errTx = s.userRepo.Upsert(ctx, upsert); errTx != nil {
+			return errTx
+		}
+
+		for _, u := range activityChanges(current, upsert) {
+			if errTx = s.publisher.Publish(ctx, &model.Event{
+				Type:     model.EventUserActivityChanged,
+				TeamName: u.TeamName,
+				UserIDs:  []uuid.UUID{u.ID},
+				Data:     u,
+			}); errTx != nil {
+				return errTx
+			}
+		}
+
+		return audit.Record(ctx, s.auditRepo, model.AuditTeamImport, model.AuditEntityTeam, strings.Join(teams, ","), nil, plan)
+	})
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("%s.Import error: %v", op, err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+// validateRoster проверяет обязательные поля и повторы пользователей
+// и возвращает команды списка в порядке первого упоминания
+func validateRoster(roster []*model.RosterEntry) ([]string, error) {
+	if len(roster) == 0 {
+		return nil, fmt.Errorf("%w: roster is empty", ErrInvalidRoster)
+	}
+
+	var teams []string
+	seenTeams := make(map[string]bool)
+	seenUsers := make(map[uuid.UUID]bool, len(roster))
+	for i, e := range roster {
+		switch {
+		case e.TeamName == "":
+			return nil, fmt.Errorf("%w: row %d: team_name is required", ErrInvalidRoster, i+1)
+		case e.UserID == uuid.Nil:
+			return nil, fmt.Errorf("%w: row %d: user_id is required", ErrInvalidRoster, i+1)
+		case e.Username == "":
+			return nil, fmt.Errorf("%w: row %d: username is required", ErrInvalidRoster, i+1)
+		case seenUsers[e.UserID]:
+			return nil, fmt.Errorf("%w: row %d: user %s is listed twice", ErrInvalidRoster, i+1, e.UserID)
+		}
+		seenUsers[e.UserID] = true
+
+		if !seenTeams[e.TeamName] {
+			seenTeams[e.TeamName] = true
+			teams = append(teams, e.TeamName)
+		}
+	}
+	return teams, nil
+}
+
+// buildPlan сравнивает список с текущими пользователями и возвращает план
+// и итоговое состояние всех пользователей, которые в нем меняются
+func buildPlan(roster []*model.RosterEntry, teams, existingTeams []string, current []*model.User) (*model.ImportPlan, []*model.User) {
+	plan := &model.ImportPlan{
+		TeamsCreated:     []string{},
+		UsersCreated:     []*model.User{},
+		UsersMoved:       []*model.UserMove{},
+		UsersUpdated:     []*model.User{},
+		UsersDeactivated: []*model.User{},
+	}
+
+	exists := make(map[string]bool, len(existingTeams))
+	for _, name := range existingTeams {
+		exists[name] = true
+	}
+	for _, name := range teams {
+		if !exists[name] {
+			plan.TeamsCreated = append(plan.TeamsCreated, name)
+		}
+	}
+
+	byID := make(map[uuid.UUID]*model.User, len(current))
+	for _, u := range current {
+		byID[u.ID] = u
+	}
+
+	var upsert []*model.User
+	listed := make(map[uuid.UUID]bool, len(roster))
+	for _, e := range roster {
+		listed[e.UserID] = true
+		u := &model.User{ID: e.UserID, Username: e.Username, TeamName: e.TeamName, IsActive: e.IsActive}
+
+		cur, ok := byID[e.UserID]
+		switch {
+		case !ok:
+			plan.UsersCreated = append(plan.UsersCreated, u)
+		case cur.TeamName != e.TeamName:
+			plan.UsersMoved = append(plan.UsersMoved, &model.UserMove{User: *u, FromTeam: cur.TeamName})
+		case cur.Username != e.Username || cur.IsActive != e.IsActive:
+			plan.UsersUpdated = append(plan.UsersUpdated, u)
+		default:
+			plan.Unchanged++
+			continue
+		}
+		upsert = append(upsert, u)
+	}
+
+	inRoster := make(map[string]bool, len(teams))
+	for _, name := range teams {
+		inRoster[name] = true
+	}
+	for _, u := range current {
+		if listed[u.ID] || !inRoster[u.TeamName] || !u.IsActive {
+			continue
+		}
+		// пользователь мог прийти дважды: по id и по команде
+		listed[u.ID] = true
+		deactivated := *u
+		deactivated.IsActive = false
+		plan.UsersDeactivated = append(plan.UsersDeactivated, &deactivated)
+		upsert = append(upsert, &deactivated)
+	}
+
+	return plan, upsert
+}
+
+// activityChanges возвращает пользователей, у которых меняется активность или
+// команда: для них меняется набор кандидатов в ревьюеры. Новые пользователи не входят
+func activityChanges(current, upsert []*model.User) []*model.User {
+	byID := make(map[uuid.UUID]*model.User, len(current))
+	for _, u := range current {
+		byID[u.ID] = u
+	}
+
+	var changed []*model.User
+	for _, u := range upsert {
+		cur, ok := byID[u.ID]
+		if ok && (cur.IsActive != u.IsActive || cur.TeamName != u.TeamName) {
+			changed = append(changed, u)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,165 @@
+package team
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/client/db"
+	"PR/internal/events"
+	"PR/internal/mocks"
+	"PR/internal/model"
+)
+
+func TestBuildPlan(t *testing.T) {
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	roster := []*model.RosterEntry{
+		{TeamName: "backend", UserID: alice, Username: "alice", IsActive: true},
+		{TeamName: "backend", UserID: bob, Username: "bob", IsActive: true},
+		{TeamName: "mobile", UserID: carol, Username: "carol-new", IsActive: true},
+	}
+	current := []*model.User{
+		// alice без изменений, bob переходит из frontend, carol переименована,
+		// dave пропал из списка backend
+		{ID: alice, Username: "alice", TeamName: "backend", IsActive: true},
+		{ID: bob, Username: "bob", TeamName: "frontend", IsActive: true},
+		{ID: carol, Username: "carol", TeamName: "mobile", IsActive: true},
+		{ID: dave, Username: "dave", TeamName: "backend", IsActive: true},
+		// dave пришел второй раз по команде — деактивируется один раз
+		{ID: dave, Username: "dave", TeamName: "backend", IsActive: true},
+	}
+
+	plan, upsert := buildPlan(roster, []string{"backend", "mobile"}, []string{"backend"}, current)
+
+	assert.Equal(t, []string{"mobile"}, plan.TeamsCreated)
+	assert.Empty(t, plan.UsersCreated)
+	require.Len(t, plan.UsersMoved, 1)
+	assert.Equal(t, bob, plan.UsersMoved[0].ID)
+	assert.Equal(t, "frontend", plan.UsersMoved[0].FromTeam)
+	assert.Equal(t, "backend", plan.UsersMoved[0].TeamName)
+	require.Len(t, plan.UsersUpdated, 1)
+	assert.Equal(t, "carol-new", plan.UsersUpdated[0].Username)
+	require.Len(t, plan.UsersDeactivated, 1)
+	assert.Equal(t, dave, plan.UsersDeactivated[0].ID)
+	assert.False(t, plan.UsersDeactivated[0].IsActive)
+	assert.Equal(t, 1, plan.Unchanged)
+
+	assert.Len(t, upsert, 3)
+}
+
+func TestBuildPlan_NewUserAndInactiveLeftover(t *testing.T) {
+	erin, frank := uuid.New(), uuid.New()
+
+	roster := []*model.RosterEntry{{TeamName: "qa", UserID: erin, Username: "erin", IsActive: true}}
+	current := []*model.User{{ID: frank, Username: "frank", TeamName: "qa", IsActive: false}}
+
+	plan, upsert := buildPlan(roster, []string{"qa"}, []string{"qa"}, current)
+
+	assert.Empty(t, plan.TeamsCreated)
+	require.Len(t, plan.UsersCreated, 1)
+	assert.Equal(t, erin, plan.UsersCreated[0].ID)
+	// уже неактивный пользователь не попадает в план
+	assert.Empty(t, plan.UsersDeactivated)
+	assert.Len(t, upsert, 1)
+}
+
+func TestValidateRoster(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		name   string
+		roster []*model.RosterEntry
+	}{
+		{"empty", nil},
+		{"no team", []*model.RosterEntry{{UserID: id, Username: "a"}}},
+		{"no user id", []*model.RosterEntry{{TeamName: "t", Username: "a"}}},
+		{"no username", []*model.RosterEntry{{TeamName: "t", UserID: id}}},
+		{"duplicate", []*model.RosterEntry{
+			{TeamName: "t", UserID: id, Username: "a"},
+			{TeamName: "u", UserID: id, Username: "a"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateRoster(tt.roster)
+			assert.ErrorIs(t, err, ErrInvalidRoster)
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	alice := uuid.New()
+	roster := []*model.RosterEntry{{TeamName: "backend", UserID: alice, Username: "alice", IsActive: true}}
+
+	for _, dryRun := range []bool{true, false} {
+		t.Run(map[bool]string{true: "dry run", false: "apply"}[dryRun], func(t *testing.T) {
+			repo := mocks.NewMockTeamRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
+			txMgr := mocks.NewMockTxManager(t)
+
+			txMgr.EXPECT().RepeatableRead(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+			repo.EXPECT().GetExistingNames(mock.Anything, []string{"backend"}).Return([]string{}, nil)
+			userRepo.EXPECT().GetByIDs(mock.Anything, []uuid.UUID{alice}).Return(nil, nil)
+			userRepo.EXPECT().GetByTeams(mock.Anything, []string{"backend"}).Return(nil, nil)
+
+			if !dryRun {
+				repo.EXPECT().CreateTeams(mock.Anything, []string{"backend"}).Return(nil)
+				userRepo.EXPECT().Upsert(mock.Anything, []*model.User{
+					{ID: alice, Username: "alice", TeamName: "backend", IsActive: true},
+				}).Return(nil)
+				auditRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditTeamImport && e.EntityID == "backend"
+				})).Return(nil)
+			}
+
+			svc := NewService(repo, userRepo, auditRepo, txMgr, events.NewBus(0))
+			plan, err := svc.Import(context.Background(), roster, dryRun)
+			require.NoError(t, err)
+
+			assert.Equal(t, dryRun, plan.DryRun)
+			assert.Equal(t, []string{"backend"}, plan.TeamsCreated)
+			assert.Len(t, plan.UsersCreated, 1)
+		})
+	}
+}
+
+func TestImport_PublishesActivityChanges(t *testing.T) {
+	alice, dave := uuid.New(), uuid.New()
+	roster := []*model.RosterEntry{{TeamName: "backend", UserID: alice, Username: "alice", IsActive: true}}
+
+	repo := mocks.NewMockTeamRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	auditRepo := mocks.NewMockAuditRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+
+	txMgr.EXPECT().RepeatableRead(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+	repo.EXPECT().GetExistingNames(mock.Anything, []string{"backend"}).Return([]string{"backend"}, nil)
+	// alice переходит из frontend, dave пропал из списка backend
+	userRepo.EXPECT().GetByIDs(mock.Anything, []uuid.UUID{alice}).
+		Return([]*model.User{{ID: alice, Username: "alice", TeamName: "frontend", IsActive: true}}, nil)
+	userRepo.EXPECT().GetByTeams(mock.Anything, []string{"backend"}).
+		Return([]*model.User{{ID: dave, Username: "dave", TeamName: "backend", IsActive: true}}, nil)
+	userRepo.EXPECT().Upsert(mock.Anything, mock.Anything).Return(nil)
+	auditRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	bus := events.NewBus(10)
+	svc := NewService(repo, userRepo, auditRepo, txMgr, bus)
+	_, err := svc.Import(context.Background(), roster, false)
+	require.NoError(t, err)
+
+	_, replay, _ := bus.Subscribe(&model.EventFilter{}, bus.Epoch()+"-0")
+	require.Len(t, replay, 2)
+	for i, id := range []uuid.UUID{alice, dave} {
+		assert.Equal(t, model.EventUserActivityChanged, replay[i].Type)
+		assert.Equal(t, "backend", replay[i].TeamName)
+		assert.Equal(t, []uuid.UUID{id}, replay[i].UserIDs)
+	}
+}
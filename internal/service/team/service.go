@@ -2,6 +2,7 @@ package team
 
 import (
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/repository"
 	"PR/internal/service"
 )
@@ -10,15 +11,24 @@ const op = "service.TeamService"
 
 type serv struct {
 	repo      repository.TeamRepository
+	userRepo  repository.UserRepository
+	auditRepo repository.AuditRepository
 	txManager db.TxManager
+	publisher events.Publisher
 }
 
 func NewService(
 	repo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	auditRepo repository.AuditRepository,
 	txManager db.TxManager,
+	publisher events.Publisher,
 ) service.TeamService {
 	return &serv{
 		repo:      repo,
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
 		txManager: txManager,
+		publisher: publisher,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/mocks"
 	"PR/internal/model"
 )
@@ -20,7 +21,7 @@ func TestCreate(t *testing.T) {
 	tests := []struct {
 		name          string
 		input         *model.Team
-		setupMocks    func(*mocks.MockTeamRepository, *mocks.MockTxManager)
+		setupMocks    func(*mocks.MockTeamRepository, *mocks.MockAuditRepository, *mocks.MockTxManager)
 		expectedError error
 	}{
 		{
@@ -40,7 +41,7 @@ func TestCreate(t *testing.T) {
 					},
 				},
 			},
-			setupMocks: func(repo *mocks.MockTeamRepository, txMgr *mocks.MockTxManager) {
+			setupMocks: func(repo *mocks.MockTeamRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
 				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
@@ -49,6 +50,9 @@ func TestCreate(t *testing.T) {
 
 				repo.On("CreateTeam", mock.Anything, "backend-team").Return(nil)
 				repo.On("CreateMembers", mock.Anything, mock.AnythingOfType("*model.Team")).Return(nil)
+				auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditTeamCreate && e.EntityID == "backend-team" && e.Before == nil
+				})).Return(nil)
 			},
 			expectedError: nil,
 		},
@@ -64,7 +68,7 @@ func TestCreate(t *testing.T) {
 					},
 				},
 			},
-			setupMocks: func(repo *mocks.MockTeamRepository, txMgr *mocks.MockTxManager) {
+			setupMocks: func(repo *mocks.MockTeamRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
 				pgErr := &pgconn.PgError{Code: "23505"}
 
 				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
@@ -89,7 +93,7 @@ func TestCreate(t *testing.T) {
 					},
 				},
 			},
-			setupMocks: func(repo *mocks.MockTeamRepository, txMgr *mocks.MockTxManager) {
+			setupMocks: func(repo *mocks.MockTeamRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
 				dbError := errors.New("foreign key constraint violation")
 
 				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
@@ -109,7 +113,7 @@ func TestCreate(t *testing.T) {
 				TeamName: "empty-team",
 				Members:  []*model.TeamMember{},
 			},
-			setupMocks: func(repo *mocks.MockTeamRepository, txMgr *mocks.MockTxManager) {
+			setupMocks: func(repo *mocks.MockTeamRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
 				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
 					Run(func(args mock.Arguments) {
 						fn := args.Get(1).(db.Handler)
@@ -118,6 +122,9 @@ func TestCreate(t *testing.T) {
 
 				repo.On("CreateTeam", mock.Anything, "empty-team").Return(nil)
 				repo.On("CreateMembers", mock.Anything, mock.AnythingOfType("*model.Team")).Return(nil)
+				auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *model.AuditEvent) bool {
+					return e.Action == model.AuditTeamCreate && e.EntityID == "empty-team" && e.Before == nil
+				})).Return(nil)
 			},
 			expectedError: nil,
 		},
@@ -127,7 +134,7 @@ func TestCreate(t *testing.T) {
 				TeamName: "test-team",
 				Members:  []*model.TeamMember{},
 			},
-			setupMocks: func(repo *mocks.MockTeamRepository, txMgr *mocks.MockTxManager) {
+			setupMocks: func(repo *mocks.MockTeamRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
 				dbError := errors.New("connection lost")
 
 				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
@@ -145,11 +152,12 @@ func TestCreate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := mocks.NewMockTeamRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
-			tt.setupMocks(repo, txMgr)
+			tt.setupMocks(repo, auditRepo, txMgr)
 
-			svc := NewService(repo, txMgr)
+			svc := NewService(repo, mocks.NewMockUserRepository(t), auditRepo, txMgr, events.NewBus(0))
 
 			err := svc.Create(context.Background(), tt.input)
 
@@ -235,7 +243,7 @@ func TestGetTeamByName(t *testing.T) {
 
 			tt.setupMocks(repo)
 
-			svc := NewService(repo, txMgr)
+			svc := NewService(repo, mocks.NewMockUserRepository(t), mocks.NewMockAuditRepository(t), txMgr, events.NewBus(0))
 
 			result, err := svc.GetTeamByName(context.Background(), tt.teamName)
 
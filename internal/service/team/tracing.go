@@ -0,0 +1,47 @@
+package team
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"PR/internal/model"
+	"PR/internal/service"
+	"PR/internal/tracing"
+)
+
+// tracedServ оборачивает каждый метод сервиса в спан "TeamService.<Метод>"
+type tracedServ struct {
+	next   service.TeamService
+	tracer trace.Tracer
+}
+
+func WithTracing(next service.TeamService) service.TeamService {
+	return &tracedServ{next: next, tracer: otel.Tracer("PR/internal/service/team")}
+}
+
+func (s *tracedServ) Create(ctx context.Context, t *model.Team) error {
+	ctx, span := s.tracer.Start(ctx, "TeamService.Create",
+		trace.WithAttributes(attribute.String("team.name", t.TeamName)))
+	err := s.next.Create(ctx, t)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *tracedServ) Import(ctx context.Context, roster []*model.RosterEntry, dryRun bool) (*model.ImportPlan, error) {
+	ctx, span := s.tracer.Start(ctx, "TeamService.Import",
+		trace.WithAttributes(attribute.Int("roster.size", len(roster)), attribute.Bool("dry_run", dryRun)))
+	res, err := s.next.Import(ctx, roster, dryRun)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (s *tracedServ) GetTeamByName(ctx context.Context, name string) (*model.Team, error) {
+	ctx, span := s.tracer.Start(ctx, "TeamService.GetTeamByName",
+		trace.WithAttributes(attribute.String("team.name", name)))
+	res, err := s.next.GetTeamByName(ctx, name)
+	tracing.End(span, err)
+	return res, err
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/repository"
 	"PR/internal/service"
 )
@@ -10,15 +11,21 @@ const op = "service.UserService"
 
 type serv struct {
 	repo      repository.UserRepository
+	auditRepo repository.AuditRepository
 	txManager db.TxManager
+	publisher events.Publisher
 }
 
 func NewService(
 	repo repository.UserRepository,
+	auditRepo repository.AuditRepository,
 	txManager db.TxManager,
+	publisher events.Publisher,
 ) service.UserService {
 	return &serv{
 		repo:      repo,
+		auditRepo: auditRepo,
 		txManager: txManager,
+		publisher: publisher,
 	}
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -10,42 +11,53 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"PR/internal/actor"
 	"PR/internal/client/db"
+	"PR/internal/events"
 	"PR/internal/mocks"
 	"PR/internal/model"
+	"PR/internal/requestid"
 )
 
+func runTx(txMgr *mocks.MockTxManager) {
+	txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
+		Return(func(ctx context.Context, fn db.Handler) error { return fn(ctx) })
+}
+
 func TestSetActive(t *testing.T) {
+	userID := uuid.New()
+	activeUser := &model.User{
+		ID:       userID,
+		Username: "john_doe",
+		IsActive: true,
+		TeamName: "backend-team",
+	}
+	inactiveUser := &model.User{
+		ID:       userID,
+		Username: "john_doe",
+		IsActive: false,
+		TeamName: "backend-team",
+	}
+
 	tests := []struct {
 		name          string
 		input         *model.UserSetActive
-		setupMocks    func(*mocks.MockUserRepository, *mocks.MockTxManager)
+		setupMocks    func(*mocks.MockUserRepository, *mocks.MockAuditRepository, *mocks.MockTxManager)
 		expectedError error
 		checkResult   func(*testing.T, *model.User)
 	}{
 		{
 			name: "успешная активация пользователя",
 			input: &model.UserSetActive{
-				UserID:   uuid.New(),
+				UserID:   userID,
 				IsActive: true,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				userID := uuid.New()
-				updatedUser := &model.User{
-					ID:       userID,
-					Username: "john_doe",
-					IsActive: true,
-					TeamName: "backend-team",
-				}
-
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(nil)
-
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, userID).Return(inactiveUser, nil).Once()
 				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(nil)
-				repo.On("GetByID", mock.Anything, mock.Anything).Return(updatedUser, nil)
+				repo.On("GetByID", mock.Anything, userID).Return(activeUser, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil)
 			},
 			expectedError: nil,
 			checkResult: func(t *testing.T, u *model.User) {
@@ -56,26 +68,15 @@ func TestSetActive(t *testing.T) {
 		{
 			name: "успешная деактивация пользователя",
 			input: &model.UserSetActive{
-				UserID:   uuid.New(),
+				UserID:   userID,
 				IsActive: false,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				userID := uuid.New()
-				updatedUser := &model.User{
-					ID:       userID,
-					Username: "jane_smith",
-					IsActive: false,
-					TeamName: "frontend-team",
-				}
-
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(nil)
-
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, userID).Return(activeUser, nil).Once()
 				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(nil)
-				repo.On("GetByID", mock.Anything, mock.Anything).Return(updatedUser, nil)
+				repo.On("GetByID", mock.Anything, userID).Return(inactiveUser, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).Return(nil)
 			},
 			expectedError: nil,
 			checkResult: func(t *testing.T, u *model.User) {
@@ -89,14 +90,9 @@ func TestSetActive(t *testing.T) {
 				UserID:   uuid.New(),
 				IsActive: true,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(ErrNotFound)
-
-				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(pgx.ErrNoRows)
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, mock.Anything).Return(nil, pgx.ErrNoRows)
 			},
 			expectedError: ErrNotFound,
 			checkResult: func(t *testing.T, u *model.User) {
@@ -106,19 +102,14 @@ func TestSetActive(t *testing.T) {
 		{
 			name: "ошибка при обновлении статуса",
 			input: &model.UserSetActive{
-				UserID:   uuid.New(),
+				UserID:   userID,
 				IsActive: true,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				dbError := errors.New("database connection error")
-
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(dbError)
-
-				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(dbError)
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, userID).Return(inactiveUser, nil).Once()
+				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).
+					Return(errors.New("database connection error"))
 			},
 			expectedError: errors.New("database connection error"),
 			checkResult: func(t *testing.T, u *model.User) {
@@ -128,40 +119,47 @@ func TestSetActive(t *testing.T) {
 		{
 			name: "ошибка при получении пользователя после обновления",
 			input: &model.UserSetActive{
-				UserID:   uuid.New(),
+				UserID:   userID,
 				IsActive: true,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				getError := errors.New("failed to retrieve user")
-
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(getError)
-
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, userID).Return(inactiveUser, nil).Once()
 				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(nil)
-				repo.On("GetByID", mock.Anything, mock.Anything).Return(nil, getError)
+				repo.On("GetByID", mock.Anything, userID).Return(nil, errors.New("failed to retrieve user")).Once()
 			},
 			expectedError: errors.New("failed to retrieve user"),
 			checkResult: func(t *testing.T, u *model.User) {
 				assert.Nil(t, u)
 			},
 		},
+		{
+			name: "ошибка записи аудита откатывает изменение",
+			input: &model.UserSetActive{
+				UserID:   userID,
+				IsActive: true,
+			},
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, userID).Return(inactiveUser, nil).Once()
+				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(nil)
+				repo.On("GetByID", mock.Anything, userID).Return(activeUser, nil).Once()
+				auditRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("audit error"))
+			},
+			expectedError: errors.New("audit error"),
+			checkResult: func(t *testing.T, u *model.User) {
+				assert.Nil(t, u)
+			},
+		},
 		{
 			name: "нулевой UUID пользователя",
 			input: &model.UserSetActive{
 				UserID:   uuid.Nil,
 				IsActive: true,
 			},
-			setupMocks: func(repo *mocks.MockUserRepository, txMgr *mocks.MockTxManager) {
-				txMgr.On("ReadCommited", mock.Anything, mock.AnythingOfType("db.Handler")).
-					Run(func(args mock.Arguments) {
-						fn := args.Get(1).(db.Handler)
-						_ = fn(args.Get(0).(context.Context))
-					}).Return(ErrNotFound)
-
-				repo.On("SetActive", mock.Anything, mock.AnythingOfType("*model.UserSetActive")).Return(pgx.ErrNoRows)
+			setupMocks: func(repo *mocks.MockUserRepository, auditRepo *mocks.MockAuditRepository, txMgr *mocks.MockTxManager) {
+				runTx(txMgr)
+				repo.On("GetByID", mock.Anything, uuid.Nil).Return(nil, pgx.ErrNoRows)
 			},
 			expectedError: ErrNotFound,
 			checkResult: func(t *testing.T, u *model.User) {
@@ -173,11 +171,12 @@ func TestSetActive(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := mocks.NewMockUserRepository(t)
+			auditRepo := mocks.NewMockAuditRepository(t)
 			txMgr := mocks.NewMockTxManager(t)
 
-			tt.setupMocks(repo, txMgr)
+			tt.setupMocks(repo, auditRepo, txMgr)
 
-			svc := NewService(repo, txMgr)
+			svc := NewService(repo, auditRepo, txMgr, events.NewBus(0))
 
 			result, err := svc.SetActive(context.Background(), tt.input)
 
@@ -196,3 +195,58 @@ func TestSetActive(t *testing.T) {
 		})
 	}
 }
+
+func TestSetActive_AuditEvent(t *testing.T) {
+	userID := uuid.New()
+	adminID := uuid.New()
+	before := &model.User{ID: userID, Username: "bob", TeamName: "backend", IsActive: true}
+	after := &model.User{ID: userID, Username: "bob", TeamName: "backend", IsActive: false}
+
+	repo := mocks.NewMockUserRepository(t)
+	auditRepo := mocks.NewMockAuditRepository(t)
+	txMgr := mocks.NewMockTxManager(t)
+
+	runTx(txMgr)
+	repo.On("GetByID", mock.Anything, userID).Return(before, nil).Once()
+	repo.On("SetActive", mock.Anything, mock.Anything).Return(nil)
+	repo.On("GetByID", mock.Anything, userID).Return(after, nil).Once()
+
+	var event *model.AuditEvent
+	auditRepo.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).
+		Run(func(args mock.Arguments) {
+			event = args.Get(1).(*model.AuditEvent)
+		}).Return(nil)
+
+	ctx := actor.WithContext(context.Background(), &actor.Actor{Name: "alice", Role: model.RoleAdmin, UserID: &adminID})
+	ctx = requestid.WithContext(ctx, "req-1")
+
+	bus := events.NewBus(0)
+	sub, _, _ := bus.Subscribe(&model.EventFilter{TeamName: "backend"}, "")
+	defer sub.Close()
+
+	svc := NewService(repo, auditRepo, txMgr, bus)
+	_, err := svc.SetActive(ctx, &model.UserSetActive{UserID: userID, IsActive: false})
+	assert.NoError(t, err)
+
+	if assert.Len(t, sub.C, 1) {
+		e := <-sub.C
+		assert.Equal(t, model.EventUserActivityChanged, e.Type)
+		assert.Equal(t, []uuid.UUID{userID}, e.UserIDs)
+		assert.Equal(t, after, e.Data)
+	}
+
+	if assert.NotNil(t, event) {
+		assert.Equal(t, model.AuditUserSetActive, event.Action)
+		assert.Equal(t, model.AuditEntityUser, event.EntityType)
+		assert.Equal(t, userID.String(), event.EntityID)
+		assert.Equal(t, "alice", event.ActorName)
+		assert.Equal(t, &adminID, event.ActorUserID)
+		assert.Equal(t, "req-1", event.RequestID)
+
+		var b, a model.User
+		assert.NoError(t, json.Unmarshal(event.Before, &b))
+		assert.NoError(t, json.Unmarshal(event.After, &a))
+		assert.True(t, b.IsActive)
+		assert.False(t, a.IsActive)
+	}
+}
@@ -4,17 +4,26 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"PR/internal/model"
+	"PR/internal/service/audit"
 )
 
 func (s *serv) SetActive(ctx context.Context, req *model.UserSetActive) (*model.User, error) {
 	var u *model.User
 
 	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
-		var errTx error
+		before, errTx := s.repo.GetByID(ctx, req.UserID)
+		if errTx != nil {
+			if errors.Is(errTx, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
+			return errTx
+		}
+
 		errTx = s.repo.SetActive(ctx, req)
 		if errTx != nil {
 			if errors.Is(errTx, pgx.ErrNoRows) {
@@ -27,11 +36,22 @@ func (s *serv) SetActive(ctx context.Context, req *model.UserSetActive) (*model.
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+
+		if before.IsActive != u.IsActive {
+			if errTx = s.publisher.Publish(ctx, &model.Event{
+				Type:     model.EventUserActivityChanged,
+				TeamName: u.TeamName,
+				UserIDs:  []uuid.UUID{u.ID},
+				Data:     u,
+			}); errTx != nil {
+				return errTx
+			}
+		}
+		return audit.Record(ctx, s.auditRepo, model.AuditUserSetActive, model.AuditEntityUser, u.ID.String(), before, u)
 	})
 
 	if err != nil {
-		log.Error().Msgf("%s.SetActive error: %v", op, err)
+		zerolog.Ctx(ctx).Error().Msgf("%s.SetActive error: %v", op, err)
 
 		return nil, err
 	}
@@ -0,0 +1,124 @@
+package token
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"PR/internal/config"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Claims — поля токена, которые нужны сервису. sub содержит users.id
+type Claims struct {
+	jwt.RegisteredClaims
+	Name string `json:"name,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+type Verifier struct {
+	hmacSecret []byte
+	rsaKey     *rsa.PublicKey
+	jwks       map[string]*rsa.PublicKey
+	parser     *jwt.Parser
+}
+
+// NewVerifier возвращает nil без ошибки, если в конфиге не задан ни один ключ
+func NewVerifier(cfg config.JWTConfig) (*Verifier, error) {
+	v := &Verifier{}
+
+	if cfg.HMACSecret != "" {
+		v.hmacSecret = []byte(cfg.HMACSecret)
+	}
+
+	if cfg.RSAPublicKey != "" {
+		key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(cfg.RSAPublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("parse rsa public key: %w", err)
+		}
+		v.rsaKey = key
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks file: %w", err)
+		}
+		v.jwks, err = parseJWKS(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse jwks file: %w", err)
+		}
+	}
+
+	if v.hmacSecret == nil && v.rsaKey == nil && len(v.jwks) == 0 {
+		return nil, nil
+	}
+
+	methods := make([]string, 0, 2)
+	if v.hmacSecret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if v.rsaKey != nil || len(v.jwks) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// Verify проверяет подпись и стандартные claims: exp обязателен, iss и aud — если заданы в конфиге
+func (v *Verifier) Verify(raw string) (*Claims, error) {
+	var claims Claims
+	_, err := v.parser.ParseWithClaims(raw, &claims, v.key)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: empty sub", ErrInvalidToken)
+	}
+	return &claims, nil
+}
+
+func (v *Verifier) key(t *jwt.Token) (any, error) {
+	switch t.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return v.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		// kid ищется в JWKS, без kid используется ключ из конфига
+		if kid, ok := t.Header["kid"].(string); ok && kid != "" {
+			if key, ok := v.jwks[kid]; ok {
+				return key, nil
+			}
+			if v.rsaKey == nil {
+				return nil, ErrUnknownKey
+			}
+		}
+		if v.rsaKey != nil {
+			return v.rsaKey, nil
+		}
+		if len(v.jwks) == 1 {
+			for _, key := range v.jwks {
+				return key, nil
+			}
+		}
+	}
+	return nil, ErrUnknownKey
+}
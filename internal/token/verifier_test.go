@@ -0,0 +1,208 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"PR/internal/config"
+)
+
+const secret = "test-secret"
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		tok.Header["kid"] = kid
+	}
+	raw, err := tok.SignedString(key)
+	require.NoError(t, err)
+	return raw
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":  "u1",
+		"name": "Alice",
+		"iss":  "issuer",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func pemPublicKey(t *testing.T, key *rsa.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	set := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewVerifier_NoKeys(t *testing.T) {
+	v, err := NewVerifier(config.JWTConfig{Issuer: "issuer"})
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestVerify_HS256(t *testing.T) {
+	v, err := NewVerifier(config.JWTConfig{HMACSecret: secret, Issuer: "issuer"})
+	require.NoError(t, err)
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	noExp := validClaims()
+	delete(noExp, "exp")
+
+	otherIssuer := validClaims()
+	otherIssuer["iss"] = "someone-else"
+
+	noSub := validClaims()
+	delete(noSub, "sub")
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "валидный токен",
+			token: sign(t, jwt.SigningMethodHS256, []byte(secret), "", validClaims()),
+		},
+		{
+			name:    "чужой секрет",
+			token:   sign(t, jwt.SigningMethodHS256, []byte("other"), "", validClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "истекший токен",
+			token:   sign(t, jwt.SigningMethodHS256, []byte(secret), "", expired),
+			wantErr: true,
+		},
+		{
+			name:    "без exp",
+			token:   sign(t, jwt.SigningMethodHS256, []byte(secret), "", noExp),
+			wantErr: true,
+		},
+		{
+			name:    "другой издатель",
+			token:   sign(t, jwt.SigningMethodHS256, []byte(secret), "", otherIssuer),
+			wantErr: true,
+		},
+		{
+			name:    "без sub",
+			token:   sign(t, jwt.SigningMethodHS256, []byte(secret), "", noSub),
+			wantErr: true,
+		},
+		{
+			name:    "alg none",
+			token:   sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims()),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := v.Verify(tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidToken)
+				assert.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "u1", claims.Subject)
+			assert.Equal(t, "Alice", claims.Name)
+		})
+	}
+}
+
+func TestVerify_RS256(t *testing.T) {
+	pemKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	unknownKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	v, err := NewVerifier(config.JWTConfig{
+		RSAPublicKey: pemPublicKey(t, &pemKey.PublicKey),
+		JWKSFile:     writeJWKS(t, "key-1", &jwksKey.PublicKey),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "ключ из PEM без kid",
+			token: sign(t, jwt.SigningMethodRS256, pemKey, "", validClaims()),
+		},
+		{
+			name:  "ключ из JWKS по kid",
+			token: sign(t, jwt.SigningMethodRS256, jwksKey, "key-1", validClaims()),
+		},
+		{
+			name:    "неизвестный ключ",
+			token:   sign(t, jwt.SigningMethodRS256, unknownKey, "key-2", validClaims()),
+			wantErr: true,
+		},
+		{
+			name:    "HS256 не разрешен без секрета",
+			token:   sign(t, jwt.SigningMethodHS256, []byte(secret), "", validClaims()),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := v.Verify(tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidToken)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "u1", claims.Subject)
+		})
+	}
+}
+
+func TestNewVerifier_InvalidKeys(t *testing.T) {
+	_, err := NewVerifier(config.JWTConfig{RSAPublicKey: "not a pem"})
+	assert.Error(t, err)
+
+	_, err = NewVerifier(config.JWTConfig{JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    action VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+
+    actor_name VARCHAR(255),
+    actor_role VARCHAR(20),
+    actor_user_id UUID,
+    actor_key_id UUID,
+    request_id VARCHAR(128),
+
+    before JSONB,
+    after JSONB
+);
+
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at DESC, id DESC);
+CREATE INDEX idx_audit_events_entity ON audit_events(entity_type, entity_id);
+CREATE INDEX idx_audit_events_actor_user_id ON audit_events(actor_user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pr_reviewer_assignments;
\ No newline at end of file
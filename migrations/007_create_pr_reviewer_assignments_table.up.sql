@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS pr_reviewer_assignments (
+    id BIGSERIAL PRIMARY KEY,
+    pr_id UUID NOT NULL,
+    reviewer_id UUID NOT NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    unassigned_at TIMESTAMP WITH TIME ZONE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('initial', 'reassign', 'deactivation')),
+
+    actor_name VARCHAR(255),
+    actor_user_id UUID,
+
+    FOREIGN KEY (pr_id) REFERENCES prs(id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+
+CREATE INDEX idx_pr_reviewer_assignments_pr_id ON pr_reviewer_assignments(pr_id, assigned_at);
+CREATE INDEX idx_pr_reviewer_assignments_reviewer_id ON pr_reviewer_assignments(reviewer_id);
+-- у ревьюера может быть только одно активное назначение на PR
+CREATE UNIQUE INDEX idx_pr_reviewer_assignments_active
+    ON pr_reviewer_assignments(pr_id, reviewer_id) WHERE unassigned_at IS NULL;
+
+-- текущие назначения считаются первичными: история до миграции не сохранилась
+INSERT INTO pr_reviewer_assignments (pr_id, reviewer_id, assigned_at, reason)
+SELECT pr_id, reviewer_id, COALESCE(assigned_at, NOW()), 'initial'
+FROM pr_reviewers;
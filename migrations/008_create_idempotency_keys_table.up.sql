@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope VARCHAR(512) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+
+    status_code INT,
+    content_type VARCHAR(255),
+    response_body BYTEA,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (scope, key)
+);
+
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
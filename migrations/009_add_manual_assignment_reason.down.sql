@@ -0,0 +1,7 @@
+-- ручные назначения считаются переназначениями, чтобы вернуть старое ограничение
+UPDATE pr_reviewer_assignments SET reason = 'reassign' WHERE reason = 'manual';
+
+ALTER TABLE pr_reviewer_assignments DROP CONSTRAINT IF EXISTS pr_reviewer_assignments_reason_check;
+ALTER TABLE pr_reviewer_assignments
+    ADD CONSTRAINT pr_reviewer_assignments_reason_check
+    CHECK (reason IN ('initial', 'reassign', 'deactivation'));
@@ -0,0 +1,4 @@
+ALTER TABLE pr_reviewer_assignments DROP CONSTRAINT IF EXISTS pr_reviewer_assignments_reason_check;
+ALTER TABLE pr_reviewer_assignments
+    ADD CONSTRAINT pr_reviewer_assignments_reason_check
+    CHECK (reason IN ('initial', 'reassign', 'deactivation', 'manual'));
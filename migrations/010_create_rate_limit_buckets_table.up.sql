@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(1024) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    -- решение по последнему запросу, чтобы вернуть его из одного UPSERT
+    allowed BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+
+CREATE INDEX idx_rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
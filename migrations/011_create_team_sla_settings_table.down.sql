@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_pending;
+
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS reminded_at,
+    DROP COLUMN IF EXISTS reviewed_at;
+
+DROP TABLE IF EXISTS team_sla_settings;
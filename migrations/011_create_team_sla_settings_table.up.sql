@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS team_sla_settings (
+    team_name VARCHAR(100) PRIMARY KEY,
+    -- за сколько часов после назначения ревьюер должен оставить первое ревью
+    first_review_hours INT NOT NULL CHECK (first_review_hours > 0),
+    -- через сколько часов напоминать повторно; 0 — напоминать один раз
+    reminder_interval_hours INT NOT NULL DEFAULT 0 CHECK (reminder_interval_hours >= 0),
+    webhook_url TEXT,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMP WITH TIME ZONE;
+
+-- планировщик напоминаний ищет только ревью, которых еще нет
+CREATE INDEX idx_pr_reviewers_pending ON pr_reviewers(assigned_at) WHERE reviewed_at IS NULL;
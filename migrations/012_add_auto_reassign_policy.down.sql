@@ -0,0 +1,11 @@
+-- переназначения по таймауту считаются обычными, чтобы вернуть старое ограничение
+UPDATE pr_reviewer_assignments SET reason = 'reassign' WHERE reason = 'timeout';
+
+ALTER TABLE pr_reviewer_assignments DROP CONSTRAINT IF EXISTS pr_reviewer_assignments_reason_check;
+ALTER TABLE pr_reviewer_assignments
+    ADD CONSTRAINT pr_reviewer_assignments_reason_check
+    CHECK (reason IN ('initial', 'reassign', 'deactivation', 'manual'));
+
+ALTER TABLE team_sla_settings
+    DROP COLUMN IF EXISTS max_auto_reassigns,
+    DROP COLUMN IF EXISTS auto_reassign_hours;
@@ -0,0 +1,10 @@
+-- автоматическое переназначение ревью, которое не оставлено за auto_reassign_hours;
+-- не больше max_auto_reassigns раз на PR, 0 в любом поле выключает его
+ALTER TABLE team_sla_settings
+    ADD COLUMN IF NOT EXISTS auto_reassign_hours INT NOT NULL DEFAULT 0 CHECK (auto_reassign_hours >= 0),
+    ADD COLUMN IF NOT EXISTS max_auto_reassigns INT NOT NULL DEFAULT 0 CHECK (max_auto_reassigns >= 0);
+
+ALTER TABLE pr_reviewer_assignments DROP CONSTRAINT IF EXISTS pr_reviewer_assignments_reason_check;
+ALTER TABLE pr_reviewer_assignments
+    ADD CONSTRAINT pr_reviewer_assignments_reason_check
+    CHECK (reason IN ('initial', 'reassign', 'deactivation', 'manual', 'timeout'));
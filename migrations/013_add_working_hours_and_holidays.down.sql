@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS holidays;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS teams_work_hours_check,
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,24 @@
+-- рабочее время команды: часы с work_start до work_end по местному времени
+-- timezone (IANA) с понедельника по пятницу. SLA считается только в эти часы
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS work_start TIME NOT NULL DEFAULT '09:00',
+    ADD COLUMN IF NOT EXISTS work_end TIME NOT NULL DEFAULT '18:00',
+    ADD CONSTRAINT teams_work_hours_check CHECK (work_start < work_end);
+
+-- NULL — как у команды пользователя
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS work_start TIME,
+    ADD COLUMN IF NOT EXISTS work_end TIME;
+
+CREATE TABLE IF NOT EXISTS holidays (
+    day DATE NOT NULL,
+    -- NULL — выходной для всех команд
+    team_name VARCHAR(100),
+    name VARCHAR(200) NOT NULL DEFAULT '',
+
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_holidays_day_team ON holidays(day, COALESCE(team_name, ''));
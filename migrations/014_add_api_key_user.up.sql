@@ -0,0 +1,4 @@
+-- ключ, привязанный к пользователю, проходит проверки владения PR как JWT этого
+-- пользователя; NULL — сервисный ключ без пользователя
+ALTER TABLE api_keys
+    ADD COLUMN IF NOT EXISTS user_id UUID REFERENCES users(id) ON DELETE CASCADE;